@@ -31,6 +31,18 @@ type CapsuleConfigurationSpec struct {
 	// when not using an already provided CA and certificate, or when these are managed externally with Vault, or cert-manager.
 	// +kubebuilder:default=true
 	EnableTLSReconciler bool `json:"enableTLSReconciler"` //nolint:tagliatelle
+	// Enables the periodic snapshot of the Tenant usage in TenantUsageReport resources, for chargeback purposes.
+	UsageReport *UsageReportSpec `json:"usageReport,omitempty"`
+}
+
+type UsageReportSpec struct {
+	// Define the period of time upon a new TenantUsageReport is created for each Tenant.
+	// +kubebuilder:default="1h"
+	Period metav1.Duration `json:"period"`
+	// The amount of TenantUsageReport resources retained for each Tenant, the oldest ones are deleted.
+	// +kubebuilder:default=24
+	// +kubebuilder:validation:Minimum=1
+	HistoryLimit int `json:"historyLimit"`
 }
 
 type NodeMetadata struct {
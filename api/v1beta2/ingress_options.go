@@ -22,12 +22,30 @@ type IngressOptions struct {
 	//
 	// - Namespace: disallow the creation of an Ingress if the pair hostname and path is already used in the Ingress Namespace.
 	//
+	// The paths are matched according to their type: the overlapping ones, such as the /api prefix and the /api/v1 path, are colliding when used by different Tenants.
+	//
 	//
 	// Optional.
 	// +kubebuilder:default=Disabled
 	HostnameCollisionScope api.HostnameCollisionScope `json:"hostnameCollisionScope,omitempty"`
+	// Defines whether the first Tenant using a hostname reserves it, regardless of the hostname collision scope.
+	//
+	//
+	// - Hostname: the Ingress resources of the other Tenants cannot use the hostnames used by the Tenant.
+	//
+	// - Path: the Ingress resources of the other Tenants cannot use the paths overlapping with the ones used by the Tenant for the same hostname.
+	//
+	// - Disabled: the hostnames are not reserved.
+	//
+	//
+	// The reservation is released as soon as the Tenant no longer uses the hostname. Optional.
+	// +kubebuilder:default=Disabled
+	HostnameOwnership api.HostnameOwnership `json:"hostnameOwnership,omitempty"`
 	// Specifies the allowed hostnames in Ingresses for the given Tenant. Capsule assures that all Ingress resources created in the Tenant can use only one of the allowed hostnames. Optional.
 	AllowedHostnames *api.AllowedListSpec `json:"allowedHostnames,omitempty"`
 	// Toggles the ability for Ingress resources created in a Tenant to have a hostname wildcard.
 	AllowWildcardHostnames bool `json:"allowWildcardHostnames,omitempty"`
+	// Specifies the Gateways the Gateway API routes created in the Tenant can be attached to, matched in the namespace/name form.
+	// The allowed hostnames, the wildcard hostnames, and the hostname collision scope apply to the HTTPRoute, GRPCRoute, and TLSRoute resources as well. Optional.
+	AllowedGateways *api.AllowedListSpec `json:"allowedGateways,omitempty"`
 }
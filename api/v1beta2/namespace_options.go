@@ -17,4 +17,11 @@ type NamespaceOptions struct {
 	ForbiddenLabels api.ForbiddenListSpec `json:"forbiddenLabels,omitempty"`
 	// Define the annotations that a Tenant Owner cannot set for their Namespace resources.
 	ForbiddenAnnotations api.ForbiddenListSpec `json:"forbiddenAnnotations,omitempty"`
+	// Specifies the soft deletion of the Namespaces: the deletion requested by a Tenant Owner turns the Namespace into a pending deletion one,
+	// cordoned and with the workloads scaled to zero, which is actually deleted once the grace period expires, unless restored. Optional.
+	SoftDelete *api.NamespaceSoftDeleteSpec `json:"softDelete,omitempty"`
+	// Specifies the naming policy of the Namespaces, taking precedence over the forceTenantPrefix setting of the Capsule configuration. Optional.
+	NamingPolicy *api.NamespaceNamingPolicy `json:"namingPolicy,omitempty"`
+	// Specifies how the NamespaceRequest resources created by the Tenant Owners are handled, such as their automatic approval. Optional.
+	Requests *api.NamespaceRequestsSpec `json:"requests,omitempty"`
 }
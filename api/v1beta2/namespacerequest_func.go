@@ -0,0 +1,50 @@
+// Copyright 2020-2023 Project Capsule Authors.
+// SPDX-License-Identifier: Apache-2.0
+
+package v1beta2
+
+import (
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// IsFulfilled returns true once the requested Namespace has been created: the request is not processed anymore.
+func (in *NamespaceRequest) IsFulfilled() bool {
+	return meta.IsStatusConditionTrue(in.Status.Conditions, NamespaceRequestConditionFulfilled)
+}
+
+// ResetStaleApproval resets the approval not set upon the current generation of the request: the approvals must carry
+// the generation of the reviewed request, since the Tenant Owner could change it before the approval is observed.
+// It returns true if the approval has been changed.
+func (in *NamespaceRequest) ResetStaleApproval() bool {
+	approval := meta.FindStatusCondition(in.Status.Conditions, NamespaceRequestConditionApproved)
+
+	if approval == nil || approval.ObservedGeneration == in.GetGeneration() {
+		return false
+	}
+
+	reason, message := NamespaceRequestReasonSpecChanged, "The request has been changed after the approval, it must be approved again"
+	if approval.ObservedGeneration == 0 {
+		reason, message = NamespaceRequestReasonUnboundApproval, "The approval must set the observed generation of the reviewed request, it must be approved again"
+	}
+
+	meta.SetStatusCondition(&in.Status.Conditions, metav1.Condition{
+		Type:               NamespaceRequestConditionApproved,
+		Status:             metav1.ConditionUnknown,
+		ObservedGeneration: in.GetGeneration(),
+		Reason:             reason,
+		Message:            message,
+	})
+
+	return true
+}
+
+// Approval returns the approval of the current generation of the request, if any.
+func (in *NamespaceRequest) Approval() *metav1.Condition {
+	approval := meta.FindStatusCondition(in.Status.Conditions, NamespaceRequestConditionApproved)
+	if approval == nil || approval.ObservedGeneration != in.GetGeneration() || approval.Status == metav1.ConditionUnknown {
+		return nil
+	}
+
+	return approval
+}
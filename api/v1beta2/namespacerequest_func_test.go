@@ -0,0 +1,45 @@
+// Copyright 2020-2023 Project Capsule Authors.
+// SPDX-License-Identifier: Apache-2.0
+
+package v1beta2
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestNamespaceRequest_ResetStaleApproval(t *testing.T) {
+	req := &NamespaceRequest{ObjectMeta: metav1.ObjectMeta{Generation: 1}}
+
+	assert.False(t, req.ResetStaleApproval())
+	assert.Nil(t, req.Approval())
+
+	// The approval set by the cluster administrators with no observed generation is not bound to any generation
+	req.Status.Conditions = []metav1.Condition{{Type: NamespaceRequestConditionApproved, Status: metav1.ConditionTrue, Reason: "Approved"}}
+
+	assert.True(t, req.ResetStaleApproval())
+	assert.Nil(t, req.Approval())
+	assert.Equal(t, NamespaceRequestReasonUnboundApproval, req.Status.Conditions[0].Reason)
+
+	req.Status.Conditions = []metav1.Condition{{Type: NamespaceRequestConditionApproved, Status: metav1.ConditionTrue, ObservedGeneration: 1, Reason: "Approved"}}
+
+	assert.False(t, req.ResetStaleApproval())
+	assert.Equal(t, int64(1), req.Approval().ObservedGeneration)
+	assert.Equal(t, metav1.ConditionTrue, req.Approval().Status)
+
+	// The Tenant Owner changes the request after the approval
+	req.SetGeneration(2)
+
+	assert.Nil(t, req.Approval())
+	assert.True(t, req.ResetStaleApproval())
+	assert.Nil(t, req.Approval())
+	assert.Equal(t, NamespaceRequestReasonSpecChanged, req.Status.Conditions[0].Reason)
+
+	req.Status.Conditions = []metav1.Condition{{Type: NamespaceRequestConditionApproved, Status: metav1.ConditionFalse, ObservedGeneration: 2, Reason: "Rejected"}}
+
+	assert.False(t, req.ResetStaleApproval())
+	assert.Equal(t, metav1.ConditionFalse, req.Approval().Status)
+	assert.False(t, req.IsFulfilled())
+}
@@ -0,0 +1,86 @@
+// Copyright 2020-2023 Project Capsule Authors.
+// SPDX-License-Identifier: Apache-2.0
+
+package v1beta2
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// NamespaceRequestConditionApproved is set by the cluster administrators, or by the Tenant automatic approval:
+	// the request is fulfilled when True, and rejected when False. The observed generation must be the one of the
+	// reviewed request, otherwise the approval is reset.
+	NamespaceRequestConditionApproved = "Approved"
+	// NamespaceRequestConditionFulfilled is True once the requested Namespace has been created by the controller.
+	NamespaceRequestConditionFulfilled = "Fulfilled"
+)
+
+const (
+	NamespaceRequestReasonAutoApproved    = "AutoApproved"
+	NamespaceRequestReasonSpecChanged     = "SpecChanged"
+	NamespaceRequestReasonUnboundApproval = "UnboundApproval"
+	NamespaceRequestReasonPending         = "PendingApproval"
+	NamespaceRequestReasonRejected        = "Rejected"
+	NamespaceRequestReasonInvalid         = "Invalid"
+	NamespaceRequestReasonAlreadyExists   = "AlreadyExists"
+	NamespaceRequestReasonCreated         = "Created"
+	NamespaceRequestReasonCreationFailure = "CreationFailure"
+)
+
+// NamespaceRequestSpec defines the desired state of NamespaceRequest.
+type NamespaceRequestSpec struct {
+	// Name of the requested Namespace: it must comply with the naming policy of the Tenant.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=63
+	Name string `json:"name"`
+	// Labels put in place on the requested Namespace: these must not be forbidden by the Tenant. Optional.
+	Labels map[string]string `json:"labels,omitempty"`
+	// Justification of the request, reviewed by the cluster administrators. Optional.
+	Justification string `json:"justification,omitempty"`
+}
+
+// NamespaceRequestStatus defines the observed state of NamespaceRequest.
+type NamespaceRequestStatus struct {
+	// +listType=map
+	// +listMapKey=type
+	// Conditions of the request: the Approved one is set by the cluster administrators,
+	// along with the observed generation of the reviewed request, while the Fulfilled one reports the outcome of the Namespace creation.
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// Tenant the Namespace is requested for.
+	Tenant string `json:"tenant,omitempty"`
+	// Specifies if the namespace quota of the Tenant has been raised to fulfill the request.
+	QuotaRaised bool `json:"quotaRaised,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:shortName=nsreq
+// +kubebuilder:printcolumn:name="Namespace",type="string",JSONPath=".spec.name",description="The requested Namespace"
+// +kubebuilder:printcolumn:name="Approved",type="string",JSONPath=".status.conditions[?(@.type==\"Approved\")].status",description="Whether the request has been approved"
+// +kubebuilder:printcolumn:name="Fulfilled",type="string",JSONPath=".status.conditions[?(@.type==\"Fulfilled\")].reason",description="The outcome of the request"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp",description="Age"
+
+// NamespaceRequest allows a Tenant Owner, if enabled with proper RBAC, to request an additional Namespace for the Tenant
+// the NamespaceRequest is deployed in, such as when the namespace quota has been reached:
+// once approved, the Namespace is created by the controller, bypassing the namespace quota.
+type NamespaceRequest struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   NamespaceRequestSpec   `json:"spec,omitempty"`
+	Status NamespaceRequestStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// NamespaceRequestList contains a list of NamespaceRequest.
+type NamespaceRequestList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []NamespaceRequest `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&NamespaceRequest{}, &NamespaceRequestList{})
+}
@@ -0,0 +1,69 @@
+// Copyright 2020-2023 Project Capsule Authors.
+// SPDX-License-Identifier: Apache-2.0
+
+package v1beta2
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// ResourceQuotaAllocationConditionAccepted is True when the allocation fits the Tenant budget,
+	// and it's applied to the ResourceQuota of the Namespace.
+	ResourceQuotaAllocationConditionAccepted = "Accepted"
+)
+
+const (
+	ResourceQuotaAllocationReasonAccepted = "Accepted"
+	ResourceQuotaAllocationReasonExceeded = "BudgetExceeded"
+	ResourceQuotaAllocationReasonInvalid  = "Invalid"
+)
+
+// ResourceQuotaAllocationSpec defines the desired state of ResourceQuotaAllocation.
+type ResourceQuotaAllocationSpec struct {
+	// Index of the Tenant ResourceQuota item the resources are allocated from.
+	// +kubebuilder:validation:Minimum=0
+	Index int `json:"index"`
+	// Amount of the resources reserved to the Namespace: these must be positive, and limited by the Tenant ResourceQuota item.
+	// +kubebuilder:validation:XValidation:rule="self.all(name, !string(self[name]).startsWith('-'))",message="the reserved quantities cannot be negative"
+	Hard corev1.ResourceList `json:"hard"`
+}
+
+// ResourceQuotaAllocationStatus defines the observed state of ResourceQuotaAllocation.
+type ResourceQuotaAllocationStatus struct {
+	// +listType=map
+	// +listMapKey=type
+	// Conditions of the allocation, reporting if it has been accepted by the Tenant controller.
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:shortName=rqa
+// +kubebuilder:printcolumn:name="Index",type="integer",JSONPath=".spec.index",description="The Tenant ResourceQuota item index"
+// +kubebuilder:printcolumn:name="Accepted",type="string",JSONPath=".status.conditions[?(@.type==\"Accepted\")].status",description="Whether the allocation fits the Tenant budget"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp",description="Age"
+
+// ResourceQuotaAllocation allows a Tenant Owner, if enabled with proper RBAC, to reserve part of a Tenant-scoped
+// ResourceQuota item to the Namespace it's deployed in: the remaining budget is shared among the other Tenant Namespaces.
+type ResourceQuotaAllocation struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ResourceQuotaAllocationSpec   `json:"spec,omitempty"`
+	Status ResourceQuotaAllocationStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ResourceQuotaAllocationList contains a list of ResourceQuotaAllocation.
+type ResourceQuotaAllocationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ResourceQuotaAllocation `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ResourceQuotaAllocation{}, &ResourceQuotaAllocationList{})
+}
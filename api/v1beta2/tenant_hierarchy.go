@@ -0,0 +1,133 @@
+// Copyright 2020-2023 Project Capsule Authors.
+// SPDX-License-Identifier: Apache-2.0
+
+package v1beta2
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/projectcapsule/capsule/pkg/api"
+)
+
+// InheritFrom applies to the Tenant the policies of the given parent, which must be already resolved:
+// missing allowed lists are taken from the parent, the parent node selector labels are enforced,
+// and the parent LimitRange items are put in place along with the Tenant ones.
+func (in *Tenant) InheritFrom(parent *Tenant) {
+	if in.Spec.ContainerRegistries == nil && parent.Spec.ContainerRegistries != nil {
+		in.Spec.ContainerRegistries = parent.Spec.ContainerRegistries.DeepCopy()
+	}
+
+	if in.Spec.ImagePolicy == nil && parent.Spec.ImagePolicy != nil {
+		in.Spec.ImagePolicy = parent.Spec.ImagePolicy.DeepCopy()
+	}
+
+	if in.Spec.RegistryMirrors == nil && parent.Spec.RegistryMirrors != nil {
+		in.Spec.RegistryMirrors = append([]api.RegistryMirrorSpec{}, parent.Spec.RegistryMirrors...)
+	}
+
+	if in.Spec.PodSecurity == nil && parent.Spec.PodSecurity != nil {
+		in.Spec.PodSecurity = parent.Spec.PodSecurity.DeepCopy()
+	}
+
+	if in.Spec.VolumeOptions == nil && parent.Spec.VolumeOptions != nil {
+		in.Spec.VolumeOptions = parent.Spec.VolumeOptions.DeepCopy()
+	}
+
+	if in.Spec.NodePool == nil && parent.Spec.NodePool != nil {
+		in.Spec.NodePool = parent.Spec.NodePool.DeepCopy()
+	}
+
+	if in.Spec.StorageClasses == nil && parent.Spec.StorageClasses != nil {
+		in.Spec.StorageClasses = parent.Spec.StorageClasses.DeepCopy()
+	}
+
+	if in.Spec.IngressOptions.AllowedClasses == nil && parent.Spec.IngressOptions.AllowedClasses != nil {
+		in.Spec.IngressOptions.AllowedClasses = parent.Spec.IngressOptions.AllowedClasses.DeepCopy()
+	}
+
+	if in.Spec.IngressOptions.AllowedHostnames == nil && parent.Spec.IngressOptions.AllowedHostnames != nil {
+		in.Spec.IngressOptions.AllowedHostnames = parent.Spec.IngressOptions.AllowedHostnames.DeepCopy()
+	}
+
+	in.Spec.IngressOptions.AllowWildcardHostnames = in.Spec.IngressOptions.AllowWildcardHostnames && parent.Spec.IngressOptions.AllowWildcardHostnames
+
+	if in.Spec.IngressOptions.AllowedGateways == nil && parent.Spec.IngressOptions.AllowedGateways != nil {
+		in.Spec.IngressOptions.AllowedGateways = parent.Spec.IngressOptions.AllowedGateways.DeepCopy()
+	}
+
+	if len(parent.Spec.NodeSelector) > 0 {
+		if in.Spec.NodeSelector == nil {
+			in.Spec.NodeSelector = make(map[string]string, len(parent.Spec.NodeSelector))
+		}
+
+		for k, v := range parent.Spec.NodeSelector {
+			in.Spec.NodeSelector[k] = v
+		}
+	}
+
+	if len(parent.Spec.LimitRanges.Items) > 0 {
+		items := make([]corev1.LimitRangeSpec, 0, len(parent.Spec.LimitRanges.Items)+len(in.Spec.LimitRanges.Items))
+
+		for _, item := range parent.Spec.LimitRanges.Items {
+			items = append(items, *item.DeepCopy())
+		}
+
+		in.Spec.LimitRanges.Items = append(items, in.Spec.LimitRanges.Items...)
+	}
+}
+
+// CarveOut subtracts from the Tenant budget the one allocated to the given children:
+// the Namespace quota is reduced by the children ones, as well as the hard values of the
+// Tenant-scoped ResourceQuota items, matched by index.
+func (in *Tenant) CarveOut(children ...Tenant) {
+	if in.Spec.NamespaceOptions != nil && in.Spec.NamespaceOptions.Quota != nil {
+		quota := *in.Spec.NamespaceOptions.Quota
+
+		for _, child := range children {
+			if child.Spec.NamespaceOptions != nil && child.Spec.NamespaceOptions.Quota != nil {
+				quota -= *child.Spec.NamespaceOptions.Quota
+			}
+		}
+
+		if quota < 0 {
+			quota = 0
+		}
+
+		in.Spec.NamespaceOptions.Quota = &quota
+	}
+
+	if in.Spec.ResourceQuota.Scope != api.ResourceQuotaScopeTenant {
+		return
+	}
+
+	for index, item := range in.Spec.ResourceQuota.Items {
+		for name, hard := range item.Hard {
+			quantity := hard.DeepCopy()
+
+			quantity.Sub(ChildrenResourceQuota(index, name, children...))
+
+			if quantity.Sign() < 0 {
+				quantity = resource.Quantity{}
+			}
+
+			in.Spec.ResourceQuota.Items[index].Hard[name] = quantity
+		}
+	}
+}
+
+// ChildrenResourceQuota returns the sum of the hard values allocated by the given children
+// for the ResourceQuota item with the provided index.
+func ChildrenResourceQuota(index int, name corev1.ResourceName, children ...Tenant) (quantity resource.Quantity) {
+	for _, child := range children {
+		if len(child.Spec.ResourceQuota.Items) <= index {
+			continue
+		}
+
+		if value, ok := child.Spec.ResourceQuota.Items[index].Hard[name]; ok {
+			quantity.Add(value)
+		}
+	}
+
+	return quantity
+}
@@ -0,0 +1,80 @@
+// Copyright 2020-2023 Project Capsule Authors.
+// SPDX-License-Identifier: Apache-2.0
+
+package v1beta2
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/utils/pointer"
+
+	"github.com/projectcapsule/capsule/pkg/api"
+)
+
+func TestTenant_InheritFrom(t *testing.T) {
+	parent := &Tenant{
+		Spec: TenantSpec{
+			ContainerRegistries: &api.AllowedListSpec{Exact: []string{"docker.io"}},
+			NodeSelector:        map[string]string{"pool": "energy"},
+			LimitRanges: api.LimitRangesSpec{Items: []corev1.LimitRangeSpec{
+				{Limits: []corev1.LimitRangeItem{{Type: corev1.LimitTypePod}}},
+			}},
+		},
+	}
+
+	child := &Tenant{
+		Spec: TenantSpec{
+			NodeSelector: map[string]string{"pool": "oil", "zone": "a"},
+			LimitRanges: api.LimitRangesSpec{Items: []corev1.LimitRangeSpec{
+				{Limits: []corev1.LimitRangeItem{{Type: corev1.LimitTypeContainer}}},
+			}},
+			IngressOptions: IngressOptions{AllowWildcardHostnames: true},
+		},
+	}
+
+	child.InheritFrom(parent)
+
+	assert.Equal(t, []string{"docker.io"}, child.Spec.ContainerRegistries.Exact)
+	assert.Equal(t, map[string]string{"pool": "energy", "zone": "a"}, child.Spec.NodeSelector)
+	assert.Len(t, child.Spec.LimitRanges.Items, 2)
+	assert.Equal(t, corev1.LimitTypePod, child.Spec.LimitRanges.Items[0].Limits[0].Type)
+	assert.False(t, child.Spec.IngressOptions.AllowWildcardHostnames)
+}
+
+func TestTenant_CarveOut(t *testing.T) {
+	parent := &Tenant{
+		Spec: TenantSpec{
+			NamespaceOptions: &NamespaceOptions{Quota: pointer.Int32(10)},
+			ResourceQuota: api.ResourceQuotaSpec{
+				Scope: api.ResourceQuotaScopeTenant,
+				Items: []corev1.ResourceQuotaSpec{
+					{Hard: corev1.ResourceList{corev1.ResourceLimitsCPU: resource.MustParse("8")}},
+				},
+			},
+		},
+	}
+
+	child := func(quota int32, cpu string) Tenant {
+		return Tenant{
+			Spec: TenantSpec{
+				NamespaceOptions: &NamespaceOptions{Quota: pointer.Int32(quota)},
+				ResourceQuota: api.ResourceQuotaSpec{
+					Scope: api.ResourceQuotaScopeTenant,
+					Items: []corev1.ResourceQuotaSpec{
+						{Hard: corev1.ResourceList{corev1.ResourceLimitsCPU: resource.MustParse(cpu)}},
+					},
+				},
+			},
+		}
+	}
+
+	parent.CarveOut(child(3, "2"), child(4, "2500m"))
+
+	assert.Equal(t, int32(3), *parent.Spec.NamespaceOptions.Quota)
+
+	cpu := parent.Spec.ResourceQuota.Items[0].Hard[corev1.ResourceLimitsCPU]
+	assert.Equal(t, 0, cpu.Cmp(resource.MustParse("3500m")))
+}
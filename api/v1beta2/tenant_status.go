@@ -3,6 +3,11 @@
 
 package v1beta2
 
+import (
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
 // +kubebuilder:validation:Enum=Cordoned;Active
 type tenantState string
 
@@ -11,6 +16,34 @@ const (
 	TenantStateCordoned tenantState = "Cordoned"
 )
 
+const (
+	// TenantConditionReady is True when all the reconciliation steps of the Tenant succeeded.
+	TenantConditionReady = "Ready"
+	// TenantConditionMetadataSynced reports the outcome of the Tenant metadata reconciliation.
+	TenantConditionMetadataSynced = "MetadataSynced"
+	// TenantConditionClassResolved reports the outcome of the resolution of the defaults provided by the TenantClass.
+	TenantConditionClassResolved = "ClassResolved"
+	// TenantConditionHierarchyResolved reports the outcome of the resolution of the policies inherited by the parent Tenant.
+	TenantConditionHierarchyResolved = "HierarchyResolved"
+	// TenantConditionNamespacesSynced reports the outcome of the Namespaces collection and metadata reconciliation.
+	TenantConditionNamespacesSynced = "NamespacesSynced"
+	// TenantConditionNetworkPoliciesSynced reports the outcome of the NetworkPolicy resources reconciliation.
+	TenantConditionNetworkPoliciesSynced = "NetworkPoliciesSynced"
+	// TenantConditionLimitRangesSynced reports the outcome of the LimitRange resources reconciliation.
+	TenantConditionLimitRangesSynced = "LimitRangesSynced"
+	// TenantConditionQuotasSynced reports the outcome of the ResourceQuota resources reconciliation.
+	TenantConditionQuotasSynced = "QuotasSynced"
+	// TenantConditionRBACSynced reports the outcome of the RoleBinding resources reconciliation.
+	TenantConditionRBACSynced = "RBACSynced"
+	// TenantConditionPolicyReportSynced reports the outcome of the TenantPolicyReport reconciliation.
+	TenantConditionPolicyReportSynced = "PolicyReportSynced"
+)
+
+const (
+	TenantReasonSucceeded = "Succeeded"
+	TenantReasonFailed    = "Failed"
+)
+
 // Returns the observed state of the Tenant.
 type TenantStatus struct {
 	// +kubebuilder:default=Active
@@ -20,4 +53,75 @@ type TenantStatus struct {
 	Size uint `json:"size"`
 	// List of namespaces assigned to the Tenant.
 	Namespaces []string `json:"namespaces,omitempty"`
+	// The generation of the Tenant last reconciled successfully by the controller.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// The TenantClass the effective specification of the Tenant has been resolved with.
+	Class *TenantClassStatus `json:"class,omitempty"`
+	// Consolidated usage of the resources across all the Tenant Namespaces.
+	Usage *TenantUsage `json:"usage,omitempty"`
+	// Outcome of the adoption of the existing Namespaces selected by the Tenant.
+	Adoption *TenantAdoptionStatus `json:"adoption,omitempty"`
+	// +listType=map
+	// +listMapKey=type
+	// Conditions of the Tenant, one for each reconciliation step plus the overall Ready one:
+	// the message of a failed step condition contains the last error returned by the step.
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// TenantClassStatus reports the TenantClass applied to the Tenant.
+type TenantClassStatus struct {
+	// Name of the TenantClass.
+	Name string `json:"name"`
+	// The generation of the TenantClass last applied to the Tenant.
+	Generation int64 `json:"generation"`
+}
+
+// TenantAdoptionStatus reports the existing Namespaces selected for the adoption by the Tenant.
+type TenantAdoptionStatus struct {
+	// Namespaces which would be adopted by the Tenant, reported when the dry run is enabled.
+	Pending []string `json:"pending,omitempty"`
+	// Namespaces adopted by the Tenant.
+	Adopted []string `json:"adopted,omitempty"`
+	// Namespaces which cannot be adopted by the Tenant, such as the ones belonging to another Tenant.
+	Refused []TenantAdoptionRefusal `json:"refused,omitempty"`
+}
+
+// TenantAdoptionRefusal reports a Namespace which cannot be adopted by the Tenant.
+type TenantAdoptionRefusal struct {
+	// Name of the Namespace.
+	Namespace string `json:"namespace"`
+	// The reason the Namespace cannot be adopted.
+	Reason string `json:"reason"`
+}
+
+// TenantUsage reports the resources consumed across all the Tenant Namespaces.
+type TenantUsage struct {
+	// Sum of the CPU requested by the running Pods.
+	CPU resource.Quantity `json:"cpu"`
+	// Sum of the memory requested by the running Pods.
+	Memory resource.Quantity `json:"memory"`
+	// Sum of the storage requested by the PersistentVolumeClaims.
+	Storage resource.Quantity `json:"storage"`
+	// Amount of running Pods.
+	Pods int64 `json:"pods"`
+	// Amount of Services of type LoadBalancer.
+	LoadBalancers int64 `json:"loadBalancers"`
+	// Amount of PersistentVolumeClaims.
+	PersistentVolumeClaims int64 `json:"persistentVolumeClaims"`
+	// The last time the usage has been changed.
+	LastUpdateTime metav1.Time `json:"lastUpdateTime,omitempty"`
+}
+
+// Equal returns true if the given usage reports the same amounts, regardless of the update time.
+func (in *TenantUsage) Equal(other *TenantUsage) bool {
+	if in == nil || other == nil {
+		return in == other
+	}
+
+	return in.CPU.Cmp(other.CPU) == 0 &&
+		in.Memory.Cmp(other.Memory) == 0 &&
+		in.Storage.Cmp(other.Storage) == 0 &&
+		in.Pods == other.Pods &&
+		in.LoadBalancers == other.LoadBalancers &&
+		in.PersistentVolumeClaims == other.PersistentVolumeClaims
 }
@@ -13,12 +13,21 @@ import (
 type TenantSpec struct {
 	// Specifies the owners of the Tenant. Mandatory.
 	Owners OwnerListSpec `json:"owners"`
+	// Specifies the name of the parent Tenant. The Tenant inherits the trusted container registries, StorageClasses, Ingress options, node selector, and LimitRanges of the parent, and can only narrow them: the Namespace quota and the Tenant-scoped ResourceQuota budget are carved out of the parent ones. Optional.
+	Parent string `json:"parent,omitempty"`
+	// Specifies the name of the TenantClass providing the default NetworkPolicies, LimitRanges, ResourceQuotas, additional RoleBindings, and Pod options: the policies specified by the Tenant take precedence over the class ones. Optional.
+	TenantClassName string `json:"tenantClassName,omitempty"`
 	// Specifies options for the Namespaces, such as additional metadata or maximum number of namespaces allowed for that Tenant. Once the namespace quota assigned to the Tenant has been reached, the Tenant owner cannot create further namespaces. Optional.
 	NamespaceOptions *NamespaceOptions `json:"namespaceOptions,omitempty"`
+	// Specifies the existing Namespaces adopted by the Tenant, selected by labels or by name: the unowned ones are assigned to the Tenant,
+	// which applies its policies to them, while the ones belonging to another Tenant are refused. Optional.
+	Adoption *api.NamespaceAdoptionSpec `json:"adoption,omitempty"`
 	// Specifies options for the Service, such as additional metadata or block of certain type of Services. Optional.
 	ServiceOptions *api.ServiceOptions `json:"serviceOptions,omitempty"`
 	// Specifies options for the Pods deployed in the Tenant namespaces, such as additional metadata.
 	PodOptions *api.PodOptions `json:"podOptions,omitempty"`
+	// Specifies the volumes the Pods in the Tenant can use, such as the allowed types, host paths, CSI drivers, and NFS servers. Optional.
+	VolumeOptions *api.VolumeOptions `json:"volumeOptions,omitempty"`
 	// Specifies the allowed StorageClasses assigned to the Tenant.
 	// Capsule assures that all PersistentVolumeClaim resources created in the Tenant can use only one of the allowed StorageClasses.
 	// A default value can be specified, and all the PersistentVolumeClaim resources created will inherit the declared class.
@@ -28,8 +37,22 @@ type TenantSpec struct {
 	IngressOptions IngressOptions `json:"ingressOptions,omitempty"`
 	// Specifies the trusted Image Registries assigned to the Tenant. Capsule assures that all Pods resources created in the Tenant can use only one of the allowed trusted registries. Optional.
 	ContainerRegistries *api.AllowedListSpec `json:"containerRegistries,omitempty"`
+	// Specifies the policy applied to the container images of the Pods in the Tenant: allowed repositories, denied images,
+	// and tag or digest pinning. Optional.
+	ImagePolicy *api.ImagePolicySpec `json:"imagePolicy,omitempty"`
+	// Specifies the mirrors the container images of the Pods in the Tenant are pulled from: upon Pod creation,
+	// the images hosted by a source registry are rewritten to the matching mirror, before enforcing the trusted registries. Optional.
+	// +listType=map
+	// +listMapKey=source
+	RegistryMirrors []api.RegistryMirrorSpec `json:"registryMirrors,omitempty"`
+	// Specifies the Pod Security Standards applied to the Tenant Namespaces through the Pod Security Admission labels,
+	// which the Tenant Owners cannot lower, along with the optional restrictions validated by Capsule. Optional.
+	PodSecurity *api.PodSecuritySpec `json:"podSecurity,omitempty"`
 	// Specifies the label to control the placement of pods on a given pool of worker nodes. All namespaces created within the Tenant will have the node selector annotation. This annotation tells the Kubernetes scheduler to place pods on the nodes having the selector label. Optional.
 	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+	// Specifies the pool of worker nodes dedicated to the Tenant: the node selector, the required node affinity, and the tolerations
+	// are injected into the Pods, while the Pods of the other Tenants cannot tolerate the reserved taints. Optional.
+	NodePool *api.NodePoolSpec `json:"nodePool,omitempty"`
 	// Specifies the NetworkPolicies assigned to the Tenant. The assigned NetworkPolicies are inherited by any namespace created in the Tenant. Optional.
 	NetworkPolicies api.NetworkPolicySpec `json:"networkPolicies,omitempty"`
 	// Specifies the resource min/max usage restrictions to the Tenant. The assigned values are inherited by any namespace created in the Tenant. Optional.
@@ -54,6 +77,9 @@ type TenantSpec struct {
 	// Prevent accidental deletion of the Tenant.
 	// When enabled, the deletion request will be declined.
 	PreventDeletion bool `json:"preventDeletion,omitempty"`
+	// Specifies how the Tenant policies are enforced: requests violating them can be denied, allowed with a warning,
+	// or allowed recording the violation. Optional.
+	Enforcement *api.EnforcementSpec `json:"enforcement,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -61,8 +87,11 @@ type TenantSpec struct {
 // +kubebuilder:subresource:status
 // +kubebuilder:resource:scope=Cluster,shortName=tnt
 // +kubebuilder:printcolumn:name="State",type="string",JSONPath=".status.state",description="The actual state of the Tenant"
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].status",description="Reports if all the Tenant resources have been reconciled"
 // +kubebuilder:printcolumn:name="Namespace quota",type="integer",JSONPath=".spec.namespaceOptions.quota",description="The max amount of Namespaces can be created"
 // +kubebuilder:printcolumn:name="Namespace count",type="integer",JSONPath=".status.size",description="The total amount of Namespaces in use"
+// +kubebuilder:printcolumn:name="Parent",type="string",JSONPath=".spec.parent",description="The parent Tenant",priority=1
+// +kubebuilder:printcolumn:name="Class",type="string",JSONPath=".spec.tenantClassName",description="The TenantClass of the Tenant",priority=1
 // +kubebuilder:printcolumn:name="Node selector",type="string",JSONPath=".spec.nodeSelector",description="Node Selector applied to Pods"
 // +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp",description="Age"
 
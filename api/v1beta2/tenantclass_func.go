@@ -0,0 +1,40 @@
+// Copyright 2020-2023 Project Capsule Authors.
+// SPDX-License-Identifier: Apache-2.0
+
+package v1beta2
+
+import (
+	"github.com/projectcapsule/capsule/pkg/api"
+)
+
+// ApplyClass merges the defaults of the given TenantClass into the Tenant specification:
+// each policy is taken from the class only if the Tenant doesn't specify it on its own.
+func (in *Tenant) ApplyClass(class *TenantClass) {
+	if len(in.Spec.NetworkPolicies.Items) == 0 && len(class.Spec.NetworkPolicies.Items) > 0 {
+		in.Spec.NetworkPolicies.Items = class.Spec.NetworkPolicies.DeepCopy().Items
+	}
+
+	if in.Spec.NetworkPolicies.Presets == nil && class.Spec.NetworkPolicies.Presets != nil {
+		in.Spec.NetworkPolicies.Presets = class.Spec.NetworkPolicies.Presets.DeepCopy()
+	}
+
+	if len(in.Spec.LimitRanges.Items) == 0 {
+		in.Spec.LimitRanges = *class.Spec.LimitRanges.DeepCopy()
+	}
+
+	if len(in.Spec.ResourceQuota.Items) == 0 {
+		in.Spec.ResourceQuota = *class.Spec.ResourceQuota.DeepCopy()
+	}
+
+	if len(in.Spec.AdditionalRoleBindings) == 0 && len(class.Spec.AdditionalRoleBindings) > 0 {
+		in.Spec.AdditionalRoleBindings = make([]api.AdditionalRoleBindingsSpec, 0, len(class.Spec.AdditionalRoleBindings))
+
+		for _, rb := range class.Spec.AdditionalRoleBindings {
+			in.Spec.AdditionalRoleBindings = append(in.Spec.AdditionalRoleBindings, *rb.DeepCopy())
+		}
+	}
+
+	if in.Spec.PodOptions == nil && class.Spec.PodOptions != nil {
+		in.Spec.PodOptions = class.Spec.PodOptions.DeepCopy()
+	}
+}
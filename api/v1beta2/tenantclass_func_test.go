@@ -0,0 +1,55 @@
+// Copyright 2020-2023 Project Capsule Authors.
+// SPDX-License-Identifier: Apache-2.0
+
+package v1beta2
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/projectcapsule/capsule/pkg/api"
+)
+
+func TestTenant_ApplyClass(t *testing.T) {
+	class := &TenantClass{
+		Spec: TenantClassSpec{
+			LimitRanges: api.LimitRangesSpec{Items: []corev1.LimitRangeSpec{
+				{Limits: []corev1.LimitRangeItem{{Type: corev1.LimitTypePod}}},
+			}},
+			ResourceQuota: api.ResourceQuotaSpec{
+				Scope: api.ResourceQuotaScopeNamespace,
+				Items: []corev1.ResourceQuotaSpec{
+					{Hard: corev1.ResourceList{corev1.ResourceLimitsCPU: resource.MustParse("8")}},
+				},
+			},
+			NetworkPolicies:        api.NetworkPolicySpec{Presets: &api.NetworkPolicyPresets{TenantIsolation: true}},
+			AdditionalRoleBindings: []api.AdditionalRoleBindingsSpec{{ClusterRoleName: "view"}},
+			PodOptions:             &api.PodOptions{AdditionalMetadata: &api.AdditionalMetadataSpec{Labels: map[string]string{"class": "gold"}}},
+		},
+	}
+
+	tnt := &Tenant{
+		Spec: TenantSpec{
+			LimitRanges: api.LimitRangesSpec{Items: []corev1.LimitRangeSpec{
+				{Limits: []corev1.LimitRangeItem{{Type: corev1.LimitTypeContainer}}},
+			}},
+		},
+	}
+
+	tnt.ApplyClass(class)
+
+	assert.Len(t, tnt.Spec.LimitRanges.Items, 1)
+	assert.Equal(t, corev1.LimitTypeContainer, tnt.Spec.LimitRanges.Items[0].Limits[0].Type)
+	assert.Equal(t, api.ResourceQuotaScopeNamespace, tnt.Spec.ResourceQuota.Scope)
+	assert.Len(t, tnt.Spec.ResourceQuota.Items, 1)
+	assert.Equal(t, "view", tnt.Spec.AdditionalRoleBindings[0].ClusterRoleName)
+	assert.Equal(t, "gold", tnt.Spec.PodOptions.AdditionalMetadata.Labels["class"])
+	assert.Empty(t, tnt.Spec.NetworkPolicies.Items)
+	assert.True(t, tnt.Spec.NetworkPolicies.Presets.TenantIsolation)
+
+	tnt.Spec.PodOptions.AdditionalMetadata.Labels["class"] = "silver"
+	assert.Equal(t, "gold", class.Spec.PodOptions.AdditionalMetadata.Labels["class"])
+}
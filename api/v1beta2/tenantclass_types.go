@@ -0,0 +1,50 @@
+// Copyright 2020-2023 Project Capsule Authors.
+// SPDX-License-Identifier: Apache-2.0
+
+package v1beta2
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/projectcapsule/capsule/pkg/api"
+)
+
+// TenantClassSpec defines the default policies applied to the Tenants referencing the class.
+// A Tenant specifying a policy on its own takes precedence over the class one.
+type TenantClassSpec struct {
+	// Specifies the default NetworkPolicies assigned to the Tenants of the class. Optional.
+	NetworkPolicies api.NetworkPolicySpec `json:"networkPolicies,omitempty"`
+	// Specifies the default resource min/max usage restrictions assigned to the Tenants of the class. Optional.
+	LimitRanges api.LimitRangesSpec `json:"limitRanges,omitempty"`
+	// Specifies the default ResourceQuota resources assigned to the Tenants of the class. Optional.
+	ResourceQuota api.ResourceQuotaSpec `json:"resourceQuotas,omitempty"`
+	// Specifies the default additional RoleBindings assigned to the Tenants of the class. Optional.
+	AdditionalRoleBindings []api.AdditionalRoleBindingsSpec `json:"additionalRoleBindings,omitempty"`
+	// Specifies the default options for the Pods deployed in the Namespaces of the Tenants of the class. Optional.
+	PodOptions *api.PodOptions `json:"podOptions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster,shortName=tntc
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp",description="Age"
+
+// TenantClass is the Schema for the tenantclasses API.
+type TenantClass struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec TenantClassSpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// TenantClassList contains a list of TenantClass.
+type TenantClassList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []TenantClass `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&TenantClass{}, &TenantClassList{})
+}
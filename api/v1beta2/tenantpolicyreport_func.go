@@ -0,0 +1,121 @@
+// Copyright 2020-2023 Project Capsule Authors.
+// SPDX-License-Identifier: Apache-2.0
+
+package v1beta2
+
+import (
+	"sort"
+)
+
+// TenantPolicyReportMaxAdmissionResults is the maximum number of admission results kept in the report:
+// the oldest ones are discarded first.
+const TenantPolicyReportMaxAdmissionResults = 100
+
+// TenantPolicyReportMaxBackgroundResults is the maximum number of background results kept in the report,
+// preventing it from exceeding the size limit of the objects: the discarded ones are counted.
+const TenantPolicyReportMaxBackgroundResults = 100
+
+// AddAdmissionResult keeps track of a violation detected by the admission webhooks:
+// the results of the same rule, on the same resource and by the same user, are replaced by the latest one.
+func (in *TenantPolicyReport) AddAdmissionResult(result PolicyReportResult) {
+	result.Category = PolicyReportCategoryAdmission
+
+	results := make([]PolicyReportResult, 0, len(in.Results)+1)
+
+	for _, r := range in.Results {
+		if r.Category == PolicyReportCategoryAdmission && r.sameViolation(result) {
+			continue
+		}
+
+		results = append(results, r)
+	}
+
+	results = append(results, result)
+
+	var admission int
+
+	for _, r := range results {
+		if r.Category == PolicyReportCategoryAdmission {
+			admission++
+		}
+	}
+	// Results are appended chronologically, thus the first ones are the oldest
+	for i := 0; i < len(results) && admission > TenantPolicyReportMaxAdmissionResults; {
+		if results[i].Category != PolicyReportCategoryAdmission {
+			i++
+
+			continue
+		}
+
+		results = append(results[:i], results[i+1:]...)
+		admission--
+	}
+
+	in.Results = results
+	in.updateSummary()
+}
+
+// SetBackgroundResults replaces the results of the existing objects evaluation with the given ones:
+// these are sorted by policy, rule, and resource, and the ones exceeding the maximum are dropped.
+func (in *TenantPolicyReport) SetBackgroundResults(background []PolicyReportResult) {
+	results := make([]PolicyReportResult, 0, len(in.Results)+len(background))
+
+	for _, r := range in.Results {
+		if r.Category != PolicyReportCategoryBackground {
+			results = append(results, r)
+		}
+	}
+
+	sort.SliceStable(background, func(i, j int) bool {
+		return background[i].key() < background[j].key()
+	})
+
+	in.DroppedBackgroundResults = 0
+
+	if len(background) > TenantPolicyReportMaxBackgroundResults {
+		in.DroppedBackgroundResults = len(background) - TenantPolicyReportMaxBackgroundResults
+		background = background[:TenantPolicyReportMaxBackgroundResults]
+	}
+
+	for _, r := range background {
+		r.Category = PolicyReportCategoryBackground
+
+		results = append(results, r)
+	}
+
+	in.Results = results
+	in.updateSummary()
+}
+
+func (in *TenantPolicyReport) updateSummary() {
+	in.Summary = PolicyReportSummary{}
+
+	for _, r := range in.Results {
+		switch r.Result {
+		case PolicyResultPass:
+			in.Summary.Pass++
+		case PolicyResultFail:
+			in.Summary.Fail++
+		case PolicyResultWarn:
+			in.Summary.Warn++
+		case PolicyResultError:
+			in.Summary.Error++
+		case PolicyResultSkip:
+			in.Summary.Skip++
+		}
+	}
+}
+
+func (in PolicyReportResult) sameViolation(other PolicyReportResult) bool {
+	return in.key() == other.key() && in.Properties[PolicyReportPropertyUser] == other.Properties[PolicyReportPropertyUser]
+}
+
+func (in PolicyReportResult) key() (key string) {
+	key = in.Policy + "/" + in.Rule
+
+	for _, r := range in.Resources {
+		key += "/" + r.Kind + "/" + r.Namespace + "/" + r.Name
+	}
+
+	return key
+}
@@ -0,0 +1,84 @@
+// Copyright 2020-2023 Project Capsule Authors.
+// SPDX-License-Identifier: Apache-2.0
+
+package v1beta2
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestTenantPolicyReport_AddAdmissionResult(t *testing.T) {
+	result := func(name, user string, outcome PolicyResult) PolicyReportResult {
+		return PolicyReportResult{
+			Policy:     "pods",
+			Rule:       "ForbiddenContainerRegistry",
+			Result:     outcome,
+			Resources:  []corev1.ObjectReference{{Kind: "Pod", Namespace: "oil-production", Name: name}},
+			Properties: map[string]string{PolicyReportPropertyUser: user},
+		}
+	}
+
+	report := &TenantPolicyReport{}
+
+	report.AddAdmissionResult(result("nginx", "alice", PolicyResultFail))
+	report.AddAdmissionResult(result("nginx", "bob", PolicyResultFail))
+	// Replacing the previous violation of the same user
+	report.AddAdmissionResult(result("nginx", "alice", PolicyResultWarn))
+
+	assert.Len(t, report.Results, 2)
+	assert.Equal(t, "bob", report.Results[0].Properties[PolicyReportPropertyUser])
+	assert.Equal(t, PolicyResultWarn, report.Results[1].Result)
+	assert.Equal(t, PolicyReportCategoryAdmission, report.Results[1].Category)
+	assert.Equal(t, PolicyReportSummary{Fail: 1, Warn: 1}, report.Summary)
+
+	report.SetBackgroundResults([]PolicyReportResult{result("redis", "", PolicyResultFail)})
+
+	for i := 0; i < TenantPolicyReportMaxAdmissionResults; i++ {
+		report.AddAdmissionResult(result(fmt.Sprintf("pod-%d", i), "alice", PolicyResultFail))
+	}
+	// The oldest admission results are discarded, the background ones are kept
+	assert.Len(t, report.Results, TenantPolicyReportMaxAdmissionResults+1)
+	assert.Equal(t, PolicyReportCategoryBackground, report.Results[0].Category)
+	assert.Equal(t, "pod-0", report.Results[1].Resources[0].Name)
+	assert.Equal(t, PolicyReportSummary{Fail: TenantPolicyReportMaxAdmissionResults + 1}, report.Summary)
+}
+
+func TestTenantPolicyReport_SetBackgroundResults(t *testing.T) {
+	report := &TenantPolicyReport{}
+
+	report.AddAdmissionResult(PolicyReportResult{Policy: "services", Rule: "ForbiddenNodePort", Result: PolicyResultFail})
+	report.SetBackgroundResults([]PolicyReportResult{
+		{Policy: "pods", Rule: "ForbiddenContainerRegistry", Result: PolicyResultFail},
+		{Policy: "ingresses", Rule: "ForbiddenIngressHostname", Result: PolicyResultFail},
+	})
+
+	assert.Len(t, report.Results, 3)
+	assert.Equal(t, "ingresses", report.Results[1].Policy)
+
+	report.SetBackgroundResults(nil)
+
+	assert.Len(t, report.Results, 1)
+	assert.Equal(t, PolicyReportCategoryAdmission, report.Results[0].Category)
+	assert.Equal(t, PolicyReportSummary{Fail: 1}, report.Summary)
+
+	// The background results exceeding the maximum are dropped, and counted
+	background := make([]PolicyReportResult, 0, TenantPolicyReportMaxBackgroundResults+5)
+
+	for i := 0; i < TenantPolicyReportMaxBackgroundResults+5; i++ {
+		background = append(background, PolicyReportResult{Policy: "pods", Rule: "ForbiddenContainerRegistry", Result: PolicyResultFail, Resources: []corev1.ObjectReference{{Kind: "Pod", Name: fmt.Sprintf("pod-%03d", i)}}})
+	}
+
+	report.SetBackgroundResults(background)
+
+	assert.Len(t, report.Results, TenantPolicyReportMaxBackgroundResults+1)
+	assert.Equal(t, 5, report.DroppedBackgroundResults)
+	assert.Equal(t, "pod-099", report.Results[len(report.Results)-1].Resources[0].Name)
+
+	report.SetBackgroundResults(nil)
+
+	assert.Zero(t, report.DroppedBackgroundResults)
+}
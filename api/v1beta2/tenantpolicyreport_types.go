@@ -0,0 +1,113 @@
+// Copyright 2020-2023 Project Capsule Authors.
+// SPDX-License-Identifier: Apache-2.0
+
+package v1beta2
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// PolicyReportSource is the source of the results reported by Capsule.
+	PolicyReportSource = "capsule"
+	// PolicyReportCategoryAdmission is the category of the violations detected by the admission webhooks.
+	PolicyReportCategoryAdmission = "Admission"
+	// PolicyReportCategoryBackground is the category of the existing objects no more complying with the Tenant policies.
+	PolicyReportCategoryBackground = "Background"
+)
+
+const (
+	// PolicyReportPropertyUser is the user issuing the request violating the policy.
+	PolicyReportPropertyUser = "user"
+	// PolicyReportPropertyOperation is the operation of the request violating the policy.
+	PolicyReportPropertyOperation = "operation"
+	// PolicyReportPropertyMode is the enforcement mode applied to the violation.
+	PolicyReportPropertyMode = "mode"
+	// PolicyReportPropertyRemediation is the remediation action applied to the existing object violating the policy.
+	PolicyReportPropertyRemediation = "remediation"
+)
+
+// +kubebuilder:validation:Enum=pass;fail;warn;error;skip
+type PolicyResult string
+
+const (
+	PolicyResultPass  PolicyResult = "pass"
+	PolicyResultFail  PolicyResult = "fail"
+	PolicyResultWarn  PolicyResult = "warn"
+	PolicyResultError PolicyResult = "error"
+	PolicyResultSkip  PolicyResult = "skip"
+)
+
+// PolicyReportSummary provides a summary of the results, by their outcome.
+type PolicyReportSummary struct {
+	// Number of the results complying with the policies.
+	Pass int `json:"pass"`
+	// Number of the results not complying with the policies.
+	Fail int `json:"fail"`
+	// Number of the results not complying with the policies, allowed due to the enforcement mode.
+	Warn int `json:"warn"`
+	// Number of the results that could not be evaluated.
+	Error int `json:"error"`
+	// Number of the results skipped.
+	Skip int `json:"skip"`
+}
+
+// PolicyReportResult is the outcome of the evaluation of a policy against a resource.
+type PolicyReportResult struct {
+	// Source of the result, such as capsule.
+	Source string `json:"source,omitempty"`
+	// Name of the policy, such as the plural name of the evaluated resources.
+	Policy string `json:"policy"`
+	// Rule of the policy, such as the reason of the event emitted upon violation.
+	Rule string `json:"rule,omitempty"`
+	// Category of the result: Admission for the requests evaluated by the webhooks,
+	// Background for the existing objects evaluated upon the Tenant changes.
+	Category string `json:"category,omitempty"`
+	// Time the result has been detected.
+	Timestamp metav1.Timestamp `json:"timestamp,omitempty"`
+	// Outcome of the evaluation.
+	Result PolicyResult `json:"result,omitempty"`
+	// Resources the result refers to.
+	Resources []corev1.ObjectReference `json:"resources,omitempty"`
+	// Description of the result.
+	Message string `json:"message,omitempty"`
+	// Additional information, such as the user issuing the request, and the enforcement mode.
+	Properties map[string]string `json:"properties,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster,shortName=tntpolr
+// +kubebuilder:printcolumn:name="Pass",type="integer",JSONPath=".summary.pass",description="Results complying with the policies"
+// +kubebuilder:printcolumn:name="Fail",type="integer",JSONPath=".summary.fail",description="Results not complying with the policies"
+// +kubebuilder:printcolumn:name="Warn",type="integer",JSONPath=".summary.warn",description="Results not complying with the policies, allowed due to the enforcement mode"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp",description="Age"
+
+// TenantPolicyReport accumulates the policy violations of a Tenant, named after it: both the requests evaluated by the admission webhooks,
+// and the existing objects no more complying with the Tenant policies. The schema follows the wg-policy PolicyReport one.
+type TenantPolicyReport struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Reference to the Tenant the report refers to.
+	Scope *corev1.ObjectReference `json:"scope,omitempty"`
+	// Summary of the results.
+	Summary PolicyReportSummary `json:"summary,omitempty"`
+	// Results of the policies evaluation.
+	Results []PolicyReportResult `json:"results,omitempty"`
+	// Number of the background results not kept in the report, since exceeding the maximum number of results.
+	DroppedBackgroundResults int `json:"droppedBackgroundResults,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// TenantPolicyReportList contains a list of TenantPolicyReport.
+type TenantPolicyReportList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []TenantPolicyReport `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&TenantPolicyReport{}, &TenantPolicyReportList{})
+}
@@ -0,0 +1,98 @@
+// Copyright 2020-2023 Project Capsule Authors.
+// SPDX-License-Identifier: Apache-2.0
+
+package v1beta2
+
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// TenantQuotaMinResyncPeriod is the minimum period of time upon the objects in the Tenant Namespaces are counted again.
+const TenantQuotaMinResyncPeriod = 10 * time.Second
+
+// TenantQuotaSpec defines the desired state of TenantQuota.
+type TenantQuotaSpec struct {
+	// Name of the Tenant the quota is applied to.
+	TenantName string `json:"tenantName"`
+	// Define the period of time upon the objects in the Tenant Namespaces are counted again,
+	// fixing any drift due to failed admission requests, or deletions bypassing the webhook: it cannot be lower than 10s.
+	// +kubebuilder:default="60s"
+	// +kubebuilder:validation:XValidation:rule="duration(self) >= duration('10s')",message="the resync period cannot be lower than 10s"
+	ResyncPeriod metav1.Duration `json:"resyncPeriod"`
+	// List of the resources limited across all the Tenant Namespaces.
+	// +kubebuilder:validation:MinItems=1
+	Resources []TenantQuotaResource `json:"resources"`
+}
+
+type TenantQuotaResource struct {
+	// API group of the limited resource, empty for the core one.
+	Group string `json:"group,omitempty"`
+	// API version of the limited resource, used to count the objects.
+	Version string `json:"version"`
+	// Kind of the limited resource.
+	Kind string `json:"kind"`
+	// The maximum amount of objects of the given kind allowed across all the Tenant Namespaces.
+	// +kubebuilder:validation:Minimum=0
+	Hard int64 `json:"hard"`
+}
+
+func (in TenantQuotaResource) GroupKind() schema.GroupKind {
+	return schema.GroupKind{Group: in.Group, Kind: in.Kind}
+}
+
+// TenantQuotaStatus defines the observed state of TenantQuota.
+type TenantQuotaStatus struct {
+	// The last time the objects in the Tenant Namespaces have been counted.
+	LastCountTime metav1.Time `json:"lastCountTime,omitempty"`
+	// Used and hard amounts of the limited resources.
+	Resources []TenantQuotaResourceStatus `json:"resources,omitempty"`
+}
+
+type TenantQuotaResourceStatus struct {
+	TenantQuotaResource `json:",inline"`
+	// The amount of objects of the given kind across all the Tenant Namespaces.
+	Used int64 `json:"used"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,shortName=tntq
+// +kubebuilder:printcolumn:name="Tenant",type="string",JSONPath=".spec.tenantName",description="The Tenant the quota is applied to"
+// +kubebuilder:printcolumn:name="Last count",type="date",JSONPath=".status.lastCountTime",description="The last time the objects have been counted"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp",description="Age"
+
+// TenantQuota limits the amount of objects of any kind across all the Namespaces of a Tenant.
+type TenantQuota struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TenantQuotaSpec   `json:"spec,omitempty"`
+	Status TenantQuotaStatus `json:"status,omitempty"`
+}
+
+// GetUsed returns the amount of objects of the given kind counted in the last resync.
+func (in *TenantQuota) GetUsed(gk schema.GroupKind) int64 {
+	for _, res := range in.Status.Resources {
+		if res.GroupKind() == gk {
+			return res.Used
+		}
+	}
+
+	return 0
+}
+
+// +kubebuilder:object:root=true
+
+// TenantQuotaList contains a list of TenantQuota.
+type TenantQuotaList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []TenantQuota `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&TenantQuota{}, &TenantQuotaList{})
+}
@@ -0,0 +1,49 @@
+// Copyright 2020-2023 Project Capsule Authors.
+// SPDX-License-Identifier: Apache-2.0
+
+package v1beta2
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// TenantUsageReportSpec defines the usage snapshot of a Tenant.
+type TenantUsageReportSpec struct {
+	// Name of the Tenant the usage refers to.
+	TenantName string `json:"tenantName"`
+	// The time the usage has been collected.
+	Timestamp metav1.Time `json:"timestamp"`
+	// Consolidated usage of the resources across all the Tenant Namespaces.
+	Usage TenantUsage `json:"usage"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster,shortName=tntur
+// +kubebuilder:printcolumn:name="Tenant",type="string",JSONPath=".spec.tenantName",description="The Tenant the usage refers to"
+// +kubebuilder:printcolumn:name="CPU",type="string",JSONPath=".spec.usage.cpu",description="Requested CPU"
+// +kubebuilder:printcolumn:name="Memory",type="string",JSONPath=".spec.usage.memory",description="Requested memory"
+// +kubebuilder:printcolumn:name="Storage",type="string",JSONPath=".spec.usage.storage",description="Requested storage"
+// +kubebuilder:printcolumn:name="Pods",type="integer",JSONPath=".spec.usage.pods",description="Running Pods"
+// +kubebuilder:printcolumn:name="Timestamp",type="date",JSONPath=".spec.timestamp",description="The time the usage has been collected"
+
+// TenantUsageReport is a snapshot of the Tenant usage, periodically created by Capsule for chargeback purposes.
+// Reports are kept upon the Tenant deletion, allowing to bill its last period.
+type TenantUsageReport struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec TenantUsageReportSpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// TenantUsageReportList contains a list of TenantUsageReport.
+type TenantUsageReportList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []TenantUsageReport `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&TenantUsageReport{}, &TenantUsageReportList{})
+}
@@ -0,0 +1,292 @@
+// Copyright 2020-2023 Project Capsule Authors.
+// SPDX-License-Identifier: Apache-2.0
+
+package compliance
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	"golang.org/x/sync/errgroup"
+	admissionv1 "k8s.io/api/admission/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	capsulev1beta2 "github.com/projectcapsule/capsule/api/v1beta2"
+	"github.com/projectcapsule/capsule/pkg/api"
+	"github.com/projectcapsule/capsule/pkg/policyreport"
+	capsulewebhook "github.com/projectcapsule/capsule/pkg/webhook"
+)
+
+// Manager evaluates the existing objects of the Tenant Namespaces upon the Tenant changes, using the same handlers of the
+// admission webhooks: the objects no more complying with the Tenant policies are reported in the TenantPolicyReport,
+// along with the remediation action applied to them, if any.
+type Manager struct {
+	client.Client
+	Log      logr.Logger
+	Recorder record.EventRecorder
+	Targets  []Target
+
+	decoder *admission.Decoder
+}
+
+// evaluation is the outcome of the evaluation of an existing object.
+type evaluation struct {
+	target     Target
+	object     client.Object
+	violations []violation
+	err        error
+	// remediation is the action applied to the object, or to the workload managing it
+	remediation api.RemediationAction
+}
+
+type violation struct {
+	reason  string
+	message string
+	mode    api.EnforcementMode
+}
+
+func (r *Manager) SetupWithManager(mgr ctrl.Manager) error {
+	r.decoder = admission.NewDecoder(mgr.GetScheme())
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named("compliance").
+		For(&capsulev1beta2.Tenant{}, builder.WithPredicates(predicate.Or(predicate.GenerationChangedPredicate{}, predicate.Funcs{
+			UpdateFunc: func(e event.UpdateEvent) bool {
+				oldTnt, oldOk := e.ObjectOld.(*capsulev1beta2.Tenant)
+				newTnt, newOk := e.ObjectNew.(*capsulev1beta2.Tenant)
+
+				return !oldOk || !newOk || !sets.New[string](oldTnt.Status.Namespaces...).Equal(sets.New[string](newTnt.Status.Namespaces...))
+			},
+		}))).
+		Complete(r)
+}
+
+func (r *Manager) Reconcile(ctx context.Context, request ctrl.Request) (ctrl.Result, error) {
+	log := r.Log.WithValues("Request.Name", request.Name)
+
+	tnt := &capsulev1beta2.Tenant{}
+	if err := r.Get(ctx, request.NamespacedName, tnt); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.Info("Request object not found, could have been deleted after reconcile request")
+
+			return reconcile.Result{}, nil
+		}
+
+		log.Error(err, "Error reading the object")
+
+		return reconcile.Result{}, err
+	}
+
+	var (
+		mu          sync.Mutex
+		evaluations []evaluation
+	)
+
+	group := new(errgroup.Group)
+
+	for _, ns := range tnt.Status.Namespaces {
+		namespace := ns
+
+		group.Go(func() error {
+			nsEvaluations, err := r.evaluateNamespace(ctx, tnt, namespace)
+			if err != nil {
+				return err
+			}
+
+			if action := tnt.Spec.Enforcement.GetRemediation(); action != api.RemediationActionNone {
+				r.remediate(ctx, tnt, action, nsEvaluations)
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			evaluations = append(evaluations, nsEvaluations...)
+
+			return nil
+		})
+	}
+
+	if err := group.Wait(); err != nil {
+		log.Error(err, "Cannot evaluate the existing objects")
+
+		return reconcile.Result{}, err
+	}
+
+	results := backgroundResults(evaluations, time.Now())
+
+	if err := policyreport.Update(ctx, r.Client, tnt, func(report *capsulev1beta2.TenantPolicyReport) {
+		report.SetBackgroundResults(results)
+	}); err != nil {
+		log.Error(err, "Cannot update the TenantPolicyReport")
+
+		return reconcile.Result{}, err
+	}
+
+	log.Info("Existing objects evaluation completed", "violations", len(results))
+
+	return reconcile.Result{}, nil
+}
+
+// evaluateNamespace evaluates the existing objects of the given Namespace, skipping the ones being deleted.
+func (r *Manager) evaluateNamespace(ctx context.Context, tnt *capsulev1beta2.Tenant, namespace string) (evaluations []evaluation, err error) {
+	for _, target := range r.Targets {
+		list := target.NewList()
+		if err = r.List(ctx, list, client.InNamespace(namespace)); err != nil {
+			return nil, err
+		}
+
+		var items []runtime.Object
+
+		if items, err = meta.ExtractList(list); err != nil {
+			return nil, err
+		}
+
+		for _, item := range items {
+			obj, ok := item.(client.Object)
+			if !ok || obj.GetDeletionTimestamp() != nil {
+				continue
+			}
+
+			evaluations = append(evaluations, r.evaluate(ctx, tnt, target, obj))
+		}
+	}
+
+	return evaluations, nil
+}
+
+// evaluate runs the target handlers against the given object with a dry-run request, as if it was created again,
+// or updated with no changes according to the target operation: the enforcement mode of the violated policies is the one of the given Tenant, owning the object Namespace.
+func (r *Manager) evaluate(ctx context.Context, tnt *capsulev1beta2.Tenant, target Target, obj client.Object) evaluation {
+	result := evaluation{target: target, object: obj}
+
+	obj.GetObjectKind().SetGroupVersionKind(target.Kind)
+
+	raw, err := json.Marshal(obj)
+	if err != nil {
+		result.err = err
+
+		return result
+	}
+
+	dryRun := true
+
+	req := admission.Request{AdmissionRequest: admissionv1.AdmissionRequest{
+		UID:       obj.GetUID(),
+		Kind:      metav1.GroupVersionKind(target.Kind),
+		Resource:  metav1.GroupVersionResource(target.Resource),
+		Name:      obj.GetName(),
+		Namespace: obj.GetNamespace(),
+		Operation: target.Operation,
+		Object:    runtime.RawExtension{Raw: raw},
+		DryRun:    &dryRun,
+	}}
+
+	if target.Operation == admissionv1.Update {
+		req.OldObject = runtime.RawExtension{Raw: raw}
+	}
+
+	for _, h := range target.Handlers {
+		recorder := &reasonRecorder{}
+
+		fn := h.OnCreate
+		if target.Operation == admissionv1.Update {
+			fn = h.OnUpdate
+		}
+
+		response := fn(r.Client, r.decoder, recorder)(ctx, req)
+
+		switch {
+		case response == nil || response.Allowed:
+			continue
+		case capsulewebhook.IsPolicyViolation(*response):
+			reason := recorder.reason
+			if len(reason) == 0 {
+				reason = string(response.Result.Reason)
+			}
+
+			result.violations = append(result.violations, violation{
+				reason:  reason,
+				message: response.Result.Message,
+				mode:    capsulewebhook.EnforcementModeFor(tnt, reason),
+			})
+		default:
+			result.err = fmt.Errorf("cannot evaluate the object: %s", response.Result.Message)
+
+			return result
+		}
+	}
+
+	return result
+}
+
+// backgroundResults returns the TenantPolicyReport results of the evaluations: only the violations and the errors are reported.
+func backgroundResults(evaluations []evaluation, now time.Time) (results []capsulev1beta2.PolicyReportResult) {
+	timestamp := metav1.Timestamp{Seconds: now.Unix(), Nanos: int32(now.Nanosecond())}
+
+	for _, e := range evaluations {
+		resources := []corev1.ObjectReference{
+			{
+				APIVersion: e.target.Kind.GroupVersion().String(),
+				Kind:       e.target.Kind.Kind,
+				Namespace:  e.object.GetNamespace(),
+				Name:       e.object.GetName(),
+				UID:        e.object.GetUID(),
+			},
+		}
+
+		if e.err != nil {
+			results = append(results, capsulev1beta2.PolicyReportResult{
+				Source:    capsulev1beta2.PolicyReportSource,
+				Policy:    e.target.Resource.Resource,
+				Timestamp: timestamp,
+				Result:    capsulev1beta2.PolicyResultError,
+				Resources: resources,
+				Message:   e.err.Error(),
+			})
+
+			continue
+		}
+
+		for _, v := range e.violations {
+			result := capsulev1beta2.PolicyReportResult{
+				Source:    capsulev1beta2.PolicyReportSource,
+				Policy:    e.target.Resource.Resource,
+				Rule:      v.reason,
+				Timestamp: timestamp,
+				Result:    capsulev1beta2.PolicyResultFail,
+				Resources: resources,
+				Message:   v.message,
+				Properties: map[string]string{
+					capsulev1beta2.PolicyReportPropertyMode: v.mode.String(),
+				},
+			}
+
+			if v.mode != api.EnforcementModeEnforce {
+				result.Result = capsulev1beta2.PolicyResultWarn
+			} else if len(e.remediation) > 0 {
+				result.Properties[capsulev1beta2.PolicyReportPropertyRemediation] = e.remediation.String()
+			}
+
+			results = append(results, result)
+		}
+	}
+
+	return results
+}
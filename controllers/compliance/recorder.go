@@ -0,0 +1,33 @@
+// Copyright 2020-2023 Project Capsule Authors.
+// SPDX-License-Identifier: Apache-2.0
+
+package compliance
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// reasonRecorder keeps track of the reason of the last warning event emitted by a handler, without emitting it:
+// the violations of the existing objects are reported in the TenantPolicyReport, rather than with events.
+type reasonRecorder struct {
+	reason string
+}
+
+func (r *reasonRecorder) Event(_ runtime.Object, eventtype, reason, _ string) {
+	r.track(eventtype, reason)
+}
+
+func (r *reasonRecorder) Eventf(_ runtime.Object, eventtype, reason, _ string, _ ...interface{}) {
+	r.track(eventtype, reason)
+}
+
+func (r *reasonRecorder) AnnotatedEventf(_ runtime.Object, _ map[string]string, eventtype, reason, _ string, _ ...interface{}) {
+	r.track(eventtype, reason)
+}
+
+func (r *reasonRecorder) track(eventtype, reason string) {
+	if eventtype == corev1.EventTypeWarning {
+		r.reason = reason
+	}
+}
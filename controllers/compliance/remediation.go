@@ -0,0 +1,218 @@
+// Copyright 2020-2023 Project Capsule Authors.
+// SPDX-License-Identifier: Apache-2.0
+
+package compliance
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	capsulev1beta2 "github.com/projectcapsule/capsule/api/v1beta2"
+	"github.com/projectcapsule/capsule/pkg/api"
+)
+
+// scalableKinds are the workloads scaled to zero by the ScaleToZero remediation action.
+var scalableKinds = sets.New[schema.GroupKind](
+	appsv1.SchemeGroupVersion.WithKind("Deployment").GroupKind(),
+	appsv1.SchemeGroupVersion.WithKind("StatefulSet").GroupKind(),
+	appsv1.SchemeGroupVersion.WithKind("ReplicaSet").GroupKind(),
+)
+
+// workloadControllers are the controllers climbed from the Pods to remediate their workloads, for each kind:
+// the objects controlled by any other kind are annotated, rather than fighting with their controller.
+var workloadControllers = map[schema.GroupKind]sets.Set[schema.GroupKind]{
+	corev1.SchemeGroupVersion.WithKind("Pod").GroupKind(): sets.New[schema.GroupKind](
+		appsv1.SchemeGroupVersion.WithKind("ReplicaSet").GroupKind(),
+		appsv1.SchemeGroupVersion.WithKind("StatefulSet").GroupKind(),
+		appsv1.SchemeGroupVersion.WithKind("DaemonSet").GroupKind(),
+	),
+	appsv1.SchemeGroupVersion.WithKind("ReplicaSet").GroupKind(): sets.New[schema.GroupKind](
+		appsv1.SchemeGroupVersion.WithKind("Deployment").GroupKind(),
+	),
+}
+
+// remediation is the remediation action applied to an object, along with the evaluations referring to it:
+// Pods are remediated through their workload, since they are recreated and validated upon updates.
+type remediation struct {
+	object      *metav1.PartialObjectMetadata
+	reasons     sets.Set[string]
+	evaluations []int
+	// annotateOnly is true if the object is controlled by a kind not remediated by Capsule.
+	annotateOnly bool
+}
+
+// remediate applies the given action to the objects violating the policies in Enforce mode,
+// removing the violations annotation from the ones complying again.
+func (r *Manager) remediate(ctx context.Context, tnt *capsulev1beta2.Tenant, action api.RemediationAction, evaluations []evaluation) {
+	remediations := make(map[string]*remediation)
+
+	var keys []string
+
+	for i, e := range evaluations {
+		if e.err != nil {
+			continue
+		}
+
+		object, annotateOnly, err := r.remediationObject(ctx, e)
+		if err != nil {
+			r.Log.Error(err, "Cannot retrieve the object to remediate", "kind", e.target.Kind.Kind, "namespace", e.object.GetNamespace(), "name", e.object.GetName())
+
+			continue
+		}
+
+		key := object.GroupVersionKind().GroupKind().String() + "/" + object.GetName()
+
+		if _, ok := remediations[key]; !ok {
+			remediations[key] = &remediation{object: object, reasons: sets.New[string](), annotateOnly: annotateOnly}
+			keys = append(keys, key)
+		}
+
+		remediations[key].evaluations = append(remediations[key].evaluations, i)
+
+		for _, v := range e.violations {
+			if v.mode == api.EnforcementModeEnforce {
+				remediations[key].reasons.Insert(v.reason)
+			}
+		}
+	}
+
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		rem := remediations[key]
+
+		applied, err := r.apply(ctx, action, rem)
+		if err != nil {
+			r.Log.Error(err, "Cannot remediate the object", "kind", rem.object.Kind, "namespace", rem.object.GetNamespace(), "name", rem.object.GetName())
+
+			r.Recorder.Eventf(tnt, corev1.EventTypeWarning, "PolicyRemediationFailed", "Cannot apply %s to %s %s/%s: %s", action, rem.object.Kind, rem.object.GetNamespace(), rem.object.GetName(), err.Error())
+
+			continue
+		}
+
+		if len(applied) == 0 {
+			continue
+		}
+
+		if applied != api.RemediationActionAnnotate {
+			r.Recorder.Eventf(tnt, corev1.EventTypeNormal, "PolicyRemediation", "Applied %s to %s %s/%s violating %s", applied, rem.object.Kind, rem.object.GetNamespace(), rem.object.GetName(), strings.Join(sets.List(rem.reasons), ", "))
+		}
+
+		for _, i := range rem.evaluations {
+			evaluations[i].remediation = applied
+		}
+	}
+}
+
+// remediationObject returns the object the remediation is applied to: the evaluated one, or the workload managing it
+// in case of Pods, along with whether it is controlled by a kind not remediated by Capsule, thus it can only be annotated.
+func (r *Manager) remediationObject(ctx context.Context, e evaluation) (*metav1.PartialObjectMetadata, bool, error) {
+	object := &metav1.PartialObjectMetadata{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            e.object.GetName(),
+			Namespace:       e.object.GetNamespace(),
+			UID:             e.object.GetUID(),
+			ResourceVersion: e.object.GetResourceVersion(),
+			Annotations:     e.object.GetAnnotations(),
+			OwnerReferences: e.object.GetOwnerReferences(),
+		},
+	}
+	object.SetGroupVersionKind(e.target.Kind)
+
+	if e.target.Kind != corev1.SchemeGroupVersion.WithKind("Pod") {
+		return object, false, nil
+	}
+
+	for {
+		owner := metav1.GetControllerOf(object)
+		if owner == nil {
+			return object, false, nil
+		}
+
+		gvk := schema.FromAPIVersionAndKind(owner.APIVersion, owner.Kind)
+
+		if !workloadControllers[object.GroupVersionKind().GroupKind()].Has(gvk.GroupKind()) {
+			return object, true, nil
+		}
+
+		controller := &metav1.PartialObjectMetadata{}
+		controller.SetGroupVersionKind(gvk)
+
+		if err := r.Get(ctx, types.NamespacedName{Namespace: object.GetNamespace(), Name: owner.Name}, controller); err != nil {
+			if apierrors.IsNotFound(err) {
+				return object, false, nil
+			}
+
+			return nil, false, err
+		}
+
+		object = controller
+	}
+}
+
+// apply applies the given action to the object violating the policies, returning the applied one:
+// workloads that cannot be scaled, and the objects controlled by a kind not remediated by Capsule, are annotated.
+func (r *Manager) apply(ctx context.Context, action api.RemediationAction, rem *remediation) (api.RemediationAction, error) {
+	if rem.reasons.Len() == 0 {
+		return "", r.annotate(ctx, rem.object, "")
+	}
+
+	if rem.annotateOnly {
+		action = api.RemediationActionAnnotate
+	}
+
+	switch action {
+	case api.RemediationActionDelete:
+		if err := r.Delete(ctx, rem.object); err != nil && !apierrors.IsNotFound(err) {
+			return "", err
+		}
+
+		return api.RemediationActionDelete, nil
+	case api.RemediationActionScaleToZero:
+		if scalableKinds.Has(rem.object.GroupVersionKind().GroupKind()) {
+			if err := r.Patch(ctx, rem.object, client.RawPatch(types.MergePatchType, []byte(`{"spec":{"replicas":0}}`))); err != nil {
+				return "", err
+			}
+
+			return api.RemediationActionScaleToZero, r.annotate(ctx, rem.object, strings.Join(sets.List(rem.reasons), ","))
+		}
+
+		fallthrough
+	default:
+		return api.RemediationActionAnnotate, r.annotate(ctx, rem.object, strings.Join(sets.List(rem.reasons), ","))
+	}
+}
+
+// annotate sets the violations annotation with the given value, removing it if empty.
+func (r *Manager) annotate(ctx context.Context, object *metav1.PartialObjectMetadata, value string) error {
+	annotations := object.GetAnnotations()
+	if annotations[api.PolicyViolationsAnnotation] == value {
+		return nil
+	}
+
+	patch := client.MergeFrom(object.DeepCopy())
+
+	if annotations == nil {
+		annotations = make(map[string]string)
+	}
+
+	if len(value) == 0 {
+		delete(annotations, api.PolicyViolationsAnnotation)
+	} else {
+		annotations[api.PolicyViolationsAnnotation] = value
+	}
+
+	object.SetAnnotations(annotations)
+
+	return r.Patch(ctx, object, patch)
+}
@@ -0,0 +1,75 @@
+// Copyright 2020-2023 Project Capsule Authors.
+// SPDX-License-Identifier: Apache-2.0
+
+package compliance
+
+import (
+	admissionv1 "k8s.io/api/admission/v1"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	capsulewebhook "github.com/projectcapsule/capsule/pkg/webhook"
+)
+
+// Target is a kind of objects evaluated by the scanner, along with the webhook handlers validating it:
+// the existing objects are evaluated as if they were created again, or updated with no changes when the handlers
+// are already taking into account the state of the objects created before the policies were put in place.
+type Target struct {
+	Resource  schema.GroupVersionResource
+	Kind      schema.GroupVersionKind
+	NewList   func() client.ObjectList
+	Handlers  []capsulewebhook.Handler
+	Operation admissionv1.Operation
+}
+
+func Pods(handlers ...capsulewebhook.Handler) Target {
+	return Target{
+		Resource: corev1.SchemeGroupVersion.WithResource("pods"),
+		Kind:     corev1.SchemeGroupVersion.WithKind("Pod"),
+		NewList: func() client.ObjectList {
+			return &corev1.PodList{}
+		},
+		Handlers:  handlers,
+		Operation: admissionv1.Create,
+	}
+}
+
+func Ingresses(handlers ...capsulewebhook.Handler) Target {
+	return Target{
+		Resource: networkingv1.SchemeGroupVersion.WithResource("ingresses"),
+		Kind:     networkingv1.SchemeGroupVersion.WithKind("Ingress"),
+		NewList: func() client.ObjectList {
+			return &networkingv1.IngressList{}
+		},
+		Handlers:  handlers,
+		Operation: admissionv1.Create,
+	}
+}
+
+func Services(handlers ...capsulewebhook.Handler) Target {
+	return Target{
+		Resource: corev1.SchemeGroupVersion.WithResource("services"),
+		Kind:     corev1.SchemeGroupVersion.WithKind("Service"),
+		NewList: func() client.ObjectList {
+			return &corev1.ServiceList{}
+		},
+		Handlers: handlers,
+		// The maximum number of Services, the node ports, and the LoadBalancer class are evaluated on their changes only,
+		// otherwise the existing Services would be counted against the maximum they are already part of
+		Operation: admissionv1.Update,
+	}
+}
+
+func PersistentVolumeClaims(handlers ...capsulewebhook.Handler) Target {
+	return Target{
+		Resource: corev1.SchemeGroupVersion.WithResource("persistentvolumeclaims"),
+		Kind:     corev1.SchemeGroupVersion.WithKind("PersistentVolumeClaim"),
+		NewList: func() client.ObjectList {
+			return &corev1.PersistentVolumeClaimList{}
+		},
+		Handlers:  handlers,
+		Operation: admissionv1.Create,
+	}
+}
@@ -0,0 +1,272 @@
+// Copyright 2020-2023 Project Capsule Authors.
+// SPDX-License-Identifier: Apache-2.0
+
+package namespacerequest
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/retry"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	capsulev1beta2 "github.com/projectcapsule/capsule/api/v1beta2"
+	"github.com/projectcapsule/capsule/pkg/api"
+	"github.com/projectcapsule/capsule/pkg/configuration"
+	capsuleutils "github.com/projectcapsule/capsule/pkg/utils"
+	webhookutils "github.com/projectcapsule/capsule/pkg/webhook/utils"
+)
+
+// Manager creates the Namespaces requested by the Tenant Owners with the NamespaceRequest resources, once approved:
+// the Namespaces are created by the controller, thus bypassing the namespace quota enforced by the admission webhook.
+type Manager struct {
+	client.Client
+	Log           logr.Logger
+	Recorder      record.EventRecorder
+	Configuration configuration.Configuration
+}
+
+func (r *Manager) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&capsulev1beta2.NamespaceRequest{}).
+		Complete(r)
+}
+
+func (r *Manager) Reconcile(ctx context.Context, request ctrl.Request) (ctrl.Result, error) {
+	log := r.Log.WithValues("Request.Namespace", request.Namespace, "Request.Name", request.Name)
+
+	nsReq := &capsulev1beta2.NamespaceRequest{}
+	if err := r.Get(ctx, request.NamespacedName, nsReq); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.Info("Request object not found, could have been deleted after reconcile request")
+
+			return reconcile.Result{}, nil
+		}
+
+		log.Error(err, "Error reading the object")
+
+		return reconcile.Result{}, err
+	}
+	// Once fulfilled, the request is not processed anymore, even if changed by the Tenant Owner
+	if nsReq.IsFulfilled() || nsReq.GetDeletionTimestamp() != nil {
+		return reconcile.Result{}, nil
+	}
+
+	status := nsReq.Status.DeepCopy()
+	nsReq.ResetStaleApproval()
+
+	fulfilled, err := r.fulfill(ctx, nsReq)
+	if err != nil {
+		log.Error(err, "Cannot fulfill the NamespaceRequest")
+	}
+
+	meta.SetStatusCondition(&nsReq.Status.Conditions, fulfilled)
+
+	if updateErr := r.updateStatus(ctx, nsReq, status); updateErr != nil {
+		log.Error(updateErr, "Cannot update the NamespaceRequest status")
+
+		return reconcile.Result{}, updateErr
+	}
+
+	return reconcile.Result{}, err
+}
+
+// fulfill creates the requested Namespace, once approved, returning the Fulfilled condition reporting the outcome.
+func (r *Manager) fulfill(ctx context.Context, nsReq *capsulev1beta2.NamespaceRequest) (metav1.Condition, error) {
+	condition := func(status metav1.ConditionStatus, reason, message string) metav1.Condition {
+		return metav1.Condition{
+			Type:               capsulev1beta2.NamespaceRequestConditionFulfilled,
+			Status:             status,
+			ObservedGeneration: nsReq.GetGeneration(),
+			Reason:             reason,
+			Message:            message,
+		}
+	}
+
+	tnt, err := webhookutils.TenantByStatusNamespace(ctx, r.Client, nsReq.GetNamespace())
+	if err != nil {
+		return condition(metav1.ConditionFalse, capsulev1beta2.NamespaceRequestReasonCreationFailure, err.Error()), err
+	}
+
+	if len(tnt.GetName()) == 0 {
+		return condition(metav1.ConditionFalse, capsulev1beta2.NamespaceRequestReasonInvalid, "The NamespaceRequest must be created in a Tenant Namespace"), nil
+	}
+
+	nsReq.Status.Tenant = tnt.GetName()
+
+	if err = r.validate(tnt, nsReq); err != nil {
+		return condition(metav1.ConditionFalse, capsulev1beta2.NamespaceRequestReasonInvalid, err.Error()), nil
+	}
+
+	approval := nsReq.Approval()
+	if approval == nil && r.autoApprove(tnt) {
+		meta.SetStatusCondition(&nsReq.Status.Conditions, metav1.Condition{
+			Type:               capsulev1beta2.NamespaceRequestConditionApproved,
+			Status:             metav1.ConditionTrue,
+			ObservedGeneration: nsReq.GetGeneration(),
+			Reason:             capsulev1beta2.NamespaceRequestReasonAutoApproved,
+			Message:            fmt.Sprintf("The request fits the automatic approval quota of the Tenant %s", tnt.GetName()),
+		})
+
+		approval = nsReq.Approval()
+	}
+
+	switch {
+	case approval == nil:
+		return condition(metav1.ConditionFalse, capsulev1beta2.NamespaceRequestReasonPending, "The request is waiting for the approval of the cluster administrators"), nil
+	case approval.Status == metav1.ConditionFalse:
+		return condition(metav1.ConditionFalse, capsulev1beta2.NamespaceRequestReasonRejected, fmt.Sprintf("The request has been rejected: %s", approval.Message)), nil
+	}
+
+	ns := &corev1.Namespace{}
+	if err = r.Get(ctx, types.NamespacedName{Name: nsReq.Spec.Name}, ns); err == nil {
+		// The Namespace could have been created upon a previous reconciliation, failing the status update
+		if ns.GetAnnotations()[api.NamespaceRequestAnnotation] != requestReference(nsReq) {
+			return condition(metav1.ConditionFalse, capsulev1beta2.NamespaceRequestReasonAlreadyExists, fmt.Sprintf("The Namespace %s already exists", nsReq.Spec.Name)), nil
+		}
+
+		return condition(metav1.ConditionTrue, capsulev1beta2.NamespaceRequestReasonCreated, fmt.Sprintf("The Namespace %s has been created", ns.GetName())), nil
+	} else if !apierrors.IsNotFound(err) {
+		return condition(metav1.ConditionFalse, capsulev1beta2.NamespaceRequestReasonCreationFailure, err.Error()), err
+	}
+
+	if tnt.Spec.NamespaceOptions != nil && tnt.Spec.NamespaceOptions.Requests != nil && tnt.Spec.NamespaceOptions.Requests.RaiseQuota {
+		if nsReq.Status.QuotaRaised, err = r.raiseQuota(ctx, tnt); err != nil {
+			return condition(metav1.ConditionFalse, capsulev1beta2.NamespaceRequestReasonCreationFailure, err.Error()), err
+		}
+	}
+
+	if err = r.createNamespace(ctx, tnt, nsReq); err != nil {
+		r.Recorder.Eventf(nsReq, corev1.EventTypeWarning, capsulev1beta2.NamespaceRequestReasonCreationFailure, "Cannot create the Namespace %s: %s", nsReq.Spec.Name, err.Error())
+
+		return condition(metav1.ConditionFalse, capsulev1beta2.NamespaceRequestReasonCreationFailure, err.Error()), err
+	}
+
+	r.Recorder.Eventf(nsReq, corev1.EventTypeNormal, capsulev1beta2.NamespaceRequestReasonCreated, "Namespace %s has been created", nsReq.Spec.Name)
+	r.Recorder.Eventf(tnt, corev1.EventTypeNormal, "NamespaceRequestFulfilled", "Namespace %s has been created upon the request %s", nsReq.Spec.Name, requestReference(nsReq))
+
+	message := fmt.Sprintf("The Namespace %s has been created", nsReq.Spec.Name)
+	if nsReq.Status.QuotaRaised {
+		message += ", raising the namespace quota of the Tenant"
+	}
+
+	return condition(metav1.ConditionTrue, capsulev1beta2.NamespaceRequestReasonCreated, message), nil
+}
+
+// autoApprove returns true if the Tenant Namespaces, including the requested one, fit the automatic approval quota.
+func (r *Manager) autoApprove(tnt *capsulev1beta2.Tenant) bool {
+	if tnt.Spec.NamespaceOptions == nil || tnt.Spec.NamespaceOptions.Requests == nil || tnt.Spec.NamespaceOptions.Requests.AutoApprovalQuota == nil {
+		return false
+	}
+
+	return len(tnt.Status.Namespaces)+1 <= int(*tnt.Spec.NamespaceOptions.Requests.AutoApprovalQuota)
+}
+
+// raiseQuota raises the namespace quota of the Tenant by one when it's full, returning true if the quota has been raised:
+// upon a failed Namespace creation the Tenant is no more full, thus the quota is not raised twice.
+func (r *Manager) raiseQuota(ctx context.Context, tnt *capsulev1beta2.Tenant) (raised bool, err error) {
+	err = retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		found := &capsulev1beta2.Tenant{}
+		if err := r.Get(ctx, types.NamespacedName{Name: tnt.GetName()}, found); err != nil {
+			return err
+		}
+		// The namespace quota inherited by the ancestors, or the TenantClass, cannot be raised
+		if found.Spec.NamespaceOptions == nil || found.Spec.NamespaceOptions.Quota == nil {
+			return nil
+		}
+		// The Namespace quota could be carved out by the children Tenants
+		resolved := found.DeepCopy()
+		if err := capsuleutils.ResolveTenantHierarchy(ctx, r.Client, resolved); err != nil {
+			return err
+		}
+
+		if raised = resolved.IsFull(); !raised {
+			return nil
+		}
+
+		*found.Spec.NamespaceOptions.Quota++
+
+		return r.Update(ctx, found)
+	})
+
+	return raised, err
+}
+
+func (r *Manager) createNamespace(ctx context.Context, tnt *capsulev1beta2.Tenant, nsReq *capsulev1beta2.NamespaceRequest) error {
+	tenantLabel, err := capsuleutils.GetTypeLabel(&capsulev1beta2.Tenant{})
+	if err != nil {
+		return err
+	}
+
+	ns := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        nsReq.Spec.Name,
+			Labels:      map[string]string{},
+			Annotations: map[string]string{api.NamespaceRequestAnnotation: requestReference(nsReq)},
+		},
+	}
+
+	for k, v := range nsReq.Spec.Labels {
+		ns.Labels[k] = v
+	}
+
+	ns.Labels[tenantLabel] = tnt.GetName()
+
+	if err = controllerutil.SetControllerReference(tnt, ns, r.Client.Scheme()); err != nil {
+		return err
+	}
+
+	return r.Create(ctx, ns)
+}
+
+// updateStatus updates the status of the request, unless unchanged: the changed conditions are applied to the latest version,
+// leaving untouched the approval set by the cluster administrators in the meantime, unless changed by the controller.
+func (r *Manager) updateStatus(ctx context.Context, nsReq *capsulev1beta2.NamespaceRequest, previous *capsulev1beta2.NamespaceRequestStatus) error {
+	var changed []metav1.Condition
+
+	for _, condition := range nsReq.Status.Conditions {
+		if conditionChanged(previous.Conditions, condition) {
+			changed = append(changed, condition)
+		}
+	}
+
+	if len(changed) == 0 && nsReq.Status.Tenant == previous.Tenant && nsReq.Status.QuotaRaised == previous.QuotaRaised {
+		return nil
+	}
+
+	return retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		found := &capsulev1beta2.NamespaceRequest{}
+		if err := r.Get(ctx, types.NamespacedName{Namespace: nsReq.GetNamespace(), Name: nsReq.GetName()}, found); err != nil {
+			return err
+		}
+
+		for _, condition := range changed {
+			meta.SetStatusCondition(&found.Status.Conditions, condition)
+		}
+
+		found.Status.Tenant = nsReq.Status.Tenant
+		found.Status.QuotaRaised = nsReq.Status.QuotaRaised || found.Status.QuotaRaised
+
+		return r.Client.Status().Update(ctx, found)
+	})
+}
+
+func conditionChanged(previous []metav1.Condition, condition metav1.Condition) bool {
+	found := meta.FindStatusCondition(previous, condition.Type)
+
+	return found == nil || found.Status != condition.Status || found.Reason != condition.Reason || found.Message != condition.Message || found.ObservedGeneration != condition.ObservedGeneration
+}
+
+func requestReference(nsReq *capsulev1beta2.NamespaceRequest) string {
+	return fmt.Sprintf("%s/%s", nsReq.GetNamespace(), nsReq.GetName())
+}
@@ -0,0 +1,48 @@
+// Copyright 2020-2023 Project Capsule Authors.
+// SPDX-License-Identifier: Apache-2.0
+
+package namespacerequest
+
+import (
+	"fmt"
+	"strings"
+
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
+	"k8s.io/apimachinery/pkg/util/validation"
+
+	capsulev1beta2 "github.com/projectcapsule/capsule/api/v1beta2"
+	"github.com/projectcapsule/capsule/pkg/api"
+	capsuleutils "github.com/projectcapsule/capsule/pkg/utils"
+)
+
+// validate enforces the policies the admission webhook enforces on the Namespaces created by the Tenant Owners,
+// since these are not applied to the ones created by the controller.
+func (r *Manager) validate(tnt *capsulev1beta2.Tenant, nsReq *capsulev1beta2.NamespaceRequest) error {
+	name := nsReq.Spec.Name
+
+	if errs := validation.IsDNS1123Label(name); len(errs) > 0 {
+		return fmt.Errorf("the Namespace name %s is not valid: %s", name, strings.Join(errs, ", "))
+	}
+
+	if exp, _ := r.Configuration.ProtectedNamespaceRegexp(); exp != nil && exp.MatchString(name) {
+		return fmt.Errorf("creating namespaces with name matching %s regexp is not allowed", exp.String())
+	}
+
+	if errs := metav1validation.ValidateLabels(nsReq.Spec.Labels, nil); len(errs) > 0 {
+		return fmt.Errorf("the Namespace labels are not valid: %s", errs.ToAggregate().Error())
+	}
+
+	for label := range nsReq.Spec.Labels {
+		if strings.HasPrefix(label, api.NetworkPolicyPresetOptOutLabelPrefix) {
+			return fmt.Errorf("the label %s opting out of the Tenant network policies can be managed by the cluster administrators only", label)
+		}
+	}
+
+	if tnt.Spec.NamespaceOptions != nil {
+		if err := api.ValidateForbidden(nsReq.Spec.Labels, tnt.Spec.NamespaceOptions.ForbiddenLabels); err != nil {
+			return err
+		}
+	}
+
+	return capsuleutils.ValidateNamespaceName(tnt, name, r.Configuration.ForceTenantPrefix())
+}
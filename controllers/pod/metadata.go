@@ -82,6 +82,10 @@ func (m *MetadataReconciler) getTenant(ctx context.Context, namespacedName types
 	if err := client.Get(ctx, types.NamespacedName{Name: ns.Labels[capsuleLabel]}, tenant); err != nil {
 		return nil, err
 	}
+	// The Pod options can be provided by the TenantClass
+	if err := utils.ResolveTenantInheritance(ctx, client, tenant); err != nil {
+		return nil, err
+	}
 
 	if tenant.Spec.PodOptions == nil || tenant.Spec.PodOptions.AdditionalMetadata == nil {
 		return nil, NewNoPodMetadata(namespacedName.Name)
@@ -23,6 +23,7 @@ import (
 	ctrllog "sigs.k8s.io/controller-runtime/pkg/log"
 
 	capsulev1beta2 "github.com/projectcapsule/capsule/api/v1beta2"
+	"github.com/projectcapsule/capsule/pkg/metrics"
 )
 
 const (
@@ -73,6 +74,8 @@ func (r *Processor) HandlePruning(ctx context.Context, current, desired sets.Set
 		}
 
 		log.Info("resource has been pruned", "resource", item)
+
+		metrics.ObserveReplicatedObject(or.Kind, metrics.OperationPruned)
 	}
 
 	return updateStatus
@@ -260,7 +263,9 @@ func (r *Processor) createOrUpdate(ctx context.Context, obj *unstructured.Unstru
 	actual.SetNamespace(desired.GetNamespace())
 	actual.SetName(desired.GetName())
 
-	_, err = controllerutil.CreateOrUpdate(ctx, r.client, actual, func() error {
+	var res controllerutil.OperationResult
+
+	res, err = controllerutil.CreateOrUpdate(ctx, r.client, actual, func() error {
 		UID := actual.GetUID()
 		rv := actual.GetResourceVersion()
 
@@ -272,6 +277,11 @@ func (r *Processor) createOrUpdate(ctx context.Context, obj *unstructured.Unstru
 
 		return nil
 	})
+	if err != nil {
+		res = metrics.OperationFailed
+	}
+
+	metrics.ObserveReplicatedObject(desired.GetKind(), string(res))
 
 	return err
 }
@@ -0,0 +1,164 @@
+// Copyright 2020-2023 Project Capsule Authors.
+// SPDX-License-Identifier: Apache-2.0
+
+package softdelete
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	capsulev1beta2 "github.com/projectcapsule/capsule/api/v1beta2"
+	"github.com/projectcapsule/capsule/pkg/api"
+	webhookutils "github.com/projectcapsule/capsule/pkg/webhook/utils"
+)
+
+// Manager handles the lifecycle of the Namespaces pending deletion: these are cordoned, with the workloads scaled to zero,
+// until the grace period expires and the Namespace is actually deleted, or the Tenant Owner restores it.
+type Manager struct {
+	client.Client
+	Log      logr.Logger
+	Recorder record.EventRecorder
+}
+
+func (r *Manager) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		Named("softdelete").
+		For(&corev1.Namespace{}, builder.WithPredicates(predicate.NewPredicateFuncs(func(object client.Object) bool {
+			_, pending := object.GetLabels()[api.NamespacePendingDeletionLabel]
+			_, scheduled := object.GetAnnotations()[api.DeletionScheduleAnnotation]
+
+			return pending || scheduled
+		}))).
+		Complete(r)
+}
+
+func (r *Manager) Reconcile(ctx context.Context, request ctrl.Request) (ctrl.Result, error) {
+	log := r.Log.WithValues("Request.Name", request.Name)
+
+	ns := &corev1.Namespace{}
+	if err := r.Get(ctx, request.NamespacedName, ns); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.Info("Request object not found, could have been deleted after reconcile request")
+
+			return reconcile.Result{}, nil
+		}
+
+		log.Error(err, "Error reading the object")
+
+		return reconcile.Result{}, err
+	}
+
+	if ns.GetDeletionTimestamp() != nil {
+		return reconcile.Result{}, nil
+	}
+
+	tnt, err := webhookutils.TenantByStatusNamespace(ctx, r.Client, ns.GetName())
+	if err != nil {
+		log.Error(err, "Cannot retrieve the Namespace Tenant")
+
+		return reconcile.Result{}, err
+	}
+
+	_, pending := ns.GetLabels()[api.NamespacePendingDeletionLabel]
+	// the Namespace is restored upon the removal of the label, or the restore annotation,
+	// as well as when the soft deletion is no more enabled for the Tenant
+	if !pending || ns.GetAnnotations()[api.RestoreAnnotation] == "true" || len(tnt.GetName()) == 0 || tnt.Spec.NamespaceOptions == nil || tnt.Spec.NamespaceOptions.SoftDelete == nil {
+		return reconcile.Result{}, r.restore(ctx, tnt, ns)
+	}
+
+	schedule, err := r.schedule(ctx, tnt, ns)
+	if err != nil {
+		log.Error(err, "Cannot schedule the Namespace deletion")
+
+		return reconcile.Result{}, err
+	}
+
+	if err = scaleDown(ctx, r.Client, ns.GetName()); err != nil {
+		log.Error(err, "Cannot scale down the Namespace workloads")
+
+		return reconcile.Result{}, err
+	}
+
+	if remaining := time.Until(schedule); remaining > 0 {
+		log.Info("Namespace is pending deletion", "schedule", schedule)
+
+		return reconcile.Result{RequeueAfter: remaining}, nil
+	}
+
+	if err = r.Delete(ctx, ns); err != nil && !apierrors.IsNotFound(err) {
+		log.Error(err, "Cannot delete the Namespace")
+
+		return reconcile.Result{}, err
+	}
+
+	r.Recorder.Eventf(tnt, corev1.EventTypeNormal, "NamespaceDeleted", "Namespace %s has been deleted, the grace period expired", ns.GetName())
+
+	return reconcile.Result{}, nil
+}
+
+// schedule returns the time the Namespace will be deleted at, putting it in place upon the first reconciliation.
+func (r *Manager) schedule(ctx context.Context, tnt *capsulev1beta2.Tenant, ns *corev1.Namespace) (time.Time, error) {
+	if value, ok := ns.GetAnnotations()[api.DeletionScheduleAnnotation]; ok {
+		if schedule, err := time.Parse(time.RFC3339, value); err == nil {
+			return schedule, nil
+		}
+	}
+
+	schedule := time.Now().Add(tnt.Spec.NamespaceOptions.SoftDelete.GracePeriod.Duration).UTC().Truncate(time.Second)
+
+	patch := client.MergeFrom(ns.DeepCopy())
+
+	annotations := ns.GetAnnotations()
+	if annotations == nil {
+		annotations = make(map[string]string)
+	}
+
+	annotations[api.DeletionScheduleAnnotation] = schedule.Format(time.RFC3339)
+	ns.SetAnnotations(annotations)
+
+	if err := r.Patch(ctx, ns, patch); err != nil {
+		return time.Time{}, err
+	}
+
+	r.Recorder.Eventf(tnt, corev1.EventTypeNormal, "NamespacePendingDeletion", "Namespace %s has been cordoned, it will be deleted at %s", ns.GetName(), annotations[api.DeletionScheduleAnnotation])
+
+	return schedule, nil
+}
+
+// restore scales up the workloads of the Namespace, removing the pending deletion metadata.
+func (r *Manager) restore(ctx context.Context, tnt *capsulev1beta2.Tenant, ns *corev1.Namespace) error {
+	if err := scaleUp(ctx, r.Client, ns.GetName()); err != nil {
+		return err
+	}
+
+	patch := client.MergeFrom(ns.DeepCopy())
+
+	labels, annotations := ns.GetLabels(), ns.GetAnnotations()
+
+	delete(labels, api.NamespacePendingDeletionLabel)
+	delete(annotations, api.DeletionScheduleAnnotation)
+	delete(annotations, api.RestoreAnnotation)
+
+	ns.SetLabels(labels)
+	ns.SetAnnotations(annotations)
+
+	if err := r.Patch(ctx, ns, patch); err != nil {
+		return err
+	}
+
+	if len(tnt.GetName()) > 0 {
+		r.Recorder.Eventf(tnt, corev1.EventTypeNormal, "NamespaceRestored", "Namespace %s has been restored", ns.GetName())
+	}
+
+	return nil
+}
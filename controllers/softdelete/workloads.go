@@ -0,0 +1,186 @@
+// Copyright 2020-2023 Project Capsule Authors.
+// SPDX-License-Identifier: Apache-2.0
+
+package softdelete
+
+import (
+	"context"
+	"strconv"
+
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/projectcapsule/capsule/pkg/api"
+)
+
+// scaleDown scales the Deployments and StatefulSets to zero, and suspends the CronJobs, of the given Namespace:
+// the original values are kept in the annotations of the workloads to be restored later.
+//
+//nolint:dupl
+func scaleDown(ctx context.Context, clt client.Client, namespace string) error {
+	deployments := &appsv1.DeploymentList{}
+	if err := clt.List(ctx, deployments, client.InNamespace(namespace)); err != nil {
+		return err
+	}
+
+	for i := range deployments.Items {
+		deployment := &deployments.Items[i]
+
+		if _, ok := deployment.GetAnnotations()[api.OriginalReplicasAnnotation]; ok {
+			continue
+		}
+
+		patch := client.MergeFrom(deployment.DeepCopy())
+
+		setAnnotation(deployment, api.OriginalReplicasAnnotation, strconv.Itoa(int(pointer.Int32Deref(deployment.Spec.Replicas, 1))))
+		deployment.Spec.Replicas = pointer.Int32(0)
+
+		if err := clt.Patch(ctx, deployment, patch); err != nil {
+			return err
+		}
+	}
+
+	statefulSets := &appsv1.StatefulSetList{}
+	if err := clt.List(ctx, statefulSets, client.InNamespace(namespace)); err != nil {
+		return err
+	}
+
+	for i := range statefulSets.Items {
+		statefulSet := &statefulSets.Items[i]
+
+		if _, ok := statefulSet.GetAnnotations()[api.OriginalReplicasAnnotation]; ok {
+			continue
+		}
+
+		patch := client.MergeFrom(statefulSet.DeepCopy())
+
+		setAnnotation(statefulSet, api.OriginalReplicasAnnotation, strconv.Itoa(int(pointer.Int32Deref(statefulSet.Spec.Replicas, 1))))
+		statefulSet.Spec.Replicas = pointer.Int32(0)
+
+		if err := clt.Patch(ctx, statefulSet, patch); err != nil {
+			return err
+		}
+	}
+
+	cronJobs := &batchv1.CronJobList{}
+	if err := clt.List(ctx, cronJobs, client.InNamespace(namespace)); err != nil {
+		return err
+	}
+
+	for i := range cronJobs.Items {
+		cronJob := &cronJobs.Items[i]
+
+		if _, ok := cronJob.GetAnnotations()[api.OriginalSuspendAnnotation]; ok {
+			continue
+		}
+
+		patch := client.MergeFrom(cronJob.DeepCopy())
+
+		setAnnotation(cronJob, api.OriginalSuspendAnnotation, strconv.FormatBool(pointer.BoolDeref(cronJob.Spec.Suspend, false)))
+		cronJob.Spec.Suspend = pointer.Bool(true)
+
+		if err := clt.Patch(ctx, cronJob, patch); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// scaleUp restores the workloads of the given Namespace to the values kept in their annotations.
+//
+//nolint:dupl
+func scaleUp(ctx context.Context, clt client.Client, namespace string) error {
+	deployments := &appsv1.DeploymentList{}
+	if err := clt.List(ctx, deployments, client.InNamespace(namespace)); err != nil {
+		return err
+	}
+
+	for i := range deployments.Items {
+		deployment := &deployments.Items[i]
+
+		value, ok := deployment.GetAnnotations()[api.OriginalReplicasAnnotation]
+		if !ok {
+			continue
+		}
+
+		patch := client.MergeFrom(deployment.DeepCopy())
+
+		if replicas, err := strconv.ParseInt(value, 10, 32); err == nil {
+			deployment.Spec.Replicas = pointer.Int32(int32(replicas))
+		}
+
+		delete(deployment.Annotations, api.OriginalReplicasAnnotation)
+
+		if err := clt.Patch(ctx, deployment, patch); err != nil {
+			return err
+		}
+	}
+
+	statefulSets := &appsv1.StatefulSetList{}
+	if err := clt.List(ctx, statefulSets, client.InNamespace(namespace)); err != nil {
+		return err
+	}
+
+	for i := range statefulSets.Items {
+		statefulSet := &statefulSets.Items[i]
+
+		value, ok := statefulSet.GetAnnotations()[api.OriginalReplicasAnnotation]
+		if !ok {
+			continue
+		}
+
+		patch := client.MergeFrom(statefulSet.DeepCopy())
+
+		if replicas, err := strconv.ParseInt(value, 10, 32); err == nil {
+			statefulSet.Spec.Replicas = pointer.Int32(int32(replicas))
+		}
+
+		delete(statefulSet.Annotations, api.OriginalReplicasAnnotation)
+
+		if err := clt.Patch(ctx, statefulSet, patch); err != nil {
+			return err
+		}
+	}
+
+	cronJobs := &batchv1.CronJobList{}
+	if err := clt.List(ctx, cronJobs, client.InNamespace(namespace)); err != nil {
+		return err
+	}
+
+	for i := range cronJobs.Items {
+		cronJob := &cronJobs.Items[i]
+
+		value, ok := cronJob.GetAnnotations()[api.OriginalSuspendAnnotation]
+		if !ok {
+			continue
+		}
+
+		patch := client.MergeFrom(cronJob.DeepCopy())
+
+		if suspend, err := strconv.ParseBool(value); err == nil {
+			cronJob.Spec.Suspend = pointer.Bool(suspend)
+		}
+
+		delete(cronJob.Annotations, api.OriginalSuspendAnnotation)
+
+		if err := clt.Patch(ctx, cronJob, patch); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func setAnnotation(obj client.Object, key, value string) {
+	annotations := obj.GetAnnotations()
+	if annotations == nil {
+		annotations = make(map[string]string)
+	}
+
+	annotations[key] = value
+
+	obj.SetAnnotations(annotations)
+}
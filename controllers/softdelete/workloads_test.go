@@ -0,0 +1,66 @@
+// Copyright 2020-2023 Project Capsule Authors.
+// SPDX-License-Identifier: Apache-2.0
+
+package softdelete
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/projectcapsule/capsule/pkg/api"
+)
+
+func TestScaleDownAndUp(t *testing.T) {
+	ctx := context.Background()
+
+	clt := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(
+		&appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "oil-dev"}, Spec: appsv1.DeploymentSpec{Replicas: pointer.Int32(3)}},
+		&appsv1.StatefulSet{ObjectMeta: metav1.ObjectMeta{Name: "db", Namespace: "oil-dev"}},
+		&batchv1.CronJob{ObjectMeta: metav1.ObjectMeta{Name: "backup", Namespace: "oil-dev"}},
+		&appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "oil-prod"}, Spec: appsv1.DeploymentSpec{Replicas: pointer.Int32(2)}},
+	).Build()
+
+	assert.NoError(t, scaleDown(ctx, clt, "oil-dev"))
+	// scaling down again must preserve the original values
+	assert.NoError(t, scaleDown(ctx, clt, "oil-dev"))
+
+	deployment, statefulSet, cronJob := &appsv1.Deployment{}, &appsv1.StatefulSet{}, &batchv1.CronJob{}
+
+	assert.NoError(t, clt.Get(ctx, types.NamespacedName{Namespace: "oil-dev", Name: "web"}, deployment))
+	assert.Equal(t, int32(0), *deployment.Spec.Replicas)
+	assert.Equal(t, "3", deployment.Annotations[api.OriginalReplicasAnnotation])
+
+	assert.NoError(t, clt.Get(ctx, types.NamespacedName{Namespace: "oil-dev", Name: "db"}, statefulSet))
+	assert.Equal(t, int32(0), *statefulSet.Spec.Replicas)
+	assert.Equal(t, "1", statefulSet.Annotations[api.OriginalReplicasAnnotation])
+
+	assert.NoError(t, clt.Get(ctx, types.NamespacedName{Namespace: "oil-dev", Name: "backup"}, cronJob))
+	assert.True(t, *cronJob.Spec.Suspend)
+	assert.Equal(t, "false", cronJob.Annotations[api.OriginalSuspendAnnotation])
+
+	other := &appsv1.Deployment{}
+	assert.NoError(t, clt.Get(ctx, types.NamespacedName{Namespace: "oil-prod", Name: "web"}, other))
+	assert.Equal(t, int32(2), *other.Spec.Replicas)
+
+	assert.NoError(t, scaleUp(ctx, clt, "oil-dev"))
+
+	assert.NoError(t, clt.Get(ctx, types.NamespacedName{Namespace: "oil-dev", Name: "web"}, deployment))
+	assert.Equal(t, int32(3), *deployment.Spec.Replicas)
+	assert.NotContains(t, deployment.Annotations, api.OriginalReplicasAnnotation)
+
+	assert.NoError(t, clt.Get(ctx, types.NamespacedName{Namespace: "oil-dev", Name: "db"}, statefulSet))
+	assert.Equal(t, int32(1), *statefulSet.Spec.Replicas)
+
+	assert.NoError(t, clt.Get(ctx, types.NamespacedName{Namespace: "oil-dev", Name: "backup"}, cronJob))
+	assert.False(t, *cronJob.Spec.Suspend)
+	assert.NotContains(t, cronJob.Annotations, api.OriginalSuspendAnnotation)
+}
@@ -0,0 +1,199 @@
+// Copyright 2020-2023 Project Capsule Authors.
+// SPDX-License-Identifier: Apache-2.0
+
+package tenant
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	capsulev1beta2 "github.com/projectcapsule/capsule/api/v1beta2"
+	"github.com/projectcapsule/capsule/pkg/utils"
+)
+
+// systemNamespaces are the Namespaces of the cluster components, which are never adopted.
+var systemNamespaces = sets.New[string]("default", "kube-system", "kube-public", "kube-node-lease")
+
+// adoptNamespaces assigns to the Tenant the unowned Namespaces selected for the adoption, setting the controller reference
+// and the Tenant label: the Tenant policies are applied to them as for any other Namespace, once collected.
+// The outcome is reported in the Tenant status, persisted along with the collected Namespaces.
+func (r *Manager) adoptNamespaces(ctx context.Context, tnt *capsulev1beta2.Tenant) error {
+	if tnt.Spec.Adoption == nil {
+		tnt.Status.Adoption = nil
+
+		return nil
+	}
+
+	tenantLabel, err := utils.GetTypeLabel(&capsulev1beta2.Tenant{})
+	if err != nil {
+		return err
+	}
+
+	list := &corev1.NamespaceList{}
+	if err = r.Client.List(ctx, list); err != nil {
+		return err
+	}
+
+	sort.Slice(list.Items, func(i, j int) bool {
+		return list.Items[i].GetName() < list.Items[j].GetName()
+	})
+
+	// the namespace quota could be defaulted by the class, inherited by the ancestors, and carved out by the children
+	resolved := tnt.DeepCopy()
+	if err = utils.ResolveTenantHierarchy(ctx, r.Client, resolved); err != nil {
+		return err
+	}
+
+	status, found := &capsulev1beta2.TenantAdoptionStatus{}, make(map[string]struct{}, len(list.Items))
+	// the Namespaces already assigned to the Tenant count against the namespace quota
+	size := len(tnt.Status.Namespaces)
+
+	refuse := func(namespace, reason string) {
+		status.Refused = append(status.Refused, capsulev1beta2.TenantAdoptionRefusal{Namespace: namespace, Reason: reason})
+	}
+
+	for i := range list.Items {
+		ns := &list.Items[i]
+
+		found[ns.GetName()] = struct{}{}
+
+		selected, selectErr := tnt.Spec.Adoption.Selects(ns.GetName(), ns.GetLabels())
+		if selectErr != nil {
+			return fmt.Errorf("cannot select the Namespaces to adopt: %w", selectErr)
+		}
+
+		if !selected {
+			continue
+		}
+
+		if reason := r.adoptionForbidden(ns.GetName()); len(reason) > 0 {
+			refuse(ns.GetName(), reason)
+
+			continue
+		}
+
+		if owner := metav1.GetControllerOf(ns); owner != nil {
+			switch {
+			case owner.Kind == "Tenant" && owner.Name == tnt.GetName():
+				status.Adopted = append(status.Adopted, ns.GetName())
+			case owner.Kind == "Tenant":
+				refuse(ns.GetName(), fmt.Sprintf("the Namespace belongs to the Tenant %s", owner.Name))
+			default:
+				refuse(ns.GetName(), fmt.Sprintf("the Namespace is controlled by the %s %s", owner.Kind, owner.Name))
+			}
+
+			continue
+		}
+
+		if value, ok := ns.GetLabels()[tenantLabel]; ok && value != tnt.GetName() {
+			refuse(ns.GetName(), fmt.Sprintf("the Namespace is labelled for the Tenant %s", value))
+
+			continue
+		}
+
+		if ns.GetDeletionTimestamp() != nil {
+			refuse(ns.GetName(), "the Namespace is terminating")
+
+			continue
+		}
+
+		if quota := resolved.Spec.NamespaceOptions; quota != nil && quota.Quota != nil && size >= int(*quota.Quota) {
+			refuse(ns.GetName(), "the namespace quota of the Tenant is exceeded")
+
+			continue
+		}
+
+		size++
+
+		if tnt.Spec.Adoption.DryRun {
+			status.Pending = append(status.Pending, ns.GetName())
+
+			continue
+		}
+
+		if err = r.adoptNamespace(ctx, tnt, tenantLabel, ns); err != nil {
+			return fmt.Errorf("cannot adopt the Namespace %s: %w", ns.GetName(), err)
+		}
+
+		status.Adopted = append(status.Adopted, ns.GetName())
+	}
+
+	for _, namespace := range tnt.Spec.Adoption.Namespaces {
+		if _, ok := found[namespace]; !ok {
+			refuse(namespace, "the Namespace doesn't exist")
+		}
+	}
+
+	tnt.Status.Adoption = status
+
+	return nil
+}
+
+// adoptionForbidden returns the reason the given Namespace cannot be adopted by any Tenant, if any:
+// the system Namespaces, the Capsule one, and the protected ones are never adopted.
+func (r *Manager) adoptionForbidden(namespace string) string {
+	if systemNamespaces.Has(namespace) || namespace == r.Namespace {
+		return "the Namespace is reserved to the cluster administrators"
+	}
+
+	if exp, _ := r.Configuration.ProtectedNamespaceRegexp(); exp != nil && exp.MatchString(namespace) {
+		return fmt.Sprintf("the Namespace is matching the protected %s regexp", exp.String())
+	}
+
+	return ""
+}
+
+func (r *Manager) adoptNamespace(ctx context.Context, tnt *capsulev1beta2.Tenant, tenantLabel string, ns *corev1.Namespace) error {
+	patch := client.MergeFrom(ns.DeepCopy())
+
+	if err := controllerutil.SetControllerReference(tnt, ns, r.Client.Scheme()); err != nil {
+		return err
+	}
+
+	labels := ns.GetLabels()
+	if labels == nil {
+		labels = make(map[string]string)
+	}
+
+	labels[tenantLabel] = tnt.GetName()
+	ns.SetLabels(labels)
+
+	if err := r.Client.Patch(ctx, ns, patch); err != nil {
+		return err
+	}
+
+	r.Recorder.Eventf(tnt, corev1.EventTypeNormal, "NamespaceAdopted", "Namespace %s has been adopted by the Tenant", ns.GetName())
+
+	return nil
+}
+
+// enqueueAdoptingTenants enqueues the Tenants selecting the given Namespace for the adoption.
+func (r *Manager) enqueueAdoptingTenants(ctx context.Context, obj client.Object) (requests []reconcile.Request) {
+	tntList := &capsulev1beta2.TenantList{}
+	if err := r.Client.List(ctx, tntList); err != nil {
+		r.Log.Error(err, "Cannot retrieve the Tenants adopting the Namespace", "namespace", obj.GetName())
+
+		return nil
+	}
+
+	for _, tnt := range tntList.Items {
+		if tnt.Spec.Adoption == nil {
+			continue
+		}
+
+		if selected, _ := tnt.Spec.Adoption.Selects(obj.GetName(), obj.GetLabels()); selected {
+			requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Name: tnt.GetName()}})
+		}
+	}
+
+	return requests
+}
@@ -0,0 +1,54 @@
+// Copyright 2020-2023 Project Capsule Authors.
+// SPDX-License-Identifier: Apache-2.0
+
+package tenant
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	capsulev1beta2 "github.com/projectcapsule/capsule/api/v1beta2"
+	"github.com/projectcapsule/capsule/pkg/utils"
+)
+
+// resolveTenantClass retrieves the TenantClass referenced by the Tenant, keeping track of its generation in the status:
+// the class defaults are merged into the effective specification upon the hierarchy resolution.
+func (r *Manager) resolveTenantClass(ctx context.Context, tnt *capsulev1beta2.Tenant) error {
+	class, err := utils.GetTenantClass(ctx, r.Client, tnt)
+	if err != nil {
+		return err
+	}
+
+	if class == nil {
+		tnt.Status.Class = nil
+
+		return nil
+	}
+
+	tnt.Status.Class = &capsulev1beta2.TenantClassStatus{
+		Name:       class.GetName(),
+		Generation: class.GetGeneration(),
+	}
+
+	return nil
+}
+
+// enqueueClassTenants triggers the reconciliation of the Tenants referencing the changed TenantClass.
+func (r *Manager) enqueueClassTenants(ctx context.Context, obj client.Object) (requests []reconcile.Request) {
+	tntList := &capsulev1beta2.TenantList{}
+	if err := r.Client.List(ctx, tntList, client.MatchingFieldsSelector{Selector: fields.OneTermEqualSelector(".spec.tenantClassName", obj.GetName())}); err != nil {
+		r.Log.Error(err, "Cannot retrieve Tenants referencing the TenantClass", "class", obj.GetName())
+
+		return nil
+	}
+
+	for _, tnt := range tntList.Items {
+		requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Name: tnt.GetName()}})
+	}
+
+	return requests
+}
@@ -0,0 +1,85 @@
+// Copyright 2020-2023 Project Capsule Authors.
+// SPDX-License-Identifier: Apache-2.0
+
+package tenant
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	capsulev1beta2 "github.com/projectcapsule/capsule/api/v1beta2"
+)
+
+// stepConditions lists the conditions reporting the result of the Tenant reconciliation steps.
+var stepConditions = []string{
+	capsulev1beta2.TenantConditionMetadataSynced,
+	capsulev1beta2.TenantConditionClassResolved,
+	capsulev1beta2.TenantConditionHierarchyResolved,
+	capsulev1beta2.TenantConditionNamespacesSynced,
+	capsulev1beta2.TenantConditionNetworkPoliciesSynced,
+	capsulev1beta2.TenantConditionLimitRangesSynced,
+	capsulev1beta2.TenantConditionQuotasSynced,
+	capsulev1beta2.TenantConditionRBACSynced,
+	capsulev1beta2.TenantConditionPolicyReportSynced,
+}
+
+// tenantConditions returns the conditions to set according to the reconciliation outcome:
+// upon success all the step conditions are True, otherwise the completed steps are reported as True,
+// and the failed step one as False. A step can run more than once, thus its failure takes precedence.
+func tenantConditions(generation int64, completedSteps []string, failedStep string, err error) (conditions []metav1.Condition) {
+	succeeded := func(conditionType string) metav1.Condition {
+		return metav1.Condition{
+			Type:               conditionType,
+			Status:             metav1.ConditionTrue,
+			ObservedGeneration: generation,
+			Reason:             capsulev1beta2.TenantReasonSucceeded,
+			Message:            "Reconciliation step completed successfully",
+		}
+	}
+
+	if err == nil {
+		for _, conditionType := range stepConditions {
+			conditions = append(conditions, succeeded(conditionType))
+		}
+
+		return append(conditions, metav1.Condition{
+			Type:               capsulev1beta2.TenantConditionReady,
+			Status:             metav1.ConditionTrue,
+			ObservedGeneration: generation,
+			Reason:             capsulev1beta2.TenantReasonSucceeded,
+			Message:            "All the Tenant resources have been reconciled",
+		})
+	}
+
+	for _, conditionType := range completedSteps {
+		if conditionType == failedStep || meta.FindStatusCondition(conditions, conditionType) != nil {
+			continue
+		}
+
+		conditions = append(conditions, succeeded(conditionType))
+	}
+
+	message := err.Error()
+
+	if len(failedStep) > 0 {
+		conditions = append(conditions, metav1.Condition{
+			Type:               failedStep,
+			Status:             metav1.ConditionFalse,
+			ObservedGeneration: generation,
+			Reason:             capsulev1beta2.TenantReasonFailed,
+			Message:            message,
+		})
+
+		message = fmt.Sprintf("%s: %s", failedStep, message)
+	}
+
+	return append(conditions, metav1.Condition{
+		Type:               capsulev1beta2.TenantConditionReady,
+		Status:             metav1.ConditionFalse,
+		ObservedGeneration: generation,
+		Reason:             capsulev1beta2.TenantReasonFailed,
+		Message:            message,
+	})
+}
@@ -0,0 +1,53 @@
+// Copyright 2020-2023 Project Capsule Authors.
+// SPDX-License-Identifier: Apache-2.0
+
+package tenant
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	capsulev1beta2 "github.com/projectcapsule/capsule/api/v1beta2"
+	"github.com/projectcapsule/capsule/pkg/utils"
+)
+
+// enqueueHierarchy triggers the reconciliation of the parent Tenant, since its budget must be carved out again,
+// and of all the descendants, since they're inheriting the policies of the changed Tenant.
+func (r *Manager) enqueueHierarchy(ctx context.Context, obj client.Object) (requests []reconcile.Request) {
+	tnt, ok := obj.(*capsulev1beta2.Tenant)
+	if !ok {
+		return nil
+	}
+
+	if len(tnt.Spec.Parent) > 0 {
+		requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Name: tnt.Spec.Parent}})
+	}
+
+	visited := sets.New[string](tnt.GetName())
+
+	for queue := []capsulev1beta2.Tenant{*tnt}; len(queue) > 0; queue = queue[1:] {
+		children, err := utils.GetTenantChildren(ctx, r.Client, &queue[0])
+		if err != nil {
+			r.Log.Error(err, "Cannot retrieve children Tenants", "tenant", queue[0].GetName())
+
+			continue
+		}
+
+		for _, child := range children {
+			if visited.Has(child.GetName()) {
+				continue
+			}
+
+			visited.Insert(child.GetName())
+
+			requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Name: child.GetName()}})
+			queue = append(queue, child)
+		}
+	}
+
+	return requests
+}
@@ -11,21 +11,30 @@ import (
 	networkingv1 "k8s.io/api/networking/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
-	"k8s.io/client-go/rest"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/retry"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	capsulev1beta2 "github.com/projectcapsule/capsule/api/v1beta2"
+	"github.com/projectcapsule/capsule/pkg/configuration"
+	"github.com/projectcapsule/capsule/pkg/metrics"
+	"github.com/projectcapsule/capsule/pkg/utils"
 )
 
 type Manager struct {
 	client.Client
-	Log        logr.Logger
-	Recorder   record.EventRecorder
-	RESTConfig *rest.Config
+	Log           logr.Logger
+	Recorder      record.EventRecorder
+	Configuration configuration.Configuration
+	// Namespace Capsule is running in, which cannot be adopted.
+	Namespace string
 }
 
 func (r *Manager) SetupWithManager(mgr ctrl.Manager) error {
@@ -36,6 +45,10 @@ func (r *Manager) SetupWithManager(mgr ctrl.Manager) error {
 		Owns(&corev1.LimitRange{}).
 		Owns(&corev1.ResourceQuota{}).
 		Owns(&rbacv1.RoleBinding{}).
+		Watches(&capsulev1beta2.Tenant{}, handler.EnqueueRequestsFromMapFunc(r.enqueueHierarchy)).
+		Watches(&capsulev1beta2.TenantClass{}, handler.EnqueueRequestsFromMapFunc(r.enqueueClassTenants)).
+		Watches(&corev1.Namespace{}, handler.EnqueueRequestsFromMapFunc(r.enqueueAdoptingTenants)).
+		Watches(&capsulev1beta2.ResourceQuotaAllocation{}, handler.EnqueueRequestsFromMapFunc(r.enqueueAllocationTenant), builder.WithPredicates(predicate.GenerationChangedPredicate{})).
 		Complete(r)
 }
 
@@ -47,6 +60,8 @@ func (r Manager) Reconcile(ctx context.Context, request ctrl.Request) (result ct
 		if apierrors.IsNotFound(err) {
 			r.Log.Info("Request object not found, could have been deleted after reconcile request")
 
+			metrics.DeleteTenantState(request.Name)
+
 			return reconcile.Result{}, nil
 		}
 
@@ -54,6 +69,31 @@ func (r Manager) Reconcile(ctx context.Context, request ctrl.Request) (result ct
 
 		return
 	}
+	// The outcome of the reconciliation is reported in the Tenant conditions,
+	// the step variable keeps track of the condition related to the running step,
+	// while the completed ones are collected as soon as the following step starts.
+	var (
+		step      string
+		completed []string
+	)
+
+	startStep := func(next string) {
+		if len(step) > 0 {
+			completed = append(completed, step)
+		}
+
+		step = next
+	}
+
+	defer func() {
+		if conditionErr := r.updateTenantConditions(ctx, instance, completed, step, err); conditionErr != nil {
+			r.Log.Error(conditionErr, "Cannot update Tenant conditions")
+		}
+
+		if metricsErr := r.exposeMetrics(ctx, instance); metricsErr != nil {
+			r.Log.Error(metricsErr, "Cannot expose Tenant metrics")
+		}
+	}()
 	// Ensuring the Tenant Status
 	if err = r.updateTenantStatus(ctx, instance); err != nil {
 		r.Log.Error(err, "Cannot update Tenant status")
@@ -61,31 +101,61 @@ func (r Manager) Reconcile(ctx context.Context, request ctrl.Request) (result ct
 		return
 	}
 	// Ensuring Metadata
+	startStep(capsulev1beta2.TenantConditionMetadataSynced)
+
 	if err = r.ensureMetadata(ctx, instance); err != nil {
 		r.Log.Error(err, "Cannot ensure metadata")
 
 		return
 	}
+	// Adopting the existing Namespaces selected by the Tenant
+	r.Log.Info("Adopting the selected Namespaces")
 
-	// Ensuring ResourceQuota
-	r.Log.Info("Ensuring limit resources count is updated")
+	startStep(capsulev1beta2.TenantConditionNamespacesSynced)
 
-	if err = r.syncCustomResourceQuotaUsages(ctx, instance); err != nil {
-		r.Log.Error(err, "Cannot count limited resources")
+	if err = r.adoptNamespaces(ctx, instance); err != nil {
+		r.Log.Error(err, "Cannot adopt Namespace resources")
 
 		return
 	}
 	// Ensuring all namespaces are collected
 	r.Log.Info("Ensuring all Namespaces are collected")
 
+	startStep(capsulev1beta2.TenantConditionNamespacesSynced)
+
 	if err = r.collectNamespaces(ctx, instance); err != nil {
 		r.Log.Error(err, "Cannot collect Namespace resources")
 
 		return
 	}
+	// Resolving the Tenant class:
+	// the resolved generation is reported in the status, along with the conditions.
+	r.Log.Info("Resolving the Tenant class")
+
+	startStep(capsulev1beta2.TenantConditionClassResolved)
+
+	if err = r.resolveTenantClass(ctx, instance); err != nil {
+		r.Log.Error(err, "Cannot resolve Tenant class")
+
+		return
+	}
+	// Resolving the Tenant hierarchy:
+	// the following steps are going to use the effective specification,
+	// defaulted by the class, inherited from the ancestors, and carved out by the children budget.
+	r.Log.Info("Resolving the Tenant hierarchy")
+
+	startStep(capsulev1beta2.TenantConditionHierarchyResolved)
+
+	if err = utils.ResolveTenantHierarchy(ctx, r.Client, instance); err != nil {
+		r.Log.Error(err, "Cannot resolve Tenant hierarchy")
+
+		return
+	}
 	// Ensuring Namespace metadata
 	r.Log.Info("Starting processing of Namespaces", "items", len(instance.Status.Namespaces))
 
+	startStep(capsulev1beta2.TenantConditionNamespacesSynced)
+
 	if err = r.syncNamespaces(ctx, instance); err != nil {
 		r.Log.Error(err, "Cannot sync Namespace items")
 
@@ -94,6 +164,8 @@ func (r Manager) Reconcile(ctx context.Context, request ctrl.Request) (result ct
 	// Ensuring NetworkPolicy resources
 	r.Log.Info("Starting processing of Network Policies")
 
+	startStep(capsulev1beta2.TenantConditionNetworkPoliciesSynced)
+
 	if err = r.syncNetworkPolicies(ctx, instance); err != nil {
 		r.Log.Error(err, "Cannot sync NetworkPolicy items")
 
@@ -102,6 +174,8 @@ func (r Manager) Reconcile(ctx context.Context, request ctrl.Request) (result ct
 	// Ensuring LimitRange resources
 	r.Log.Info("Starting processing of Limit Ranges", "items", len(instance.Spec.LimitRanges.Items))
 
+	startStep(capsulev1beta2.TenantConditionLimitRangesSynced)
+
 	if err = r.syncLimitRanges(ctx, instance); err != nil {
 		r.Log.Error(err, "Cannot sync LimitRange items")
 
@@ -110,22 +184,44 @@ func (r Manager) Reconcile(ctx context.Context, request ctrl.Request) (result ct
 	// Ensuring ResourceQuota resources
 	r.Log.Info("Starting processing of Resource Quotas", "items", len(instance.Spec.ResourceQuota.Items))
 
+	startStep(capsulev1beta2.TenantConditionQuotasSynced)
+
 	if err = r.syncResourceQuotas(ctx, instance); err != nil {
 		r.Log.Error(err, "Cannot sync ResourceQuota items")
 
 		return
 	}
+	// Converting the deprecated custom resource quota annotations
+	if err = r.syncLegacyQuotas(ctx, instance); err != nil {
+		r.Log.Error(err, "Cannot sync the TenantQuota of the deprecated annotations")
+
+		return
+	}
 	// Ensuring RoleBinding resources
 	r.Log.Info("Ensuring RoleBindings for Owners and Tenant")
 
+	startStep(capsulev1beta2.TenantConditionRBACSynced)
+
 	if err = r.syncRoleBindings(ctx, instance); err != nil {
 		r.Log.Error(err, "Cannot sync RoleBindings items")
 
 		return
 	}
+	// Ensuring the TenantPolicyReport, readable by the Owners
+	r.Log.Info("Ensuring the TenantPolicyReport")
+
+	startStep(capsulev1beta2.TenantConditionPolicyReportSynced)
+
+	if err = r.syncPolicyReport(ctx, instance); err != nil {
+		r.Log.Error(err, "Cannot sync the TenantPolicyReport")
+
+		return
+	}
 	// Ensuring Namespace count
 	r.Log.Info("Ensuring Namespace count")
 
+	startStep(capsulev1beta2.TenantConditionNamespacesSynced)
+
 	if err = r.ensureNamespaceCount(ctx, instance); err != nil {
 		r.Log.Error(err, "Cannot sync Namespace count")
 
@@ -148,3 +244,30 @@ func (r *Manager) updateTenantStatus(ctx context.Context, tnt *capsulev1beta2.Te
 		return r.Client.Status().Update(ctx, tnt)
 	})
 }
+
+// updateTenantConditions reports the outcome of the reconciliation in the Tenant conditions:
+// the condition of the failed step is set to False along with the returned error, while the ones of the
+// steps completed before the failure are set to True, recovering from the failures of the previous runs.
+// The observed generation is bumped only once the reconciliation succeeded, and the one of the reconciled
+// instance is reported, since the Tenant could have been changed in the meanwhile.
+// The resolved TenantClass is reported along with the conditions.
+func (r *Manager) updateTenantConditions(ctx context.Context, tnt *capsulev1beta2.Tenant, completed []string, step string, reconcileErr error) error {
+	return retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		found := &capsulev1beta2.Tenant{}
+		if err := r.Client.Get(ctx, types.NamespacedName{Name: tnt.GetName()}, found); err != nil {
+			return err
+		}
+
+		if reconcileErr == nil {
+			found.Status.ObservedGeneration = tnt.GetGeneration()
+		}
+
+		found.Status.Class = tnt.Status.Class
+
+		for _, condition := range tenantConditions(tnt.GetGeneration(), completed, step, reconcileErr) {
+			meta.SetStatusCondition(&found.Status.Conditions, condition)
+		}
+
+		return r.Client.Status().Update(ctx, found, &client.SubResourceUpdateOptions{})
+	})
+}
@@ -0,0 +1,60 @@
+// Copyright 2020-2023 Project Capsule Authors.
+// SPDX-License-Identifier: Apache-2.0
+
+package tenant
+
+import (
+	"context"
+	"strconv"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	capsulev1beta2 "github.com/projectcapsule/capsule/api/v1beta2"
+	"github.com/projectcapsule/capsule/pkg/api"
+	"github.com/projectcapsule/capsule/pkg/metrics"
+	"github.com/projectcapsule/capsule/pkg/utils"
+)
+
+// exposeMetrics publishes the Tenant state as Prometheus metrics: the used resources of the Tenant-scoped ResourceQuota
+// items are computed by summing the ResourceQuota resources of the Tenant Namespaces, as done upon their reconciliation.
+func (r *Manager) exposeMetrics(ctx context.Context, tnt *capsulev1beta2.Tenant) error {
+	metrics.SetTenantState(tnt)
+
+	var used, hard []corev1.ResourceList
+
+	if tnt.Spec.ResourceQuota.Scope == api.ResourceQuotaScopeTenant {
+		tenantLabel, err := utils.GetTypeLabel(&capsulev1beta2.Tenant{})
+		if err != nil {
+			return err
+		}
+
+		typeLabel, err := utils.GetTypeLabel(&corev1.ResourceQuota{})
+		if err != nil {
+			return err
+		}
+
+		for index, item := range tnt.Spec.ResourceQuota.Items {
+			list := &corev1.ResourceQuotaList{}
+			if err = r.List(ctx, list, client.MatchingLabels{tenantLabel: tnt.GetName(), typeLabel: strconv.Itoa(index)}); err != nil {
+				return err
+			}
+
+			itemUsed := corev1.ResourceList{}
+
+			for _, rq := range list.Items {
+				for name, quantity := range rq.Status.Used {
+					value := itemUsed[name]
+					value.Add(quantity)
+					itemUsed[name] = value
+				}
+			}
+
+			used, hard = append(used, itemUsed), append(hard, item.Hard)
+		}
+	}
+
+	metrics.SetTenantResourceQuotas(tnt.GetName(), used, hard)
+
+	return nil
+}
@@ -10,7 +10,10 @@ import (
 
 	"golang.org/x/sync/errgroup"
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
 	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/util/retry"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -29,7 +32,11 @@ func (r *Manager) syncNamespaces(ctx context.Context, tenant *capsulev1beta2.Ten
 		namespace := item
 
 		group.Go(func() error {
-			return r.syncNamespaceMetadata(ctx, namespace, tenant)
+			if err := r.syncNamespaceMetadata(ctx, namespace, tenant); err != nil {
+				return err
+			}
+
+			return r.pruningTransferredResources(ctx, namespace, tenant)
 		})
 	}
 
@@ -73,6 +80,10 @@ func (r *Manager) syncNamespaceMetadata(ctx context.Context, namespace string, t
 				}
 			}
 
+			for k, v := range api.PodSecurityLabels(tnt.Spec.PodSecurity) {
+				labels[k] = v
+			}
+
 			if tnt.Spec.NodeSelector != nil {
 				annotations = utils.BuildNodeSelector(tnt, annotations)
 			}
@@ -162,6 +173,33 @@ func (r *Manager) ensureNamespaceCount(ctx context.Context, tenant *capsulev1bet
 	})
 }
 
+// pruningTransferredResources removes the resources put in place by the Tenant the Namespace has been transferred from,
+// such as the RoleBindings granting access to its owners: these are labelled with the name of another Tenant.
+func (r *Manager) pruningTransferredResources(ctx context.Context, namespace string, tnt *capsulev1beta2.Tenant) error {
+	tenantLabel, err := utils.GetTypeLabel(&capsulev1beta2.Tenant{})
+	if err != nil {
+		return err
+	}
+
+	for _, obj := range []client.Object{&networkingv1.NetworkPolicy{}, &corev1.LimitRange{}, &corev1.ResourceQuota{}, &rbacv1.RoleBinding{}} {
+		typeLabel, typeErr := utils.GetTypeLabel(obj)
+		if typeErr != nil {
+			return typeErr
+		}
+
+		selector, selectorErr := labels.Parse(fmt.Sprintf("%s,%s,%s!=%s", typeLabel, tenantLabel, tenantLabel, tnt.GetName()))
+		if selectorErr != nil {
+			return selectorErr
+		}
+
+		if err = r.DeleteAllOf(ctx, obj, client.InNamespace(namespace), client.MatchingLabelsSelector{Selector: selector}); err != nil {
+			return fmt.Errorf("cannot prune the resources of the previous Tenant in the Namespace %s: %w", namespace, err)
+		}
+	}
+
+	return nil
+}
+
 func (r *Manager) collectNamespaces(ctx context.Context, tenant *capsulev1beta2.Tenant) error {
 	return retry.RetryOnConflict(retry.DefaultBackoff, func() (err error) {
 		list := &corev1.NamespaceList{}
@@ -9,40 +9,34 @@ import (
 	"strconv"
 
 	"golang.org/x/sync/errgroup"
+	corev1 "k8s.io/api/core/v1"
 	networkingv1 "k8s.io/api/networking/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 
 	capsulev1beta2 "github.com/projectcapsule/capsule/api/v1beta2"
+	"github.com/projectcapsule/capsule/pkg/api"
 	"github.com/projectcapsule/capsule/pkg/utils"
 )
 
 // Ensuring all the NetworkPolicies are applied to each Namespace handled by the Tenant.
-func (r *Manager) syncNetworkPolicies(ctx context.Context, tenant *capsulev1beta2.Tenant) error { //nolint:dupl
-	// getting requested NetworkPolicy keys
-	keys := make([]string, 0, len(tenant.Spec.NetworkPolicies.Items))
-
-	for i := range tenant.Spec.NetworkPolicies.Items {
-		keys = append(keys, strconv.Itoa(i))
-	}
-
+func (r *Manager) syncNetworkPolicies(ctx context.Context, tenant *capsulev1beta2.Tenant) error {
 	group := new(errgroup.Group)
 
 	for _, ns := range tenant.Status.Namespaces {
 		namespace := ns
 
 		group.Go(func() error {
-			return r.syncNetworkPolicy(ctx, tenant, namespace, keys)
+			return r.syncNetworkPolicy(ctx, tenant, namespace)
 		})
 	}
 
 	return group.Wait()
 }
 
-func (r *Manager) syncNetworkPolicy(ctx context.Context, tenant *capsulev1beta2.Tenant, namespace string, keys []string) (err error) {
-	if err = r.pruningResources(ctx, namespace, keys, &networkingv1.NetworkPolicy{}); err != nil {
-		return err
-	}
+//nolint:cyclop
+func (r *Manager) syncNetworkPolicy(ctx context.Context, tenant *capsulev1beta2.Tenant, namespace string) (err error) {
 	// getting NetworkPolicy labels for the mutateFn
 	var tenantLabel, networkPolicyLabel string
 
@@ -54,10 +48,55 @@ func (r *Manager) syncNetworkPolicy(ctx context.Context, tenant *capsulev1beta2.
 		return err
 	}
 
-	for i, spec := range tenant.Spec.NetworkPolicies.Items {
+	ns := &corev1.Namespace{}
+	if err = r.Client.Get(ctx, types.NamespacedName{Name: namespace}, ns); err != nil {
+		return err
+	}
+
+	var items []networkingv1.NetworkPolicySpec
+
+	if items, err = tenant.Spec.NetworkPolicies.RenderItems(tenant.Name, namespace); err != nil {
+		return err
+	}
+	// the items are keyed by their index, the presets by their name,
+	// skipping the ones the Namespace opted out of
+	policies := make(map[string]networkingv1.NetworkPolicySpec, len(items))
+
+	for i, spec := range items {
+		policies[strconv.Itoa(i)] = spec
+	}
+
+	for preset, spec := range tenant.Spec.NetworkPolicies.Presets.Render(tenantLabel, tenant.Name) {
+		if ns.GetLabels()[preset.OptOutLabel()] == api.NetworkPolicyPresetOptOutValue {
+			continue
+		}
+
+		policies[string(preset)] = spec
+	}
+	// the cloud metadata endpoint is excluded from the egress rules of the other NetworkPolicies
+	if presets := tenant.Spec.NetworkPolicies.Presets; presets != nil && presets.DenyCloudMetadata &&
+		ns.GetLabels()[api.NetworkPolicyPresetDenyCloudMetadata.OptOutLabel()] != api.NetworkPolicyPresetOptOutValue {
+		for key, spec := range policies {
+			policies[key] = api.ExcludeCloudMetadata(spec)
+		}
+	}
+
+	keys := make([]string, 0, len(policies))
+
+	for key := range policies {
+		keys = append(keys, key)
+	}
+
+	if err = r.pruningResources(ctx, namespace, keys, &networkingv1.NetworkPolicy{}); err != nil {
+		return err
+	}
+
+	for key, spec := range policies {
+		key, spec := key, spec
+
 		target := &networkingv1.NetworkPolicy{
 			ObjectMeta: metav1.ObjectMeta{
-				Name:      fmt.Sprintf("capsule-%s-%d", tenant.Name, i),
+				Name:      fmt.Sprintf("capsule-%s-%s", tenant.Name, key),
 				Namespace: namespace,
 			},
 		}
@@ -66,7 +105,7 @@ func (r *Manager) syncNetworkPolicy(ctx context.Context, tenant *capsulev1beta2.
 		res, err = controllerutil.CreateOrUpdate(ctx, r.Client, target, func() (err error) {
 			target.SetLabels(map[string]string{
 				tenantLabel:        tenant.Name,
-				networkPolicyLabel: strconv.Itoa(i),
+				networkPolicyLabel: key,
 			})
 			target.Spec = spec
 
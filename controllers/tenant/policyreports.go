@@ -0,0 +1,83 @@
+// Copyright 2020-2023 Project Capsule Authors.
+// SPDX-License-Identifier: Apache-2.0
+
+package tenant
+
+import (
+	"context"
+	"fmt"
+
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	capsulev1beta2 "github.com/projectcapsule/capsule/api/v1beta2"
+	"github.com/projectcapsule/capsule/pkg/policyreport"
+)
+
+// syncPolicyReport ensures the TenantPolicyReport of the Tenant, along with the ClusterRole and ClusterRoleBinding
+// allowing the Tenant Owners to read it, although being a cluster-scoped resource.
+func (r *Manager) syncPolicyReport(ctx context.Context, tenant *capsulev1beta2.Tenant) (err error) {
+	if err = policyreport.Update(ctx, r.Client, tenant, func(*capsulev1beta2.TenantPolicyReport) {}); err != nil {
+		return err
+	}
+
+	name := fmt.Sprintf("capsule-%s-policy-report", tenant.GetName())
+
+	clusterRole := &rbacv1.ClusterRole{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+		},
+	}
+
+	var res controllerutil.OperationResult
+
+	res, err = controllerutil.CreateOrUpdate(ctx, r.Client, clusterRole, func() error {
+		clusterRole.Rules = []rbacv1.PolicyRule{
+			{
+				APIGroups:     []string{capsulev1beta2.GroupVersion.Group},
+				Resources:     []string{"tenantpolicyreports"},
+				ResourceNames: []string{tenant.GetName()},
+				Verbs:         []string{"get", "list", "watch"},
+			},
+		}
+
+		return controllerutil.SetControllerReference(tenant, clusterRole, r.Client.Scheme())
+	})
+
+	if err != nil {
+		return err
+	}
+
+	r.Log.Info(fmt.Sprintf("ClusterRole sync result: %s", string(res)), "name", clusterRole.GetName())
+
+	clusterRoleBinding := &rbacv1.ClusterRoleBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+		},
+	}
+
+	res, err = controllerutil.CreateOrUpdate(ctx, r.Client, clusterRoleBinding, func() error {
+		clusterRoleBinding.RoleRef = rbacv1.RoleRef{
+			APIGroup: rbacv1.GroupName,
+			Kind:     "ClusterRole",
+			Name:     clusterRole.GetName(),
+		}
+
+		clusterRoleBinding.Subjects = make([]rbacv1.Subject, 0, len(tenant.Spec.Owners))
+
+		for _, owner := range tenant.Spec.Owners {
+			clusterRoleBinding.Subjects = append(clusterRoleBinding.Subjects, r.ownerClusterRoleBindings(owner, clusterRole.GetName()).Subjects...)
+		}
+
+		return controllerutil.SetControllerReference(tenant, clusterRoleBinding, r.Client.Scheme())
+	})
+
+	if err != nil {
+		return err
+	}
+
+	r.Log.Info(fmt.Sprintf("ClusterRoleBinding sync result: %s", string(res)), "name", clusterRoleBinding.GetName())
+
+	return nil
+}
@@ -23,6 +23,7 @@ import (
 
 	capsulev1beta2 "github.com/projectcapsule/capsule/api/v1beta2"
 	"github.com/projectcapsule/capsule/pkg/api"
+	"github.com/projectcapsule/capsule/pkg/quota"
 	"github.com/projectcapsule/capsule/pkg/utils"
 )
 
@@ -37,6 +38,13 @@ import (
 // This will trigger following reconciliations but that's ok: the mutateFn will re-use the same business logic, letting
 // the mutateFn along with the CreateOrUpdate to don't perform the update since resources are identical.
 //
+// Pods and PersistentVolumeClaims are also checked against the Tenant-scoped Resource Budget at admission time,
+// see the quota.Ledger, since the aggregation performed here is eventually consistent.
+//
+// Tenant owners can reserve part of the Tenant-scoped Resource Budget to a Namespace with a ResourceQuotaAllocation:
+// the Namespace ResourceQuota gets the allocated amount as .Hard value, and the remaining budget is shared across the
+// other Namespaces, as described above.
+//
 // In case of Namespace-scoped Resource Budget, we're just replicating the resources across all registered Namespaces.
 func (r *Manager) syncResourceQuotas(ctx context.Context, tenant *capsulev1beta2.Tenant) (err error) { //nolint:gocognit
 	// getting ResourceQuota labels for the mutateFn
@@ -49,6 +57,12 @@ func (r *Manager) syncResourceQuotas(ctx context.Context, tenant *capsulev1beta2
 	if typeLabel, err = utils.GetTypeLabel(&corev1.ResourceQuota{}); err != nil {
 		return err
 	}
+	// Validating the allocations against the Tenant budget, even if not Tenant-scoped to report their rejection.
+	var allocations quota.Allocations
+
+	if allocations, err = r.syncResourceQuotaAllocations(ctx, tenant); err != nil {
+		return err
+	}
 	//nolint:nestif
 	if tenant.Spec.ResourceQuota.Scope == api.ResourceQuotaScopeTenant {
 		group := new(errgroup.Group)
@@ -87,17 +101,45 @@ func (r *Manager) syncResourceQuotas(ctx context.Context, tenant *capsulev1beta2
 				// if we're hitting a Hard quota at Tenant level.
 				// For this case, we're going to block the Quota setting the Hard as the
 				// used one.
+				// Namespaces with an allocation are left out, since their Hard quota is the allocated one.
 				for name, hardQuota := range resourceQuota.Hard {
 					r.Log.Info("Desired hard " + name.String() + " quota is " + hardQuota.String())
 
-					// Getting the whole usage across all the Tenant Namespaces
-					var quantity resource.Quantity
-					for _, item := range list.Items {
-						quantity.Add(item.Status.Used[name])
+					shared := allocations.Shared(index, name, hardQuota)
+					r.Log.Info("Shared hard " + name.String() + " quota across non allocated Namespaces is " + shared.String())
+
+					// Getting the whole usage across all the Tenant Namespaces,
+					// along with the one of the Namespaces sharing the remaining budget.
+					var quantity, sharedQuantity resource.Quantity
+
+					sharedItems := make([]int, 0, len(list.Items))
+
+					for item := range list.Items {
+						quantity.Add(list.Items[item].Status.Used[name])
+
+						allocated, ok := allocations.Allocated(index, list.Items[item].GetNamespace(), name)
+						if ok {
+							if list.Items[item].Spec.Hard == nil {
+								list.Items[item].Spec.Hard = map[corev1.ResourceName]resource.Quantity{}
+							}
+
+							list.Items[item].Spec.Hard[name] = allocated
+
+							for k := range list.Items[item].Spec.Hard {
+								if !toKeep.Has(k) {
+									delete(list.Items[item].Spec.Hard, k)
+								}
+							}
+
+							continue
+						}
+
+						sharedQuantity.Add(list.Items[item].Status.Used[name])
+						sharedItems = append(sharedItems, item)
 					}
 					r.Log.Info("Computed " + name.String() + " quota for the whole Tenant is " + quantity.String())
 
-					switch quantity.Cmp(resourceQuota.Hard[name]) {
+					switch sharedQuantity.Cmp(shared) {
 					case 0:
 						// The Tenant is matching exactly the Quota:
 						// falling through next case since we have to block further
@@ -107,7 +149,7 @@ func (r *Manager) syncResourceQuotas(ctx context.Context, tenant *capsulev1beta2
 						// The Tenant is OverQuota:
 						// updating all the related ResourceQuota with the current
 						// used Quota to block further creations.
-						for item := range list.Items {
+						for _, item := range sharedItems {
 							if _, ok := list.Items[item].Status.Used[name]; ok {
 								list.Items[item].Spec.Hard[name] = list.Items[item].Status.Used[name]
 							} else {
@@ -120,11 +162,11 @@ func (r *Manager) syncResourceQuotas(ctx context.Context, tenant *capsulev1beta2
 						// The Tenant is respecting the Hard quota:
 						// restoring the default one for all the elements,
 						// also for the reconciled one.
-						for item := range list.Items {
+						for _, item := range sharedItems {
 							if list.Items[item].Spec.Hard == nil {
 								list.Items[item].Spec.Hard = map[corev1.ResourceName]resource.Quantity{}
 							}
-							list.Items[item].Spec.Hard[name] = resourceQuota.Hard[name]
+							list.Items[item].Spec.Hard[name] = shared
 
 							for k := range list.Items[item].Spec.Hard {
 								if !toKeep.Has(k) {
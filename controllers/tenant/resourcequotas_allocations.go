@@ -0,0 +1,110 @@
+// Copyright 2020-2023 Project Capsule Authors.
+// SPDX-License-Identifier: Apache-2.0
+
+package tenant
+
+import (
+	"context"
+
+	"golang.org/x/sync/errgroup"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	capsulev1beta2 "github.com/projectcapsule/capsule/api/v1beta2"
+	"github.com/projectcapsule/capsule/pkg/quota"
+)
+
+// syncResourceQuotaAllocations validates the ResourceQuotaAllocation resources deployed in the Tenant Namespaces against
+// the Tenant budget, reporting the outcome in their status: the accepted allocations are returned to render the
+// Namespace ResourceQuota resources.
+func (r *Manager) syncResourceQuotaAllocations(ctx context.Context, tenant *capsulev1beta2.Tenant) (quota.Allocations, error) {
+	var items []capsulev1beta2.ResourceQuotaAllocation
+
+	for _, ns := range tenant.Status.Namespaces {
+		list := &capsulev1beta2.ResourceQuotaAllocationList{}
+		if err := r.List(ctx, list, client.InNamespace(ns)); err != nil {
+			return nil, err
+		}
+
+		items = append(items, list.Items...)
+	}
+
+	allocations, rejected := quota.Allocate(tenant.Spec.ResourceQuota, items)
+
+	group := new(errgroup.Group)
+
+	for i := range items {
+		item := items[i]
+
+		group.Go(func() error {
+			return r.updateAllocationCondition(ctx, item, rejected[types.NamespacedName{Namespace: item.GetNamespace(), Name: item.GetName()}])
+		})
+	}
+
+	return allocations, group.Wait()
+}
+
+// updateAllocationCondition reports if the allocation has been accepted, skipping the update when nothing changed
+// to avoid useless reconciliations: rejections are also notified with an event.
+func (r *Manager) updateAllocationCondition(ctx context.Context, allocation capsulev1beta2.ResourceQuotaAllocation, rejectErr error) error {
+	condition := metav1.Condition{
+		Type:               capsulev1beta2.ResourceQuotaAllocationConditionAccepted,
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: allocation.GetGeneration(),
+		Reason:             capsulev1beta2.ResourceQuotaAllocationReasonAccepted,
+		Message:            "The allocation fits the Tenant budget",
+	}
+
+	if rejectErr != nil {
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = capsulev1beta2.ResourceQuotaAllocationReasonInvalid
+		condition.Message = rejectErr.Error()
+
+		if quota.IsAllocationExceeded(rejectErr) {
+			condition.Reason = capsulev1beta2.ResourceQuotaAllocationReasonExceeded
+		}
+	}
+
+	return retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		found := &capsulev1beta2.ResourceQuotaAllocation{}
+		if err := r.Get(ctx, types.NamespacedName{Namespace: allocation.GetNamespace(), Name: allocation.GetName()}, found); err != nil {
+			return client.IgnoreNotFound(err)
+		}
+
+		if current := meta.FindStatusCondition(found.Status.Conditions, condition.Type); current != nil &&
+			current.Status == condition.Status && current.Reason == condition.Reason &&
+			current.Message == condition.Message && current.ObservedGeneration == condition.ObservedGeneration {
+			return nil
+		}
+
+		if rejectErr != nil {
+			r.Recorder.Event(found, corev1.EventTypeWarning, condition.Reason, condition.Message)
+		}
+
+		meta.SetStatusCondition(&found.Status.Conditions, condition)
+
+		return r.Client.Status().Update(ctx, found)
+	})
+}
+
+// enqueueAllocationTenant triggers the reconciliation of the Tenant owning the Namespace of the changed allocation.
+func (r *Manager) enqueueAllocationTenant(ctx context.Context, obj client.Object) (requests []reconcile.Request) {
+	tntList := &capsulev1beta2.TenantList{}
+	if err := r.Client.List(ctx, tntList, client.MatchingFieldsSelector{Selector: fields.OneTermEqualSelector(".status.namespaces", obj.GetNamespace())}); err != nil {
+		r.Log.Error(err, "Cannot retrieve the Tenant owning the Namespace", "namespace", obj.GetNamespace())
+
+		return nil
+	}
+
+	for _, tnt := range tntList.Items {
+		requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Name: tnt.GetName()}})
+	}
+
+	return requests
+}
@@ -0,0 +1,130 @@
+// Copyright 2020-2023 Project Capsule Authors.
+// SPDX-License-Identifier: Apache-2.0
+
+package tenant
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	capsulev1beta1 "github.com/projectcapsule/capsule/api/v1beta1"
+	capsulev1beta2 "github.com/projectcapsule/capsule/api/v1beta2"
+)
+
+// syncLegacyQuotas converts the deprecated custom resource quota annotations of the Tenant,
+// in the form quota.resources.capsule.clastix.io/<resource>.<group>_<version>: <hard>, into a TenantQuota owned by it,
+// which is deleted once the annotations are removed.
+func (r *Manager) syncLegacyQuotas(ctx context.Context, tenant *capsulev1beta2.Tenant) error {
+	tq := &capsulev1beta2.TenantQuota{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: fmt.Sprintf("capsule-%s-legacy", tenant.GetName()),
+		},
+	}
+
+	resources := r.legacyQuotaResources(tenant)
+
+	if len(resources) == 0 {
+		if err := r.Client.Get(ctx, types.NamespacedName{Name: tq.GetName()}, tq); err != nil {
+			if apierrors.IsNotFound(err) {
+				return nil
+			}
+
+			return err
+		}
+
+		if !metav1.IsControlledBy(tq, tenant) {
+			return nil
+		}
+
+		if err := r.Client.Delete(ctx, tq); err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+
+		return nil
+	}
+
+	res, err := controllerutil.CreateOrUpdate(ctx, r.Client, tq, func() error {
+		tq.Spec.TenantName = tenant.GetName()
+		tq.Spec.Resources = resources
+
+		if tq.Spec.ResyncPeriod.Duration == 0 {
+			tq.Spec.ResyncPeriod = metav1.Duration{Duration: time.Minute}
+		}
+
+		return controllerutil.SetControllerReference(tenant, tq, r.Client.Scheme())
+	})
+	if err != nil {
+		return err
+	}
+
+	r.Log.Info(fmt.Sprintf("TenantQuota sync result: %s", string(res)), "name", tq.GetName())
+
+	if res != controllerutil.OperationResultNone {
+		r.Recorder.Eventf(tenant, corev1.EventTypeWarning, "DeprecatedQuotaAnnotations", "The %s annotations are deprecated, and have been converted into the TenantQuota %s", capsulev1beta1.ResourceQuotaAnnotationPrefix, tq.GetName())
+	}
+
+	return nil
+}
+
+// legacyQuotaResources returns the resources limited by the deprecated annotations of the Tenant, skipping the malformed ones.
+func (r *Manager) legacyQuotaResources(tenant *capsulev1beta2.Tenant) (resources []capsulev1beta2.TenantQuotaResource) {
+	keys := make([]string, 0, len(tenant.GetAnnotations()))
+
+	for key := range tenant.GetAnnotations() {
+		if strings.HasPrefix(key, capsulev1beta1.ResourceQuotaAnnotationPrefix+"/") {
+			keys = append(keys, key)
+		}
+	}
+
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		resourceGroup, version, ok := strings.Cut(strings.TrimPrefix(key, capsulev1beta1.ResourceQuotaAnnotationPrefix+"/"), "_")
+		if !ok {
+			r.Log.Info("non well-formed Resource Limit annotation, cannot retrieve version", "key", key)
+
+			continue
+		}
+
+		resource, group, ok := strings.Cut(resourceGroup, ".")
+		if !ok {
+			r.Log.Info("non well-formed Resource Limit annotation, cannot retrieve kind and group", "key", key)
+
+			continue
+		}
+
+		hard, err := strconv.ParseInt(tenant.GetAnnotations()[key], 10, 64)
+		if err != nil {
+			r.Log.Info("non well-formed Resource Limit annotation, cannot parse the limit", "key", key)
+
+			continue
+		}
+
+		gvk, err := r.Client.RESTMapper().KindFor(schema.GroupVersionResource{Group: group, Version: version, Resource: resource})
+		if err != nil {
+			r.Log.Error(err, "Cannot retrieve the kind of the limited resource", "key", key)
+
+			continue
+		}
+
+		resources = append(resources, capsulev1beta2.TenantQuotaResource{
+			Group:   gvk.Group,
+			Version: gvk.Version,
+			Kind:    gvk.Kind,
+			Hard:    hard,
+		})
+	}
+
+	return resources
+}
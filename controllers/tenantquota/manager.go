@@ -0,0 +1,176 @@
+// Copyright 2020-2023 Project Capsule Authors.
+// SPDX-License-Identifier: Apache-2.0
+
+package tenantquota
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	"golang.org/x/sync/errgroup"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/metadata"
+	"k8s.io/client-go/util/retry"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	capsulev1beta2 "github.com/projectcapsule/capsule/api/v1beta2"
+	"github.com/projectcapsule/capsule/pkg/utils"
+)
+
+// Manager periodically counts the objects limited by the TenantQuota resources across the Tenant Namespaces,
+// reporting the used amount in the status: the admission webhook relies on it, along with the changes admitted since the last count.
+type Manager struct {
+	client.Client
+	Log            logr.Logger
+	MetadataClient metadata.Interface
+}
+
+func (r *Manager) SetupWithManager(mgr ctrl.Manager) (err error) {
+	if r.MetadataClient == nil {
+		if r.MetadataClient, err = metadata.NewForConfig(mgr.GetConfig()); err != nil {
+			return err
+		}
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&capsulev1beta2.TenantQuota{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Watches(&capsulev1beta2.Tenant{}, handler.EnqueueRequestsFromMapFunc(r.enqueueTenantQuotas), builder.WithPredicates(predicate.Funcs{
+			UpdateFunc: func(e event.UpdateEvent) bool {
+				oldTnt, oldOk := e.ObjectOld.(*capsulev1beta2.Tenant)
+				newTnt, newOk := e.ObjectNew.(*capsulev1beta2.Tenant)
+
+				return !oldOk || !newOk || !sets.New[string](oldTnt.Status.Namespaces...).Equal(sets.New[string](newTnt.Status.Namespaces...))
+			},
+		})).
+		Complete(r)
+}
+
+func (r *Manager) Reconcile(ctx context.Context, request ctrl.Request) (ctrl.Result, error) {
+	log := r.Log.WithValues("Request.Name", request.Name)
+
+	tq := &capsulev1beta2.TenantQuota{}
+	if err := r.Get(ctx, request.NamespacedName, tq); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.Info("Request object not found, could have been deleted after reconcile request")
+
+			return reconcile.Result{}, nil
+		}
+
+		log.Error(err, "Error reading the object")
+
+		return reconcile.Result{}, err
+	}
+
+	tnt := &capsulev1beta2.Tenant{}
+	if err := r.Get(ctx, types.NamespacedName{Name: tq.Spec.TenantName}, tnt); err != nil {
+		log.Error(err, "Cannot retrieve the Tenant", "tenant", tq.Spec.TenantName)
+
+		return reconcile.Result{}, err
+	}
+	// The count time is taken before listing the objects:
+	// the changes admitted afterwards are still considered by the webhook.
+	countTime := metav1.Now()
+
+	resources := make([]capsulev1beta2.TenantQuotaResourceStatus, len(tq.Spec.Resources))
+
+	group := new(errgroup.Group)
+
+	for i, res := range tq.Spec.Resources {
+		index, resource := i, res
+
+		group.Go(func() (err error) {
+			resources[index] = capsulev1beta2.TenantQuotaResourceStatus{TenantQuotaResource: resource}
+			resources[index].Used, err = r.countObjects(ctx, tnt, resource)
+
+			return err
+		})
+	}
+
+	if err := group.Wait(); err != nil {
+		log.Error(err, "Cannot count the limited objects")
+
+		return reconcile.Result{}, err
+	}
+
+	err := retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		found := &capsulev1beta2.TenantQuota{}
+		if err := r.Get(ctx, request.NamespacedName, found); err != nil {
+			return err
+		}
+
+		found.Status.LastCountTime = countTime
+		found.Status.Resources = resources
+
+		return r.Client.Status().Update(ctx, found)
+	})
+	if err != nil {
+		log.Error(err, "Cannot update the TenantQuota status")
+
+		return reconcile.Result{}, err
+	}
+
+	log.Info("TenantQuota counting completed")
+
+	// The period is clamped as well, since the TenantQuota could have been created before its validation
+	resync := tq.Spec.ResyncPeriod.Duration
+	if resync < capsulev1beta2.TenantQuotaMinResyncPeriod {
+		resync = capsulev1beta2.TenantQuotaMinResyncPeriod
+	}
+
+	return reconcile.Result{Requeue: true, RequeueAfter: resync}, nil
+}
+
+// countObjects returns the amount of objects of the given resource across the Tenant Namespaces,
+// ignoring the ones being deleted.
+func (r *Manager) countObjects(ctx context.Context, tnt *capsulev1beta2.Tenant, res capsulev1beta2.TenantQuotaResource) (used int64, err error) {
+	var mapping *meta.RESTMapping
+
+	if mapping, err = r.Client.RESTMapper().RESTMapping(res.GroupKind(), res.Version); err != nil {
+		return 0, fmt.Errorf("cannot retrieve the resource for %s: %w", res.GroupKind().String(), err)
+	}
+
+	for _, ns := range tnt.Status.Namespaces {
+		list, listErr := r.MetadataClient.Resource(mapping.Resource).Namespace(ns).List(ctx, metav1.ListOptions{})
+		if listErr != nil {
+			return 0, listErr
+		}
+
+		for _, item := range list.Items {
+			if item.GetDeletionTimestamp() != nil {
+				continue
+			}
+
+			used++
+		}
+	}
+
+	return used, nil
+}
+
+// enqueueTenantQuotas triggers the count of the TenantQuota resources applied to the changed Tenant,
+// since its Namespaces have been changed.
+func (r *Manager) enqueueTenantQuotas(ctx context.Context, obj client.Object) (requests []reconcile.Request) {
+	tqs, err := utils.GetTenantQuotas(ctx, r.Client, obj.GetName())
+	if err != nil {
+		r.Log.Error(err, "Cannot retrieve TenantQuota resources", "tenant", obj.GetName())
+
+		return nil
+	}
+
+	for _, tq := range tqs {
+		requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Name: tq.GetName()}})
+	}
+
+	return requests
+}
@@ -0,0 +1,231 @@
+// Copyright 2020-2023 Project Capsule Authors.
+// SPDX-License-Identifier: Apache-2.0
+
+package tenantusage
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/util/retry"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	capsulev1beta2 "github.com/projectcapsule/capsule/api/v1beta2"
+	"github.com/projectcapsule/capsule/pkg/configuration"
+	"github.com/projectcapsule/capsule/pkg/metrics"
+	"github.com/projectcapsule/capsule/pkg/quota"
+	"github.com/projectcapsule/capsule/pkg/utils"
+)
+
+// resyncPeriod is the period of time upon the Tenant usage is collected again.
+const resyncPeriod = time.Minute
+
+// Manager periodically collects the resources consumed across the Tenant Namespaces, reporting them in the Tenant status
+// and as Prometheus metrics: when enabled in the configuration, the usage is also snapshotted in TenantUsageReport resources.
+type Manager struct {
+	client.Client
+	Log           logr.Logger
+	Configuration configuration.Configuration
+}
+
+func (r *Manager) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		Named("tenantusage").
+		For(&capsulev1beta2.Tenant{}, builder.WithPredicates(predicate.Funcs{
+			UpdateFunc: func(e event.UpdateEvent) bool {
+				oldTnt, oldOk := e.ObjectOld.(*capsulev1beta2.Tenant)
+				newTnt, newOk := e.ObjectNew.(*capsulev1beta2.Tenant)
+
+				return !oldOk || !newOk || !sets.New[string](oldTnt.Status.Namespaces...).Equal(sets.New[string](newTnt.Status.Namespaces...))
+			},
+		})).
+		Complete(r)
+}
+
+func (r *Manager) Reconcile(ctx context.Context, request ctrl.Request) (ctrl.Result, error) {
+	log := r.Log.WithValues("Request.Name", request.Name)
+
+	tnt := &capsulev1beta2.Tenant{}
+	if err := r.Get(ctx, request.NamespacedName, tnt); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.Info("Request object not found, could have been deleted after reconcile request")
+
+			metrics.DeleteTenantUsage(request.Name)
+
+			return reconcile.Result{}, nil
+		}
+
+		log.Error(err, "Error reading the object")
+
+		return reconcile.Result{}, err
+	}
+
+	usage, err := r.collectUsage(ctx, tnt)
+	if err != nil {
+		log.Error(err, "Cannot collect the Tenant usage")
+
+		return reconcile.Result{}, err
+	}
+
+	metrics.SetTenantUsage(tnt.GetName(), usage)
+
+	if err = r.updateUsage(ctx, tnt.GetName(), usage); err != nil {
+		log.Error(err, "Cannot update the Tenant usage")
+
+		return reconcile.Result{}, err
+	}
+
+	requeueAfter := resyncPeriod
+
+	if period := r.Configuration.UsageReportPeriod(); period > 0 {
+		var next time.Duration
+
+		if next, err = r.ensureReport(ctx, tnt, usage, period); err != nil {
+			log.Error(err, "Cannot ensure the Tenant usage report")
+
+			return reconcile.Result{}, err
+		}
+
+		if next < requeueAfter {
+			requeueAfter = next
+		}
+	}
+
+	log.Info("Tenant usage collection completed")
+
+	return reconcile.Result{Requeue: true, RequeueAfter: requeueAfter}, nil
+}
+
+// collectUsage sums the resources consumed across the Tenant Namespaces: the requests of the Pods are computed as the
+// Kubernetes quota evaluator does, ignoring the terminated ones.
+func (r *Manager) collectUsage(ctx context.Context, tnt *capsulev1beta2.Tenant) (usage capsulev1beta2.TenantUsage, err error) {
+	for _, ns := range tnt.Status.Namespaces {
+		pods := &corev1.PodList{}
+		if err = r.List(ctx, pods, client.InNamespace(ns)); err != nil {
+			return usage, err
+		}
+
+		for i := range pods.Items {
+			pod := pods.Items[i]
+
+			if pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed {
+				continue
+			}
+
+			podUsage := quota.PodUsage(&pod)
+
+			usage.CPU.Add(podUsage[corev1.ResourceRequestsCPU])
+			usage.Memory.Add(podUsage[corev1.ResourceRequestsMemory])
+			usage.Pods++
+		}
+
+		pvcs := &corev1.PersistentVolumeClaimList{}
+		if err = r.List(ctx, pvcs, client.InNamespace(ns)); err != nil {
+			return usage, err
+		}
+
+		for _, pvc := range pvcs.Items {
+			usage.Storage.Add(pvc.Spec.Resources.Requests[corev1.ResourceStorage])
+			usage.PersistentVolumeClaims++
+		}
+
+		services := &corev1.ServiceList{}
+		if err = r.List(ctx, services, client.InNamespace(ns)); err != nil {
+			return usage, err
+		}
+
+		for _, svc := range services.Items {
+			if svc.Spec.Type == corev1.ServiceTypeLoadBalancer {
+				usage.LoadBalancers++
+			}
+		}
+	}
+
+	return usage, nil
+}
+
+// updateUsage reports the usage in the Tenant status only if changed, avoiding useless Tenant reconciliations.
+func (r *Manager) updateUsage(ctx context.Context, name string, usage capsulev1beta2.TenantUsage) error {
+	return retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		found := &capsulev1beta2.Tenant{}
+		if err := r.Get(ctx, types.NamespacedName{Name: name}, found); err != nil {
+			return err
+		}
+
+		if found.Status.Usage.Equal(&usage) {
+			return nil
+		}
+
+		usage.LastUpdateTime = metav1.Now()
+		found.Status.Usage = &usage
+
+		return r.Client.Status().Update(ctx, found)
+	})
+}
+
+// ensureReport creates the TenantUsageReport for the current period, if not yet done, pruning the ones exceeding the
+// history limit: the time left before the next period is returned.
+// Reports are named after the period they belong to, thus creating them twice is not possible.
+func (r *Manager) ensureReport(ctx context.Context, tnt *capsulev1beta2.Tenant, usage capsulev1beta2.TenantUsage, period time.Duration) (time.Duration, error) {
+	tenantLabel, err := utils.GetTypeLabel(&capsulev1beta2.Tenant{})
+	if err != nil {
+		return 0, err
+	}
+
+	reports := &capsulev1beta2.TenantUsageReportList{}
+	if err = r.List(ctx, reports, client.MatchingLabels{tenantLabel: tnt.GetName()}); err != nil {
+		return 0, err
+	}
+
+	sort.SliceStable(reports.Items, func(i, j int) bool {
+		return reports.Items[i].Spec.Timestamp.Before(&reports.Items[j].Spec.Timestamp)
+	})
+
+	now := time.Now()
+	current := now.Truncate(period)
+
+	if len(reports.Items) == 0 || reports.Items[len(reports.Items)-1].Spec.Timestamp.Time.Before(current) {
+		report := capsulev1beta2.TenantUsageReport{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: fmt.Sprintf("%s-%d", tnt.GetName(), current.Unix()),
+				Labels: map[string]string{
+					tenantLabel: tnt.GetName(),
+				},
+			},
+			Spec: capsulev1beta2.TenantUsageReportSpec{
+				TenantName: tnt.GetName(),
+				Timestamp:  metav1.NewTime(now),
+				Usage:      usage,
+			},
+		}
+
+		if err = r.Create(ctx, &report); err != nil && !apierrors.IsAlreadyExists(err) {
+			return 0, err
+		}
+
+		if err == nil {
+			reports.Items = append(reports.Items, report)
+		}
+	}
+
+	for limit := r.Configuration.UsageReportHistoryLimit(); len(reports.Items) > limit; reports.Items = reports.Items[1:] {
+		if err = r.Delete(ctx, &reports.Items[0]); err != nil && !apierrors.IsNotFound(err) {
+			return 0, err
+		}
+	}
+
+	return current.Add(period).Sub(now), nil
+}
@@ -27,9 +27,6 @@ var _ = Describe("when Tenant limits custom Resource Quota", func() {
 	tnt := &capsulev1beta2.Tenant{
 		ObjectMeta: metav1.ObjectMeta{
 			Name: "limiting-resources",
-			Annotations: map[string]string{
-				"quota.resources.capsule.clastix.io/foos.test.clastix.io_v1": "3",
-			},
 		},
 		Spec: capsulev1beta2.TenantSpec{
 			Owners: capsulev1beta2.OwnerListSpec{
@@ -41,6 +38,23 @@ var _ = Describe("when Tenant limits custom Resource Quota", func() {
 		},
 	}
 
+	tq := &capsulev1beta2.TenantQuota{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "limiting-resources",
+		},
+		Spec: capsulev1beta2.TenantQuotaSpec{
+			TenantName: "limiting-resources",
+			Resources: []capsulev1beta2.TenantQuotaResource{
+				{
+					Group:   "test.clastix.io",
+					Version: "v1",
+					Kind:    "Foo",
+					Hard:    3,
+				},
+			},
+		},
+	}
+
 	crd := &v1.CustomResourceDefinition{
 		ObjectMeta: metav1.ObjectMeta{
 			Name: "foos.test.clastix.io",
@@ -90,9 +104,15 @@ var _ = Describe("when Tenant limits custom Resource Quota", func() {
 		EventuallyCreation(func() error {
 			return k8sClient.Create(context.TODO(), tnt)
 		}).Should(Succeed())
+
+		EventuallyCreation(func() error {
+			return k8sClient.Create(context.TODO(), tq)
+		}).Should(Succeed())
 	})
 
 	JustAfterEach(func() {
+		Expect(k8sClient.Delete(context.TODO(), tq)).Should(Succeed())
+
 		Expect(k8sClient.Delete(context.TODO(), crd)).Should(Succeed())
 
 		Expect(k8sClient.Delete(context.TODO(), tnt)).Should(Succeed())
@@ -143,13 +163,16 @@ var _ = Describe("when Tenant limits custom Resource Quota", func() {
 			}).Should(HaveOccurred())
 		}
 
-		Expect(k8sClient.Get(context.Background(), types.NamespacedName{Name: tnt.GetName()}, tnt)).ShouldNot(HaveOccurred())
-
-		Eventually(func() bool {
-			limit, _ := HaveKeyWithValue("quota.resources.capsule.clastix.io/foos.test.clastix.io_v1", "3").Match(tnt.GetAnnotations())
-			used, _ := HaveKeyWithValue("used.resources.capsule.clastix.io/foos.test.clastix.io_v1", "3").Match(tnt.GetAnnotations())
+		Eventually(func() (resources []capsulev1beta2.TenantQuotaResourceStatus) {
+			found := &capsulev1beta2.TenantQuota{}
+			if err := k8sClient.Get(context.Background(), types.NamespacedName{Name: tq.GetName()}, found); err != nil {
+				return nil
+			}
 
-			return limit && used
-		}, defaultTimeoutInterval, defaultPollInterval).Should(BeTrue())
+			return found.Status.Resources
+		}, defaultTimeoutInterval, defaultPollInterval).Should(ConsistOf(capsulev1beta2.TenantQuotaResourceStatus{
+			TenantQuotaResource: tq.Spec.Resources[0],
+			Used:                3,
+		}))
 	})
 })
@@ -0,0 +1,135 @@
+//go:build e2e
+
+// Copyright 2020-2023 Project Capsule Authors.
+// SPDX-License-Identifier: Apache-2.0
+
+package e2e
+
+import (
+	"context"
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gatewayv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+
+	capsulev1beta2 "github.com/projectcapsule/capsule/api/v1beta2"
+	"github.com/projectcapsule/capsule/pkg/api"
+	"github.com/projectcapsule/capsule/pkg/utils"
+)
+
+var _ = Describe("enforcing the Ingress options on the Gateway API routes", func() {
+	tnt := &capsulev1beta2.Tenant{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "gateway-routes",
+		},
+		Spec: capsulev1beta2.TenantSpec{
+			Owners: capsulev1beta2.OwnerListSpec{
+				{
+					Name: "gateway-owner",
+					Kind: "User",
+				},
+			},
+			IngressOptions: capsulev1beta2.IngressOptions{
+				HostnameCollisionScope: api.HostnameCollisionScopeTenant,
+				AllowedHostnames: &api.AllowedListSpec{
+					Regex: `^.*\.routes\.capsule\.io$`,
+				},
+				AllowedGateways: &api.AllowedListSpec{
+					Exact: []string{"gateway-system/public"},
+				},
+			},
+		},
+	}
+
+	JustBeforeEach(func() {
+		EventuallyCreation(func() error {
+			tnt.ResourceVersion = ""
+
+			return k8sClient.Create(context.TODO(), tnt)
+		}).Should(Succeed())
+	})
+	JustAfterEach(func() {
+		Expect(k8sClient.Delete(context.TODO(), tnt)).Should(Succeed())
+	})
+
+	newRoute := func(namespace, name, gatewayNamespace, gatewayName, hostname, path string) *gatewayv1beta1.HTTPRoute {
+		gatewayNs := gatewayv1beta1.Namespace(gatewayNamespace)
+
+		return &gatewayv1beta1.HTTPRoute{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: namespace,
+			},
+			Spec: gatewayv1beta1.HTTPRouteSpec{
+				CommonRouteSpec: gatewayv1beta1.CommonRouteSpec{
+					ParentRefs: []gatewayv1beta1.ParentReference{
+						{
+							Namespace: &gatewayNs,
+							Name:      gatewayv1beta1.ObjectName(gatewayName),
+						},
+					},
+				},
+				Hostnames: []gatewayv1beta1.Hostname{gatewayv1beta1.Hostname(hostname)},
+				Rules: []gatewayv1beta1.HTTPRouteRule{
+					{
+						Matches: []gatewayv1beta1.HTTPRouteMatch{
+							{
+								Path: &gatewayv1beta1.HTTPPathMatch{
+									Value: &path,
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	It("should validate the hostnames, the collisions, and the parent Gateways", func() {
+		if err := k8sClient.List(context.Background(), &gatewayv1beta1.HTTPRouteList{}); err != nil {
+			if utils.IsUnsupportedAPI(err) {
+				Skip(fmt.Sprintf("Running test due to unsupported API kind: %s", err.Error()))
+			}
+		}
+
+		ns := NewNamespace("")
+		NamespaceCreation(ns, tnt.Spec.Owners[0], defaultTimeoutInterval).Should(Succeed())
+		TenantNamespaceList(tnt, defaultTimeoutInterval).Should(ContainElement(ns.GetName()))
+
+		By("denying a hostname not allowed", func() {
+			route := newRoute(ns.GetName(), "forbidden-hostname", "gateway-system", "public", "www.acme.com", "/")
+
+			Expect(k8sClient.Create(context.TODO(), route)).ShouldNot(Succeed())
+		})
+
+		By("denying a Gateway not allowed", func() {
+			route := newRoute(ns.GetName(), "forbidden-gateway", "gateway-system", "private", "www.routes.capsule.io", "/")
+
+			Expect(k8sClient.Create(context.TODO(), route)).ShouldNot(Succeed())
+		})
+
+		By("allowing a hostname and a Gateway allowed", func() {
+			EventuallyCreation(func() error {
+				route := newRoute(ns.GetName(), "allowed", "gateway-system", "public", "www.routes.capsule.io", "/")
+
+				return k8sClient.Create(context.TODO(), route)
+			}).Should(Succeed())
+		})
+
+		By("denying a colliding hostname and path", func() {
+			route := newRoute(ns.GetName(), "colliding", "gateway-system", "public", "www.routes.capsule.io", "/")
+
+			Expect(k8sClient.Create(context.TODO(), route)).ShouldNot(Succeed())
+		})
+
+		By("allowing the same hostname with a different path", func() {
+			EventuallyCreation(func() error {
+				route := newRoute(ns.GetName(), "different-path", "gateway-system", "public", "www.routes.capsule.io", "/docs")
+
+				return k8sClient.Create(context.TODO(), route)
+			}).Should(Succeed())
+		})
+	})
+})
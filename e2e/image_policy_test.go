@@ -0,0 +1,105 @@
+//go:build e2e
+
+// Copyright 2020-2023 Project Capsule Authors.
+// SPDX-License-Identifier: Apache-2.0
+
+package e2e
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	capsulev1beta2 "github.com/projectcapsule/capsule/api/v1beta2"
+	"github.com/projectcapsule/capsule/pkg/api"
+)
+
+var _ = Describe("enforcing an Image Policy", func() {
+	tnt := &capsulev1beta2.Tenant{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "image-policy",
+		},
+		Spec: capsulev1beta2.TenantSpec{
+			Owners: capsulev1beta2.OwnerListSpec{
+				{
+					Name: "gregor",
+					Kind: "User",
+				},
+			},
+			ImagePolicy: &api.ImagePolicySpec{
+				AllowedRepositories: []string{"docker.io/library"},
+				DeniedImages:        []string{"docker.io/library/busybox"},
+				ForbidLatestTag:     true,
+			},
+		},
+	}
+
+	JustBeforeEach(func() {
+		EventuallyCreation(func() error {
+			tnt.ResourceVersion = ""
+			return k8sClient.Create(context.TODO(), tnt)
+		}).Should(Succeed())
+	})
+	JustAfterEach(func() {
+		Expect(k8sClient.Delete(context.TODO(), tnt)).Should(Succeed())
+	})
+
+	newPod := func(image string) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "container",
+			},
+			Spec: corev1.PodSpec{
+				Containers: []corev1.Container{
+					{
+						Name:  "container",
+						Image: image,
+					},
+				},
+			},
+		}
+	}
+
+	It("should deny images out of the allowed repositories", func() {
+		ns := NewNamespace("")
+		NamespaceCreation(ns, tnt.Spec.Owners[0], defaultTimeoutInterval).Should(Succeed())
+
+		cs := ownerClient(tnt.Spec.Owners[0])
+		_, err := cs.CoreV1().Pods(ns.Name).Create(context.Background(), newPod("gcr.io/google_containers/pause-amd64:3.0"), metav1.CreateOptions{})
+		Expect(err).ShouldNot(Succeed())
+	})
+
+	It("should deny the denied images", func() {
+		ns := NewNamespace("")
+		NamespaceCreation(ns, tnt.Spec.Owners[0], defaultTimeoutInterval).Should(Succeed())
+
+		cs := ownerClient(tnt.Spec.Owners[0])
+		_, err := cs.CoreV1().Pods(ns.Name).Create(context.Background(), newPod("docker.io/library/busybox:1.36"), metav1.CreateOptions{})
+		Expect(err).ShouldNot(Succeed())
+	})
+
+	It("should deny the latest tag", func() {
+		ns := NewNamespace("")
+		NamespaceCreation(ns, tnt.Spec.Owners[0], defaultTimeoutInterval).Should(Succeed())
+
+		cs := ownerClient(tnt.Spec.Owners[0])
+		for _, image := range []string{"docker.io/library/nginx:latest", "docker.io/library/nginx"} {
+			_, err := cs.CoreV1().Pods(ns.Name).Create(context.Background(), newPod(image), metav1.CreateOptions{})
+			Expect(err).ShouldNot(Succeed())
+		}
+	})
+
+	It("should allow a compliant image", func() {
+		ns := NewNamespace("")
+		NamespaceCreation(ns, tnt.Spec.Owners[0], defaultTimeoutInterval).Should(Succeed())
+
+		cs := ownerClient(tnt.Spec.Owners[0])
+		EventuallyCreation(func() error {
+			_, err := cs.CoreV1().Pods(ns.Name).Create(context.Background(), newPod("docker.io/library/nginx:alpine"), metav1.CreateOptions{})
+			return err
+		}).Should(Succeed())
+	})
+})
@@ -0,0 +1,163 @@
+//go:build e2e
+
+// Copyright 2020-2023 Project Capsule Authors.
+// SPDX-License-Identifier: Apache-2.0
+
+package e2e
+
+import (
+	"context"
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	capsulev1beta2 "github.com/projectcapsule/capsule/api/v1beta2"
+	"github.com/projectcapsule/capsule/pkg/api"
+	"github.com/projectcapsule/capsule/pkg/utils"
+)
+
+var _ = Describe("when handling the Ingress hostnames ownership and the overlapping paths", func() {
+	tnt1 := &capsulev1beta2.Tenant{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "hostnames-ownership-one",
+		},
+		Spec: capsulev1beta2.TenantSpec{
+			Owners: capsulev1beta2.OwnerListSpec{
+				{
+					Name: "ownership-tenant-one",
+					Kind: "User",
+				},
+			},
+			IngressOptions: capsulev1beta2.IngressOptions{
+				HostnameCollisionScope: api.HostnameCollisionScopeCluster,
+			},
+		},
+	}
+	tnt2 := &capsulev1beta2.Tenant{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "hostnames-ownership-two",
+		},
+		Spec: capsulev1beta2.TenantSpec{
+			Owners: capsulev1beta2.OwnerListSpec{
+				{
+					Name: "ownership-tenant-two",
+					Kind: "User",
+				},
+			},
+			IngressOptions: capsulev1beta2.IngressOptions{
+				HostnameCollisionScope: api.HostnameCollisionScopeTenant,
+				HostnameOwnership:      api.HostnameOwnershipHostname,
+			},
+		},
+	}
+	// scaffold a basic networking.k8s.io Ingress with name, host, and prefix path
+	networkingIngress := func(name, hostname, path string) *networkingv1.Ingress {
+		pathType := networkingv1.PathTypePrefix
+
+		return &networkingv1.Ingress{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: name,
+			},
+			Spec: networkingv1.IngressSpec{
+				Rules: []networkingv1.IngressRule{
+					{
+						Host: hostname,
+						IngressRuleValue: networkingv1.IngressRuleValue{
+							HTTP: &networkingv1.HTTPIngressRuleValue{
+								Paths: []networkingv1.HTTPIngressPath{
+									{
+										Path:     path,
+										PathType: &pathType,
+										Backend: networkingv1.IngressBackend{
+											Service: &networkingv1.IngressServiceBackend{
+												Name: "example",
+												Port: networkingv1.ServiceBackendPort{
+													Number: 8080,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	JustBeforeEach(func() {
+		for _, tnt := range []*capsulev1beta2.Tenant{tnt1, tnt2} {
+			EventuallyCreation(func() error {
+				tnt.ResourceVersion = ""
+
+				return k8sClient.Create(context.TODO(), tnt)
+			}).Should(Succeed())
+		}
+	})
+
+	JustAfterEach(func() {
+		for _, tnt := range []*capsulev1beta2.Tenant{tnt1, tnt2} {
+			Expect(k8sClient.Delete(context.TODO(), tnt)).Should(Succeed())
+		}
+	})
+
+	It("should reserve the hostnames, and detect the overlapping paths across Tenants", func() {
+		if err := k8sClient.List(context.Background(), &networkingv1.IngressList{}); err != nil {
+			if utils.IsUnsupportedAPI(err) {
+				Skip(fmt.Sprintf("Running test due to unsupported API kind: %s", err.Error()))
+			}
+		}
+
+		ns1 := NewNamespace("")
+		cs1 := ownerClient(tnt1.Spec.Owners[0])
+		NamespaceCreation(ns1, tnt1.Spec.Owners[0], defaultTimeoutInterval).Should(Succeed())
+		TenantNamespaceList(tnt1, defaultTimeoutInterval).Should(ContainElement(ns1.GetName()))
+
+		ns2 := NewNamespace("")
+		cs2 := ownerClient(tnt2.Spec.Owners[0])
+		NamespaceCreation(ns2, tnt2.Spec.Owners[0], defaultTimeoutInterval).Should(Succeed())
+		TenantNamespaceList(tnt2, defaultTimeoutInterval).Should(ContainElement(ns2.GetName()))
+
+		By("allowing the overlapping paths in the same Tenant", func() {
+			EventuallyCreation(func() (err error) {
+				_, err = cs2.NetworkingV1().Ingresses(ns2.GetName()).Create(context.TODO(), networkingIngress("api", "ownership.capsule.io", "/api"), metav1.CreateOptions{})
+
+				return
+			}).Should(Succeed())
+
+			EventuallyCreation(func() (err error) {
+				_, err = cs2.NetworkingV1().Ingresses(ns2.GetName()).Create(context.TODO(), networkingIngress("api-v1", "ownership.capsule.io", "/api/v1"), metav1.CreateOptions{})
+
+				return
+			}).Should(Succeed())
+		})
+
+		By("denying the overlapping paths across Tenants", func() {
+			_, err := cs1.NetworkingV1().Ingresses(ns1.GetName()).Create(context.TODO(), networkingIngress("api-v2", "ownership.capsule.io", "/api/v2"), metav1.CreateOptions{})
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).Should(ContainSubstring("overlapping"))
+		})
+
+		By("denying the hostname reserved by the first Tenant", func() {
+			_, err := cs1.NetworkingV1().Ingresses(ns1.GetName()).Create(context.TODO(), networkingIngress("blog", "ownership.capsule.io", "/blog"), metav1.CreateOptions{})
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).Should(ContainSubstring("reserved"))
+		})
+
+		By("releasing the hostname once no longer used", func() {
+			for _, name := range []string{"api", "api-v1"} {
+				Expect(cs2.NetworkingV1().Ingresses(ns2.GetName()).Delete(context.TODO(), name, metav1.DeleteOptions{})).Should(Succeed())
+			}
+
+			EventuallyCreation(func() (err error) {
+				_, err = cs1.NetworkingV1().Ingresses(ns1.GetName()).Create(context.TODO(), networkingIngress("blog", "ownership.capsule.io", "/blog"), metav1.CreateOptions{})
+
+				return
+			}).Should(Succeed())
+		})
+	})
+})
@@ -0,0 +1,182 @@
+//go:build e2e
+
+// Copyright 2020-2023 Project Capsule Authors.
+// SPDX-License-Identifier: Apache-2.0
+
+package e2e
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	capsulev1beta2 "github.com/projectcapsule/capsule/api/v1beta2"
+	"github.com/projectcapsule/capsule/pkg/api"
+)
+
+var _ = Describe("adopting the existing Namespaces", func() {
+	tnt := &capsulev1beta2.Tenant{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "adoption",
+		},
+		Spec: capsulev1beta2.TenantSpec{
+			Owners: capsulev1beta2.OwnerListSpec{
+				{
+					Name: "frank",
+					Kind: "User",
+				},
+			},
+			Adoption: &api.NamespaceAdoptionSpec{
+				NamespaceSelector: &metav1.LabelSelector{
+					MatchLabels: map[string]string{
+						"adoption": "enabled",
+					},
+				},
+				Namespaces: []string{"adoption-explicit"},
+				DryRun:     true,
+			},
+		},
+	}
+	other := &capsulev1beta2.Tenant{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "adoption-other",
+		},
+		Spec: capsulev1beta2.TenantSpec{
+			Owners: capsulev1beta2.OwnerListSpec{
+				{
+					Name: "frida",
+					Kind: "User",
+				},
+			},
+		},
+	}
+
+	selected := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "adoption-selected",
+			Labels: map[string]string{
+				"adoption": "enabled",
+			},
+		},
+	}
+	explicit := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "adoption-explicit",
+		},
+	}
+
+	adoption := func() *capsulev1beta2.TenantAdoptionStatus {
+		t := &capsulev1beta2.Tenant{}
+		Expect(k8sClient.Get(context.TODO(), types.NamespacedName{Name: tnt.GetName()}, t)).Should(Succeed())
+
+		if t.Status.Adoption == nil {
+			return &capsulev1beta2.TenantAdoptionStatus{}
+		}
+
+		return t.Status.Adoption
+	}
+
+	JustBeforeEach(func() {
+		tnt.Spec.Adoption.DryRun = true
+		tnt.Spec.Adoption.Namespaces = []string{explicit.GetName()}
+
+		for _, ns := range []*corev1.Namespace{selected, explicit} {
+			EventuallyCreation(func() error {
+				ns.ResourceVersion = ""
+
+				return k8sClient.Create(context.TODO(), ns)
+			}).Should(Succeed())
+		}
+
+		for _, t := range []*capsulev1beta2.Tenant{tnt, other} {
+			EventuallyCreation(func() error {
+				t.ResourceVersion = ""
+
+				return k8sClient.Create(context.TODO(), t)
+			}).Should(Succeed())
+		}
+	})
+
+	JustAfterEach(func() {
+		for _, t := range []*capsulev1beta2.Tenant{tnt, other} {
+			Expect(k8sClient.Delete(context.TODO(), t)).Should(Succeed())
+		}
+
+		for _, ns := range []*corev1.Namespace{selected, explicit} {
+			_ = k8sClient.Delete(context.TODO(), ns)
+		}
+	})
+
+	It("should report the Namespaces to adopt with the dry run, and adopt them once disabled", func() {
+		Eventually(func() []string {
+			return adoption().Pending
+		}, defaultTimeoutInterval, defaultPollInterval).Should(ConsistOf(selected.GetName(), explicit.GetName()))
+
+		Expect(k8sClient.Get(context.TODO(), types.NamespacedName{Name: selected.GetName()}, selected)).Should(Succeed())
+		Expect(selected.GetOwnerReferences()).Should(BeEmpty())
+
+		Eventually(func() error {
+			Expect(k8sClient.Get(context.TODO(), types.NamespacedName{Name: tnt.GetName()}, tnt)).Should(Succeed())
+			tnt.Spec.Adoption.DryRun = false
+
+			return k8sClient.Update(context.TODO(), tnt)
+		}, defaultTimeoutInterval, defaultPollInterval).Should(Succeed())
+
+		TenantNamespaceList(tnt, defaultTimeoutInterval).Should(ContainElements(selected.GetName(), explicit.GetName()))
+
+		Eventually(func() []string {
+			return adoption().Adopted
+		}, defaultTimeoutInterval, defaultPollInterval).Should(ConsistOf(selected.GetName(), explicit.GetName()))
+
+		Expect(k8sClient.Get(context.TODO(), types.NamespacedName{Name: selected.GetName()}, selected)).Should(Succeed())
+		Expect(selected.GetOwnerReferences()).Should(HaveLen(1))
+		Expect(selected.GetOwnerReferences()[0].Name).Should(Equal(tnt.GetName()))
+		Expect(selected.GetLabels()).Should(HaveKeyWithValue("capsule.clastix.io/tenant", tnt.GetName()))
+
+		By("binding the Tenant owners in the adopted Namespaces", func() {
+			Eventually(CheckForOwnerRoleBindings(selected, tnt.Spec.Owners[0], nil), defaultTimeoutInterval, defaultPollInterval).Should(Succeed())
+		})
+	})
+
+	It("should refuse the Namespaces belonging to another Tenant", func() {
+		ns := NewNamespace("adoption-other-dev")
+		ns.SetLabels(map[string]string{"adoption": "enabled"})
+		NamespaceCreation(ns, other.Spec.Owners[0], defaultTimeoutInterval).Should(Succeed())
+		TenantNamespaceList(other, defaultTimeoutInterval).Should(ContainElement(ns.GetName()))
+
+		Eventually(func() (namespaces []string) {
+			for _, refusal := range adoption().Refused {
+				namespaces = append(namespaces, refusal.Namespace)
+			}
+
+			return namespaces
+		}, defaultTimeoutInterval, defaultPollInterval).Should(ContainElement(ns.GetName()))
+
+		Expect(k8sClient.Get(context.TODO(), types.NamespacedName{Name: ns.GetName()}, ns)).Should(Succeed())
+		Expect(ns.GetOwnerReferences()).Should(HaveLen(1))
+		Expect(ns.GetOwnerReferences()[0].Name).Should(Equal(other.GetName()))
+	})
+
+	It("should refuse the system Namespaces", func() {
+		Eventually(func() error {
+			Expect(k8sClient.Get(context.TODO(), types.NamespacedName{Name: tnt.GetName()}, tnt)).Should(Succeed())
+			tnt.Spec.Adoption.Namespaces = append(tnt.Spec.Adoption.Namespaces, "kube-system")
+
+			return k8sClient.Update(context.TODO(), tnt)
+		}, defaultTimeoutInterval, defaultPollInterval).Should(Succeed())
+
+		Eventually(func() (namespaces []string) {
+			for _, refusal := range adoption().Refused {
+				namespaces = append(namespaces, refusal.Namespace)
+			}
+
+			return namespaces
+		}, defaultTimeoutInterval, defaultPollInterval).Should(ContainElement("kube-system"))
+
+		Expect(adoption().Pending).ShouldNot(ContainElement("kube-system"))
+	})
+})
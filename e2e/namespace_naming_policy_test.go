@@ -0,0 +1,91 @@
+//go:build e2e
+
+// Copyright 2020-2023 Project Capsule Authors.
+// SPDX-License-Identifier: Apache-2.0
+
+package e2e
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/pointer"
+
+	capsulev1beta2 "github.com/projectcapsule/capsule/api/v1beta2"
+	"github.com/projectcapsule/capsule/pkg/api"
+)
+
+var _ = Describe("creating a Namespace with the Tenant naming policy", func() {
+	tnt := &capsulev1beta2.Tenant{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "naming-policy",
+		},
+		Spec: capsulev1beta2.TenantSpec{
+			Owners: capsulev1beta2.OwnerListSpec{
+				{
+					Name: "kurt",
+					Kind: "User",
+				},
+			},
+			NamespaceOptions: &capsulev1beta2.NamespaceOptions{
+				NamingPolicy: &api.NamespaceNamingPolicy{
+					Template:        "{{ tenant }}-{{ name }}-{{ suffix }}",
+					AllowedSuffixes: []string{"dev", "prod"},
+					MaxLength:       pointer.Int32(30),
+					Mode:            api.NamespaceNamingModeValidate,
+				},
+			},
+		},
+	}
+
+	JustBeforeEach(func() {
+		EventuallyCreation(func() error {
+			tnt.ResourceVersion = ""
+			tnt.Spec.NamespaceOptions.NamingPolicy.Mode = api.NamespaceNamingModeValidate
+
+			return k8sClient.Create(context.TODO(), tnt)
+		}).Should(Succeed())
+	})
+	JustAfterEach(func() {
+		Expect(k8sClient.Delete(context.TODO(), tnt)).Should(Succeed())
+	})
+
+	It("should deny the Tenant with an unknown placeholder", func() {
+		invalid := tnt.DeepCopy()
+		invalid.SetName("naming-policy-invalid")
+		invalid.ResourceVersion = ""
+		invalid.Spec.NamespaceOptions.NamingPolicy.Template = "{{ tenant }}-{{ team }}"
+
+		Expect(k8sClient.Create(context.TODO(), invalid)).ShouldNot(Succeed())
+	})
+
+	It("should allow the Namespaces matching the policy", func() {
+		for _, name := range []string{"naming-policy-web-dev", "naming-policy-web-prod"} {
+			NamespaceCreation(NewNamespace(name), tnt.Spec.Owners[0], defaultTimeoutInterval).Should(Succeed())
+			TenantNamespaceList(tnt, defaultTimeoutInterval).Should(ContainElement(name))
+		}
+	})
+
+	It("should deny the Namespaces not matching the policy", func() {
+		for _, name := range []string{"naming-policy-web", "naming-policy-web-test", "naming-policy-web-frontend-prod"} {
+			NamespaceCreation(NewNamespace(name), tnt.Spec.Owners[0], defaultTimeoutInterval).ShouldNot(Succeed())
+		}
+	})
+
+	It("should rename the Namespaces in the mutating mode", func() {
+		EventuallyCreation(func() error {
+			Expect(k8sClient.Get(context.TODO(), types.NamespacedName{Name: tnt.GetName()}, tnt)).Should(Succeed())
+			tnt.Spec.NamespaceOptions.NamingPolicy.Mode = api.NamespaceNamingModeMutate
+
+			return k8sClient.Update(context.TODO(), tnt)
+		}).Should(Succeed())
+
+		NamespaceCreation(NewNamespace("api-prod"), tnt.Spec.Owners[0], defaultTimeoutInterval).Should(Succeed())
+		TenantNamespaceList(tnt, defaultTimeoutInterval).Should(ContainElement("naming-policy-api-prod"))
+
+		NamespaceCreation(NewNamespace("naming-policy-api"), tnt.Spec.Owners[0], defaultTimeoutInterval).ShouldNot(Succeed())
+	})
+})
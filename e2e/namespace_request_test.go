@@ -0,0 +1,241 @@
+//go:build e2e
+
+// Copyright 2020-2023 Project Capsule Authors.
+// SPDX-License-Identifier: Apache-2.0
+
+package e2e
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/pointer"
+
+	capsulev1beta2 "github.com/projectcapsule/capsule/api/v1beta2"
+	"github.com/projectcapsule/capsule/pkg/api"
+)
+
+var _ = Describe("requesting a Namespace beyond the Tenant quota", func() {
+	tnt := &capsulev1beta2.Tenant{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "namespace-request",
+		},
+		Spec: capsulev1beta2.TenantSpec{
+			Owners: capsulev1beta2.OwnerListSpec{
+				{
+					Name: "nora",
+					Kind: "User",
+				},
+			},
+			NamespaceOptions: &capsulev1beta2.NamespaceOptions{
+				Quota: pointer.Int32(1),
+			},
+		},
+	}
+
+	request := func(name string) *capsulev1beta2.NamespaceRequest {
+		return &capsulev1beta2.NamespaceRequest{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "namespace-request-prod",
+			},
+			Spec: capsulev1beta2.NamespaceRequestSpec{
+				Name:          name,
+				Labels:        map[string]string{"env": "training"},
+				Justification: "Hosting the training environment",
+			},
+		}
+	}
+
+	fulfilled := func(name string) func() string {
+		return func() string {
+			found := &capsulev1beta2.NamespaceRequest{}
+			if err := k8sClient.Get(context.TODO(), types.NamespacedName{Name: name, Namespace: "namespace-request-prod"}, found); err != nil {
+				return ""
+			}
+
+			condition := meta.FindStatusCondition(found.Status.Conditions, capsulev1beta2.NamespaceRequestConditionFulfilled)
+			if condition == nil {
+				return ""
+			}
+
+			return condition.Reason
+		}
+	}
+
+	// approveGeneration reviews the request, setting the observed generation returned by the given function
+	approveGeneration := func(name string, status metav1.ConditionStatus, generation func(*capsulev1beta2.NamespaceRequest) int64) {
+		EventuallyCreation(func() error {
+			found := &capsulev1beta2.NamespaceRequest{}
+			if err := k8sClient.Get(context.TODO(), types.NamespacedName{Name: name, Namespace: "namespace-request-prod"}, found); err != nil {
+				return err
+			}
+
+			meta.SetStatusCondition(&found.Status.Conditions, metav1.Condition{
+				Type:               capsulev1beta2.NamespaceRequestConditionApproved,
+				Status:             status,
+				ObservedGeneration: generation(found),
+				Reason:             "ReviewedByAdmin",
+				Message:            "Reviewed by the cluster administrators",
+			})
+
+			return k8sClient.Status().Update(context.TODO(), found)
+		}).Should(Succeed())
+	}
+
+	approve := func(name string, status metav1.ConditionStatus) {
+		approveGeneration(name, status, func(found *capsulev1beta2.NamespaceRequest) int64 {
+			return found.GetGeneration()
+		})
+	}
+
+	approval := func(name string) func() string {
+		return func() string {
+			found := &capsulev1beta2.NamespaceRequest{}
+			if err := k8sClient.Get(context.TODO(), types.NamespacedName{Name: name, Namespace: "namespace-request-prod"}, found); err != nil {
+				return ""
+			}
+
+			condition := meta.FindStatusCondition(found.Status.Conditions, capsulev1beta2.NamespaceRequestConditionApproved)
+			if condition == nil {
+				return ""
+			}
+
+			return condition.Reason
+		}
+	}
+
+	JustBeforeEach(func() {
+		EventuallyCreation(func() error {
+			tnt.ResourceVersion = ""
+			tnt.Spec.NamespaceOptions.Quota = pointer.Int32(1)
+			tnt.Spec.NamespaceOptions.Requests = nil
+
+			return k8sClient.Create(context.TODO(), tnt)
+		}).Should(Succeed())
+
+		ns := NewNamespace("namespace-request-prod")
+		NamespaceCreation(ns, tnt.Spec.Owners[0], defaultTimeoutInterval).Should(Succeed())
+		TenantNamespaceList(tnt, defaultTimeoutInterval).Should(ContainElement(ns.GetName()))
+	})
+
+	JustAfterEach(func() {
+		Expect(k8sClient.Delete(context.TODO(), tnt)).Should(Succeed())
+	})
+
+	It("should create the Namespace once approved", func() {
+		NamespaceCreation(NewNamespace("namespace-request-training"), tnt.Spec.Owners[0], defaultTimeoutInterval).ShouldNot(Succeed())
+
+		EventuallyCreation(func() error {
+			return ownerCtrlClient(tnt.Spec.Owners[0]).Create(context.TODO(), request("namespace-request-training"))
+		}).Should(Succeed())
+
+		Eventually(fulfilled("namespace-request-training"), defaultTimeoutInterval, defaultPollInterval).Should(Equal(capsulev1beta2.NamespaceRequestReasonPending))
+
+		By("denying the Tenant owner from approving the request", func() {
+			found := &capsulev1beta2.NamespaceRequest{}
+			Expect(k8sClient.Get(context.TODO(), types.NamespacedName{Name: "namespace-request-training", Namespace: "namespace-request-prod"}, found)).Should(Succeed())
+
+			meta.SetStatusCondition(&found.Status.Conditions, metav1.Condition{
+				Type:    capsulev1beta2.NamespaceRequestConditionApproved,
+				Status:  metav1.ConditionTrue,
+				Reason:  "SelfApproved",
+				Message: "Approved by the Tenant owner",
+			})
+
+			Expect(ownerCtrlClient(tnt.Spec.Owners[0]).Status().Update(context.TODO(), found)).ShouldNot(Succeed())
+		})
+
+		approve("namespace-request-training", metav1.ConditionTrue)
+
+		Eventually(fulfilled("namespace-request-training"), defaultTimeoutInterval, defaultPollInterval).Should(Equal(capsulev1beta2.NamespaceRequestReasonCreated))
+		TenantNamespaceList(tnt, defaultTimeoutInterval).Should(ContainElement("namespace-request-training"))
+
+		ns := &corev1.Namespace{}
+		Expect(k8sClient.Get(context.TODO(), types.NamespacedName{Name: "namespace-request-training"}, ns)).Should(Succeed())
+		Expect(ns.GetLabels()).Should(HaveKeyWithValue("env", "training"))
+		Expect(ns.GetOwnerReferences()).Should(HaveLen(1))
+		Expect(ns.GetOwnerReferences()[0].Name).Should(Equal(tnt.GetName()))
+	})
+
+	It("should not create the Namespace when the approval is not bound to the reviewed request", func() {
+		EventuallyCreation(func() error {
+			return ownerCtrlClient(tnt.Spec.Owners[0]).Create(context.TODO(), request("namespace-request-unbound"))
+		}).Should(Succeed())
+
+		By("resetting the approval with no observed generation", func() {
+			approveGeneration("namespace-request-unbound", metav1.ConditionTrue, func(*capsulev1beta2.NamespaceRequest) int64 {
+				return 0
+			})
+
+			Eventually(approval("namespace-request-unbound"), defaultTimeoutInterval, defaultPollInterval).Should(Equal(capsulev1beta2.NamespaceRequestReasonUnboundApproval))
+			Eventually(fulfilled("namespace-request-unbound"), defaultTimeoutInterval, defaultPollInterval).Should(Equal(capsulev1beta2.NamespaceRequestReasonPending))
+		})
+
+		By("resetting the approval of the request changed by the Tenant owner", func() {
+			found := &capsulev1beta2.NamespaceRequest{}
+			Expect(k8sClient.Get(context.TODO(), types.NamespacedName{Name: "namespace-request-unbound", Namespace: "namespace-request-prod"}, found)).Should(Succeed())
+
+			reviewed := found.GetGeneration()
+
+			EventuallyCreation(func() error {
+				if err := k8sClient.Get(context.TODO(), types.NamespacedName{Name: "namespace-request-unbound", Namespace: "namespace-request-prod"}, found); err != nil {
+					return err
+				}
+
+				found.Spec.Labels = map[string]string{"env": "changed"}
+
+				return ownerCtrlClient(tnt.Spec.Owners[0]).Update(context.TODO(), found)
+			}).Should(Succeed())
+
+			approveGeneration("namespace-request-unbound", metav1.ConditionTrue, func(*capsulev1beta2.NamespaceRequest) int64 {
+				return reviewed
+			})
+
+			Eventually(approval("namespace-request-unbound"), defaultTimeoutInterval, defaultPollInterval).Should(Equal(capsulev1beta2.NamespaceRequestReasonSpecChanged))
+			Consistently(func() error {
+				return k8sClient.Get(context.TODO(), types.NamespacedName{Name: "namespace-request-unbound"}, &corev1.Namespace{})
+			}, defaultTimeoutInterval, defaultPollInterval).ShouldNot(Succeed())
+		})
+	})
+
+	It("should not create the Namespace when rejected", func() {
+		EventuallyCreation(func() error {
+			return ownerCtrlClient(tnt.Spec.Owners[0]).Create(context.TODO(), request("namespace-request-rejected"))
+		}).Should(Succeed())
+
+		approve("namespace-request-rejected", metav1.ConditionFalse)
+
+		Eventually(fulfilled("namespace-request-rejected"), defaultTimeoutInterval, defaultPollInterval).Should(Equal(capsulev1beta2.NamespaceRequestReasonRejected))
+		Consistently(func() error {
+			return k8sClient.Get(context.TODO(), types.NamespacedName{Name: "namespace-request-rejected"}, &corev1.Namespace{})
+		}, defaultTimeoutInterval, defaultPollInterval).ShouldNot(Succeed())
+	})
+
+	It("should approve the request automatically and raise the quota", func() {
+		EventuallyCreation(func() error {
+			Expect(k8sClient.Get(context.TODO(), types.NamespacedName{Name: tnt.GetName()}, tnt)).Should(Succeed())
+			tnt.Spec.NamespaceOptions.Requests = &api.NamespaceRequestsSpec{
+				AutoApprovalQuota: pointer.Int32(2),
+				RaiseQuota:        true,
+			}
+
+			return k8sClient.Update(context.TODO(), tnt)
+		}).Should(Succeed())
+
+		EventuallyCreation(func() error {
+			return ownerCtrlClient(tnt.Spec.Owners[0]).Create(context.TODO(), request("namespace-request-auto"))
+		}).Should(Succeed())
+
+		Eventually(fulfilled("namespace-request-auto"), defaultTimeoutInterval, defaultPollInterval).Should(Equal(capsulev1beta2.NamespaceRequestReasonCreated))
+		TenantNamespaceList(tnt, defaultTimeoutInterval).Should(ContainElement("namespace-request-auto"))
+
+		Expect(k8sClient.Get(context.TODO(), types.NamespacedName{Name: tnt.GetName()}, tnt)).Should(Succeed())
+		Expect(*tnt.Spec.NamespaceOptions.Quota).Should(Equal(int32(2)))
+	})
+})
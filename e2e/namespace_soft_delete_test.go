@@ -0,0 +1,147 @@
+//go:build e2e
+
+// Copyright 2020-2023 Project Capsule Authors.
+// SPDX-License-Identifier: Apache-2.0
+
+package e2e
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/pointer"
+
+	capsulev1beta2 "github.com/projectcapsule/capsule/api/v1beta2"
+	"github.com/projectcapsule/capsule/pkg/api"
+)
+
+var _ = Describe("soft deleting the Tenant Namespaces", func() {
+	tnt := &capsulev1beta2.Tenant{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "namespace-soft-delete",
+		},
+		Spec: capsulev1beta2.TenantSpec{
+			Owners: capsulev1beta2.OwnerListSpec{
+				{
+					Name: "oscar",
+					Kind: "User",
+				},
+			},
+			NamespaceOptions: &capsulev1beta2.NamespaceOptions{
+				SoftDelete: &api.NamespaceSoftDeleteSpec{
+					GracePeriod: metav1.Duration{Duration: 30 * time.Second},
+				},
+			},
+		},
+	}
+
+	JustBeforeEach(func() {
+		EventuallyCreation(func() error {
+			tnt.ResourceVersion = ""
+			return k8sClient.Create(context.TODO(), tnt)
+		}).Should(Succeed())
+	})
+	JustAfterEach(func() {
+		Expect(k8sClient.Delete(context.TODO(), tnt)).Should(Succeed())
+	})
+
+	It("should put the Namespace pending deletion, and restore it", func() {
+		cs := ownerClient(tnt.Spec.Owners[0])
+
+		ns := NewNamespace("")
+		NamespaceCreation(ns, tnt.Spec.Owners[0], defaultTimeoutInterval).Should(Succeed())
+		TenantNamespaceList(tnt, defaultTimeoutInterval).Should(ContainElement(ns.GetName()))
+
+		deployment := &appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "web",
+				Namespace: ns.GetName(),
+			},
+			Spec: appsv1.DeploymentSpec{
+				Replicas: pointer.Int32(2),
+				Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "web"}},
+				Template: corev1.PodTemplateSpec{
+					ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"app": "web"}},
+					Spec: corev1.PodSpec{
+						Containers: []corev1.Container{
+							{
+								Name:  "container",
+								Image: "gcr.io/google_containers/pause-amd64:3.0",
+							},
+						},
+					},
+				},
+			},
+		}
+
+		EventuallyCreation(func() error {
+			_, err := cs.AppsV1().Deployments(ns.GetName()).Create(context.Background(), deployment, metav1.CreateOptions{})
+
+			return err
+		}).Should(Succeed())
+
+		By("denying the deletion of the Namespace", func() {
+			Expect(cs.CoreV1().Namespaces().Delete(context.Background(), ns.GetName(), metav1.DeleteOptions{})).ShouldNot(Succeed())
+
+			Eventually(func() string {
+				namespace := &corev1.Namespace{}
+				Expect(k8sClient.Get(context.TODO(), types.NamespacedName{Name: ns.GetName()}, namespace)).Should(Succeed())
+
+				return namespace.GetAnnotations()[api.DeletionScheduleAnnotation]
+			}, defaultTimeoutInterval, defaultPollInterval).ShouldNot(BeEmpty())
+		})
+		By("scaling down the workloads", func() {
+			Eventually(func() int32 {
+				d := &appsv1.Deployment{}
+				Expect(k8sClient.Get(context.TODO(), types.NamespacedName{Name: deployment.GetName(), Namespace: ns.GetName()}, d)).Should(Succeed())
+
+				return *d.Spec.Replicas
+			}, defaultTimeoutInterval, defaultPollInterval).Should(BeEquivalentTo(0))
+		})
+		By("cordoning the Namespace", func() {
+			Expect(cs.AppsV1().Deployments(ns.GetName()).Delete(context.Background(), deployment.GetName(), metav1.DeleteOptions{})).ShouldNot(Succeed())
+		})
+		By("restoring the Namespace", func() {
+			Eventually(func() error {
+				namespace, err := cs.CoreV1().Namespaces().Get(context.Background(), ns.GetName(), metav1.GetOptions{})
+				if err != nil {
+					return err
+				}
+
+				namespace.Annotations[api.RestoreAnnotation] = "true"
+
+				_, err = cs.CoreV1().Namespaces().Update(context.Background(), namespace, metav1.UpdateOptions{})
+
+				return err
+			}, defaultTimeoutInterval, defaultPollInterval).Should(Succeed())
+
+			Eventually(func() int32 {
+				d := &appsv1.Deployment{}
+				Expect(k8sClient.Get(context.TODO(), types.NamespacedName{Name: deployment.GetName(), Namespace: ns.GetName()}, d)).Should(Succeed())
+
+				return *d.Spec.Replicas
+			}, defaultTimeoutInterval, defaultPollInterval).Should(BeEquivalentTo(2))
+
+			namespace := &corev1.Namespace{}
+			Expect(k8sClient.Get(context.TODO(), types.NamespacedName{Name: ns.GetName()}, namespace)).Should(Succeed())
+			Expect(namespace.GetLabels()).ShouldNot(HaveKey(api.NamespacePendingDeletionLabel))
+		})
+		By("deleting the Namespace once the grace period expires", func() {
+			Expect(cs.CoreV1().Namespaces().Delete(context.Background(), ns.GetName(), metav1.DeleteOptions{})).ShouldNot(Succeed())
+
+			Eventually(func() bool {
+				namespace := &corev1.Namespace{}
+				err := k8sClient.Get(context.TODO(), types.NamespacedName{Name: ns.GetName()}, namespace)
+
+				return apierrors.IsNotFound(err) || namespace.GetDeletionTimestamp() != nil
+			}, 2*time.Minute, defaultPollInterval).Should(BeTrue())
+		})
+	})
+})
@@ -0,0 +1,187 @@
+//go:build e2e
+
+// Copyright 2020-2023 Project Capsule Authors.
+// SPDX-License-Identifier: Apache-2.0
+
+package e2e
+
+import (
+	"context"
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/pointer"
+
+	capsulev1beta2 "github.com/projectcapsule/capsule/api/v1beta2"
+	"github.com/projectcapsule/capsule/pkg/api"
+)
+
+var _ = Describe("transferring a Namespace between Tenants", func() {
+	source := &capsulev1beta2.Tenant{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "transfer-source",
+		},
+		Spec: capsulev1beta2.TenantSpec{
+			Owners: capsulev1beta2.OwnerListSpec{
+				{
+					Name: "paul",
+					Kind: "User",
+				},
+			},
+		},
+	}
+	destination := &capsulev1beta2.Tenant{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "transfer-destination",
+		},
+		Spec: capsulev1beta2.TenantSpec{
+			Owners: capsulev1beta2.OwnerListSpec{
+				{
+					Name: "paul",
+					Kind: "User",
+				},
+			},
+			NamespaceOptions: &capsulev1beta2.NamespaceOptions{
+				Quota: pointer.Int32(1),
+			},
+		},
+	}
+	budget := &capsulev1beta2.Tenant{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "transfer-budget",
+		},
+		Spec: capsulev1beta2.TenantSpec{
+			Owners: capsulev1beta2.OwnerListSpec{
+				{
+					Name: "paul",
+					Kind: "User",
+				},
+			},
+			ResourceQuota: api.ResourceQuotaSpec{
+				Scope: api.ResourceQuotaScopeTenant,
+				Items: []corev1.ResourceQuotaSpec{
+					{Hard: corev1.ResourceList{corev1.ResourcePersistentVolumeClaims: resource.MustParse("0")}},
+				},
+			},
+		},
+	}
+	foreign := &capsulev1beta2.Tenant{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "transfer-foreign",
+		},
+		Spec: capsulev1beta2.TenantSpec{
+			Owners: capsulev1beta2.OwnerListSpec{
+				{
+					Name: "paula",
+					Kind: "User",
+				},
+			},
+		},
+	}
+
+	transfer := func(namespace, tenant string) error {
+		patch := []byte(fmt.Sprintf(`{"metadata":{"labels":{"capsule.clastix.io/tenant":"%s"}}}`, tenant))
+
+		_, err := ownerClient(source.Spec.Owners[0]).CoreV1().Namespaces().Patch(context.TODO(), namespace, types.MergePatchType, patch, metav1.PatchOptions{})
+
+		return err
+	}
+
+	JustBeforeEach(func() {
+		for _, tnt := range []*capsulev1beta2.Tenant{source, destination, budget, foreign} {
+			EventuallyCreation(func() error {
+				tnt.ResourceVersion = ""
+
+				return k8sClient.Create(context.TODO(), tnt)
+			}).Should(Succeed())
+		}
+	})
+
+	JustAfterEach(func() {
+		for _, tnt := range []*capsulev1beta2.Tenant{source, destination, budget, foreign} {
+			Expect(k8sClient.Delete(context.TODO(), tnt)).Should(Succeed())
+		}
+	})
+
+	It("should transfer the Namespace between the owned Tenants", func() {
+		ns := NewNamespace("transfer-source-dev")
+		NamespaceCreation(ns, source.Spec.Owners[0], defaultTimeoutInterval).Should(Succeed())
+		TenantNamespaceList(source, defaultTimeoutInterval).Should(ContainElement(ns.GetName()))
+
+		Expect(transfer(ns.GetName(), destination.GetName())).Should(Succeed())
+
+		TenantNamespaceList(destination, defaultTimeoutInterval).Should(ContainElement(ns.GetName()))
+		TenantNamespaceList(source, defaultTimeoutInterval).ShouldNot(ContainElement(ns.GetName()))
+
+		Expect(k8sClient.Get(context.TODO(), types.NamespacedName{Name: ns.GetName()}, ns)).Should(Succeed())
+		Expect(ns.GetOwnerReferences()).Should(HaveLen(1))
+		Expect(ns.GetOwnerReferences()[0].Name).Should(Equal(destination.GetName()))
+
+		By("pruning the RoleBindings of the source Tenant", func() {
+			Eventually(func() (names []string) {
+				bindings, err := ownerClient(source.Spec.Owners[0]).RbacV1().RoleBindings(ns.GetName()).List(context.TODO(), metav1.ListOptions{LabelSelector: "capsule.clastix.io/tenant=" + source.GetName()})
+				if err != nil {
+					return []string{err.Error()}
+				}
+
+				for _, binding := range bindings.Items {
+					names = append(names, binding.GetName())
+				}
+
+				return names
+			}, defaultTimeoutInterval, defaultPollInterval).Should(BeEmpty())
+		})
+
+		By("denying the transfer exceeding the destination quota", func() {
+			other := NewNamespace("transfer-source-test")
+			NamespaceCreation(other, source.Spec.Owners[0], defaultTimeoutInterval).Should(Succeed())
+			TenantNamespaceList(source, defaultTimeoutInterval).Should(ContainElement(other.GetName()))
+
+			Expect(transfer(other.GetName(), destination.GetName())).ShouldNot(Succeed())
+		})
+	})
+
+	It("should deny the transfer exceeding the Tenant-scoped quota of the destination", func() {
+		ns := NewNamespace("transfer-source-data")
+		NamespaceCreation(ns, source.Spec.Owners[0], defaultTimeoutInterval).Should(Succeed())
+		TenantNamespaceList(source, defaultTimeoutInterval).Should(ContainElement(ns.GetName()))
+
+		pvc := &corev1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{Name: "data"},
+			Spec: corev1.PersistentVolumeClaimSpec{
+				AccessModes: []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce},
+				Resources: corev1.ResourceRequirements{
+					Requests: corev1.ResourceList{corev1.ResourceStorage: resource.MustParse("1Gi")},
+				},
+			},
+		}
+
+		EventuallyCreation(func() error {
+			_, err := ownerClient(source.Spec.Owners[0]).CoreV1().PersistentVolumeClaims(ns.GetName()).Create(context.TODO(), pvc, metav1.CreateOptions{})
+
+			return err
+		}).Should(Succeed())
+
+		Expect(transfer(ns.GetName(), budget.GetName())).ShouldNot(Succeed())
+		TenantNamespaceList(source, defaultTimeoutInterval).Should(ContainElement(ns.GetName()))
+	})
+
+	It("should deny the transfer to a non-owned Tenant", func() {
+		ns := NewNamespace("transfer-source-prod")
+		NamespaceCreation(ns, source.Spec.Owners[0], defaultTimeoutInterval).Should(Succeed())
+		TenantNamespaceList(source, defaultTimeoutInterval).Should(ContainElement(ns.GetName()))
+
+		Expect(transfer(ns.GetName(), foreign.GetName())).ShouldNot(Succeed())
+
+		Consistently(func() []string {
+			Expect(k8sClient.Get(context.TODO(), types.NamespacedName{Name: foreign.GetName()}, foreign)).Should(Succeed())
+
+			return foreign.Status.Namespaces
+		}, defaultTimeoutInterval, defaultPollInterval).ShouldNot(ContainElement(ns.GetName()))
+	})
+})
@@ -0,0 +1,140 @@
+//go:build e2e
+
+// Copyright 2020-2023 Project Capsule Authors.
+// SPDX-License-Identifier: Apache-2.0
+
+package e2e
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	capsulev1beta2 "github.com/projectcapsule/capsule/api/v1beta2"
+	"github.com/projectcapsule/capsule/pkg/api"
+)
+
+var _ = Describe("assigning a Node Pool", func() {
+	tnt := &capsulev1beta2.Tenant{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "node-pool",
+		},
+		Spec: capsulev1beta2.TenantSpec{
+			Owners: capsulev1beta2.OwnerListSpec{
+				{
+					Name: "olaf",
+					Kind: "User",
+				},
+			},
+			NodeSelector: map[string]string{"pool": "olaf"},
+			NodePool: &api.NodePoolSpec{
+				InjectNodeSelector: true,
+				Tolerations: []corev1.Toleration{
+					{
+						Key:      "pool",
+						Operator: corev1.TolerationOpEqual,
+						Value:    "olaf",
+						Effect:   corev1.TaintEffectNoSchedule,
+					},
+				},
+				ReservedTaints: []api.ReservedTaintSpec{
+					{
+						Key:   "pool",
+						Value: "olaf",
+					},
+				},
+			},
+		},
+	}
+
+	other := &capsulev1beta2.Tenant{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "node-pool-other",
+		},
+		Spec: capsulev1beta2.TenantSpec{
+			Owners: capsulev1beta2.OwnerListSpec{
+				{
+					Name: "hans",
+					Kind: "User",
+				},
+			},
+		},
+	}
+
+	JustBeforeEach(func() {
+		for _, t := range []*capsulev1beta2.Tenant{tnt, other} {
+			tenant := t
+
+			EventuallyCreation(func() error {
+				tenant.ResourceVersion = ""
+				return k8sClient.Create(context.TODO(), tenant)
+			}).Should(Succeed())
+		}
+	})
+	JustAfterEach(func() {
+		for _, tenant := range []*capsulev1beta2.Tenant{tnt, other} {
+			Expect(k8sClient.Delete(context.TODO(), tenant)).Should(Succeed())
+		}
+	})
+
+	newPod := func(tolerations ...corev1.Toleration) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "container",
+			},
+			Spec: corev1.PodSpec{
+				Containers: []corev1.Container{
+					{
+						Name:  "container",
+						Image: "quay.io/google-containers/pause-amd64:3.0",
+					},
+				},
+				Tolerations: tolerations,
+			},
+		}
+	}
+
+	It("should inject the node selector and the tolerations", func() {
+		ns := NewNamespace("")
+		NamespaceCreation(ns, tnt.Spec.Owners[0], defaultTimeoutInterval).Should(Succeed())
+
+		cs := ownerClient(tnt.Spec.Owners[0])
+
+		var pod *corev1.Pod
+
+		EventuallyCreation(func() (err error) {
+			pod, err = cs.CoreV1().Pods(ns.Name).Create(context.Background(), newPod(), metav1.CreateOptions{})
+
+			return err
+		}).Should(Succeed())
+
+		Expect(pod.Spec.NodeSelector).To(HaveKeyWithValue("pool", "olaf"))
+		Expect(pod.Spec.Tolerations).To(ContainElement(tnt.Spec.NodePool.Tolerations[0]))
+	})
+
+	It("should deny the tolerations of taints reserved to other Tenants", func() {
+		ns := NewNamespace("")
+		NamespaceCreation(ns, other.Spec.Owners[0], defaultTimeoutInterval).Should(Succeed())
+
+		cs := ownerClient(other.Spec.Owners[0])
+
+		By("tolerating the reserved taint", func() {
+			_, err := cs.CoreV1().Pods(ns.Name).Create(context.Background(), newPod(corev1.Toleration{Key: "pool", Operator: corev1.TolerationOpExists}), metav1.CreateOptions{})
+			Expect(err).ShouldNot(Succeed())
+		})
+		By("tolerating any taint", func() {
+			_, err := cs.CoreV1().Pods(ns.Name).Create(context.Background(), newPod(corev1.Toleration{Operator: corev1.TolerationOpExists}), metav1.CreateOptions{})
+			Expect(err).ShouldNot(Succeed())
+		})
+		By("tolerating a non reserved taint", func() {
+			EventuallyCreation(func() error {
+				_, err := cs.CoreV1().Pods(ns.Name).Create(context.Background(), newPod(corev1.Toleration{Key: "pool", Operator: corev1.TolerationOpEqual, Value: "shared"}), metav1.CreateOptions{})
+
+				return err
+			}).Should(Succeed())
+		})
+	})
+})
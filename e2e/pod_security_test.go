@@ -0,0 +1,166 @@
+//go:build e2e
+
+// Copyright 2020-2023 Project Capsule Authors.
+// SPDX-License-Identifier: Apache-2.0
+
+package e2e
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/pointer"
+
+	capsulev1beta2 "github.com/projectcapsule/capsule/api/v1beta2"
+	"github.com/projectcapsule/capsule/pkg/api"
+)
+
+var _ = Describe("enforcing the Pod Security", func() {
+	tnt := &capsulev1beta2.Tenant{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "pod-security",
+		},
+		Spec: capsulev1beta2.TenantSpec{
+			Owners: capsulev1beta2.OwnerListSpec{
+				{
+					Name: "elsa",
+					Kind: "User",
+				},
+			},
+			PodSecurity: &api.PodSecuritySpec{
+				Enforce: &api.PodSecurityStandardSpec{
+					Level: api.PodSecurityLevelBaseline,
+				},
+				Restrictions: &api.PodSecurityRestrictionsSpec{
+					ForbidPrivileged:    true,
+					AllowedCapabilities: &[]corev1.Capability{},
+					Exceptions: []api.PodSecurityExceptionSpec{
+						{
+							PodSelector:         &metav1.LabelSelector{MatchLabels: map[string]string{"app": "proxy"}},
+							AllowedCapabilities: []corev1.Capability{"NET_BIND_SERVICE"},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	JustBeforeEach(func() {
+		EventuallyCreation(func() error {
+			tnt.ResourceVersion = ""
+			return k8sClient.Create(context.TODO(), tnt)
+		}).Should(Succeed())
+	})
+	JustAfterEach(func() {
+		Expect(k8sClient.Delete(context.TODO(), tnt)).Should(Succeed())
+	})
+
+	It("should set the Pod Security Admission labels, not allowing to lower them", func() {
+		ns := NewNamespace("")
+		NamespaceCreation(ns, tnt.Spec.Owners[0], defaultTimeoutInterval).Should(Succeed())
+
+		Eventually(func() map[string]string {
+			Expect(k8sClient.Get(context.Background(), types.NamespacedName{Name: ns.GetName()}, ns)).Should(Succeed())
+
+			return ns.GetLabels()
+		}, defaultTimeoutInterval, defaultPollInterval).Should(And(
+			HaveKeyWithValue("pod-security.kubernetes.io/enforce", "baseline"),
+			HaveKeyWithValue("pod-security.kubernetes.io/enforce-version", "latest"),
+		))
+
+		role := &rbacv1.Role{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "ns-patch",
+				Namespace: ns.GetName(),
+			},
+			Rules: []rbacv1.PolicyRule{
+				{
+					Verbs:     []string{"patch", "update"},
+					APIGroups: []string{""},
+					Resources: []string{"namespaces"},
+				},
+			},
+		}
+		Expect(k8sClient.Create(context.Background(), role)).To(Succeed())
+
+		roleBinding := &rbacv1.RoleBinding{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "ns-patch",
+				Namespace: ns.GetName(),
+			},
+			Subjects: []rbacv1.Subject{
+				{
+					APIGroup: "rbac.authorization.k8s.io",
+					Kind:     tnt.Spec.Owners[0].Kind.String(),
+					Name:     tnt.Spec.Owners[0].Name,
+				},
+			},
+			RoleRef: rbacv1.RoleRef{
+				APIGroup: "rbac.authorization.k8s.io",
+				Kind:     "Role",
+				Name:     role.GetName(),
+			},
+		}
+		Expect(k8sClient.Create(context.Background(), roleBinding)).To(Succeed())
+
+		cs := ownerClient(tnt.Spec.Owners[0])
+		Consistently(func() error {
+			if err := k8sClient.Get(context.Background(), types.NamespacedName{Name: ns.GetName()}, ns); err != nil {
+				return nil
+			}
+
+			ns.Labels["pod-security.kubernetes.io/enforce"] = "privileged"
+
+			_, err := cs.CoreV1().Namespaces().Update(context.Background(), ns, metav1.UpdateOptions{})
+
+			return err
+		}, 10*time.Second, time.Second).ShouldNot(Succeed())
+	})
+
+	It("should validate the Pod Security restrictions", func() {
+		ns := NewNamespace("")
+		NamespaceCreation(ns, tnt.Spec.Owners[0], defaultTimeoutInterval).Should(Succeed())
+
+		newPod := func(labels map[string]string, securityContext *corev1.SecurityContext) *corev1.Pod {
+			return &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					GenerateName: "container-",
+					Labels:       labels,
+				},
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{
+						{
+							Name:            "container",
+							Image:           "quay.io/google-containers/pause-amd64:3.0",
+							SecurityContext: securityContext,
+						},
+					},
+				},
+			}
+		}
+
+		cs := ownerClient(tnt.Spec.Owners[0])
+
+		By("denying a privileged container", func() {
+			_, err := cs.CoreV1().Pods(ns.Name).Create(context.Background(), newPod(nil, &corev1.SecurityContext{Privileged: pointer.Bool(true)}), metav1.CreateOptions{})
+			Expect(err).ShouldNot(Succeed())
+		})
+		By("denying an added capability", func() {
+			_, err := cs.CoreV1().Pods(ns.Name).Create(context.Background(), newPod(nil, &corev1.SecurityContext{Capabilities: &corev1.Capabilities{Add: []corev1.Capability{"NET_BIND_SERVICE"}}}), metav1.CreateOptions{})
+			Expect(err).ShouldNot(Succeed())
+		})
+		By("allowing an added capability granted by an exception", func() {
+			EventuallyCreation(func() error {
+				_, err := cs.CoreV1().Pods(ns.Name).Create(context.Background(), newPod(map[string]string{"app": "proxy"}, &corev1.SecurityContext{Capabilities: &corev1.Capabilities{Add: []corev1.Capability{"NET_BIND_SERVICE"}}}), metav1.CreateOptions{})
+
+				return err
+			}).Should(Succeed())
+		})
+	})
+})
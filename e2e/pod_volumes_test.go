@@ -0,0 +1,116 @@
+//go:build e2e
+
+// Copyright 2020-2023 Project Capsule Authors.
+// SPDX-License-Identifier: Apache-2.0
+
+package e2e
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	capsulev1beta2 "github.com/projectcapsule/capsule/api/v1beta2"
+	"github.com/projectcapsule/capsule/pkg/api"
+)
+
+var _ = Describe("enforcing the Pod volumes", func() {
+	tnt := &capsulev1beta2.Tenant{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "pod-volumes",
+		},
+		Spec: capsulev1beta2.TenantSpec{
+			Owners: capsulev1beta2.OwnerListSpec{
+				{
+					Name: "anna",
+					Kind: "User",
+				},
+			},
+			VolumeOptions: &api.VolumeOptions{
+				AllowedTypes: []api.VolumeType{"emptyDir", "hostPath", "nfs"},
+				AllowedHostPaths: []api.AllowedHostPath{
+					{
+						PathPrefix: "/var/log",
+						ReadOnly:   true,
+					},
+				},
+				AllowedNFSServers: &api.AllowedListSpec{
+					Exact: []string{"nfs.acme.com"},
+				},
+			},
+		},
+	}
+
+	JustBeforeEach(func() {
+		EventuallyCreation(func() error {
+			tnt.ResourceVersion = ""
+			return k8sClient.Create(context.TODO(), tnt)
+		}).Should(Succeed())
+	})
+	JustAfterEach(func() {
+		Expect(k8sClient.Delete(context.TODO(), tnt)).Should(Succeed())
+	})
+
+	newPod := func(source corev1.VolumeSource, readOnly bool) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				GenerateName: "container-",
+			},
+			Spec: corev1.PodSpec{
+				Containers: []corev1.Container{
+					{
+						Name:  "container",
+						Image: "quay.io/google-containers/pause-amd64:3.0",
+						VolumeMounts: []corev1.VolumeMount{
+							{
+								Name:      "volume",
+								MountPath: "/data",
+								ReadOnly:  readOnly,
+							},
+						},
+					},
+				},
+				Volumes: []corev1.Volume{
+					{
+						Name:         "volume",
+						VolumeSource: source,
+					},
+				},
+			},
+		}
+	}
+
+	It("should validate the volumes", func() {
+		ns := NewNamespace("")
+		NamespaceCreation(ns, tnt.Spec.Owners[0], defaultTimeoutInterval).Should(Succeed())
+
+		cs := ownerClient(tnt.Spec.Owners[0])
+
+		By("denying a forbidden volume type", func() {
+			_, err := cs.CoreV1().Pods(ns.Name).Create(context.Background(), newPod(corev1.VolumeSource{ConfigMap: &corev1.ConfigMapVolumeSource{LocalObjectReference: corev1.LocalObjectReference{Name: "config"}}}, false), metav1.CreateOptions{})
+			Expect(err).ShouldNot(Succeed())
+		})
+		By("denying a forbidden host path", func() {
+			_, err := cs.CoreV1().Pods(ns.Name).Create(context.Background(), newPod(corev1.VolumeSource{HostPath: &corev1.HostPathVolumeSource{Path: "/etc"}}, true), metav1.CreateOptions{})
+			Expect(err).ShouldNot(Succeed())
+		})
+		By("denying a writable host path", func() {
+			_, err := cs.CoreV1().Pods(ns.Name).Create(context.Background(), newPod(corev1.VolumeSource{HostPath: &corev1.HostPathVolumeSource{Path: "/var/log/pods"}}, false), metav1.CreateOptions{})
+			Expect(err).ShouldNot(Succeed())
+		})
+		By("denying a forbidden NFS server", func() {
+			_, err := cs.CoreV1().Pods(ns.Name).Create(context.Background(), newPod(corev1.VolumeSource{NFS: &corev1.NFSVolumeSource{Server: "nfs.evil.com", Path: "/"}}, false), metav1.CreateOptions{})
+			Expect(err).ShouldNot(Succeed())
+		})
+		By("allowing a read-only host path", func() {
+			EventuallyCreation(func() error {
+				_, err := cs.CoreV1().Pods(ns.Name).Create(context.Background(), newPod(corev1.VolumeSource{HostPath: &corev1.HostPathVolumeSource{Path: "/var/log/pods"}}, true), metav1.CreateOptions{})
+
+				return err
+			}).Should(Succeed())
+		})
+	})
+})
@@ -0,0 +1,153 @@
+//go:build e2e
+
+// Copyright 2020-2023 Project Capsule Authors.
+// SPDX-License-Identifier: Apache-2.0
+
+package e2e
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/pointer"
+
+	capsulev1beta2 "github.com/projectcapsule/capsule/api/v1beta2"
+	"github.com/projectcapsule/capsule/pkg/api"
+)
+
+var _ = Describe("enforcing the Tenant policies with a mode", func() {
+	tnt := &capsulev1beta2.Tenant{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "policy-enforcement",
+		},
+		Spec: capsulev1beta2.TenantSpec{
+			Owners: capsulev1beta2.OwnerListSpec{
+				{
+					Name: "quentin",
+					Kind: "User",
+				},
+			},
+			ContainerRegistries: &api.AllowedListSpec{
+				Exact: []string{"docker.io"},
+			},
+		},
+	}
+
+	pod := func() *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "container",
+			},
+			Spec: corev1.PodSpec{
+				Containers: []corev1.Container{
+					{
+						Name:  "container",
+						Image: "gcr.io/google_containers/pause-amd64:3.0",
+					},
+				},
+			},
+		}
+	}
+
+	JustBeforeEach(func() {
+		EventuallyCreation(func() error {
+			tnt.ResourceVersion = ""
+			return k8sClient.Create(context.TODO(), tnt)
+		}).Should(Succeed())
+	})
+	JustAfterEach(func() {
+		Expect(k8sClient.Delete(context.TODO(), tnt)).Should(Succeed())
+	})
+
+	setEnforcement := func(enforcement *api.EnforcementSpec) {
+		Eventually(func() error {
+			if err := k8sClient.Get(context.TODO(), types.NamespacedName{Name: tnt.GetName()}, tnt); err != nil {
+				return err
+			}
+
+			tnt.Spec.Enforcement = enforcement
+
+			return k8sClient.Update(context.TODO(), tnt)
+		}, defaultTimeoutInterval, defaultPollInterval).Should(Succeed())
+	}
+
+	It("should deny the violations by default", func() {
+		ns := NewNamespace("")
+		NamespaceCreation(ns, tnt.Spec.Owners[0], defaultTimeoutInterval).Should(Succeed())
+		TenantNamespaceList(tnt, defaultTimeoutInterval).Should(ContainElement(ns.GetName()))
+
+		cs := ownerClient(tnt.Spec.Owners[0])
+		_, err := cs.CoreV1().Pods(ns.Name).Create(context.Background(), pod(), metav1.CreateOptions{})
+		Expect(err).ShouldNot(Succeed())
+	})
+
+	It("should allow the violations of a policy in Warn mode", func() {
+		ns := NewNamespace("")
+		NamespaceCreation(ns, tnt.Spec.Owners[0], defaultTimeoutInterval).Should(Succeed())
+		TenantNamespaceList(tnt, defaultTimeoutInterval).Should(ContainElement(ns.GetName()))
+
+		setEnforcement(&api.EnforcementSpec{
+			Mode: api.EnforcementModeEnforce,
+			Policies: []api.PolicyEnforcementSpec{
+				{Reason: "ForbiddenContainerRegistry", Mode: api.EnforcementModeWarn},
+			},
+		})
+
+		cs := ownerClient(tnt.Spec.Owners[0])
+		EventuallyCreation(func() error {
+			_, err := cs.CoreV1().Pods(ns.Name).Create(context.Background(), pod(), metav1.CreateOptions{})
+			return err
+		}).Should(Succeed())
+	})
+
+	It("should allow the violations in Audit mode, recording an event", func() {
+		ns := NewNamespace("")
+		NamespaceCreation(ns, tnt.Spec.Owners[0], defaultTimeoutInterval).Should(Succeed())
+		TenantNamespaceList(tnt, defaultTimeoutInterval).Should(ContainElement(ns.GetName()))
+
+		setEnforcement(&api.EnforcementSpec{Mode: api.EnforcementModeAudit})
+
+		cs := ownerClient(tnt.Spec.Owners[0])
+		EventuallyCreation(func() error {
+			_, err := cs.CoreV1().Pods(ns.Name).Create(context.Background(), pod(), metav1.CreateOptions{})
+			return err
+		}).Should(Succeed())
+
+		Eventually(func() (reasons []string) {
+			events := &corev1.EventList{}
+			Expect(k8sClient.List(context.TODO(), events)).Should(Succeed())
+
+			for _, event := range events.Items {
+				if event.InvolvedObject.Kind == "Tenant" && event.InvolvedObject.Name == tnt.GetName() {
+					reasons = append(reasons, event.Reason)
+				}
+			}
+
+			return reasons
+		}, defaultTimeoutInterval, defaultPollInterval).Should(ContainElement("PolicyViolationAudited"))
+	})
+
+	It("should enforce the denials other than the Tenant policies in Audit mode", func() {
+		ns := NewNamespace("")
+		NamespaceCreation(ns, tnt.Spec.Owners[0], defaultTimeoutInterval).Should(Succeed())
+		TenantNamespaceList(tnt, defaultTimeoutInterval).Should(ContainElement(ns.GetName()))
+
+		setEnforcement(&api.EnforcementSpec{Mode: api.EnforcementModeAudit})
+
+		Eventually(func() error {
+			if err := k8sClient.Get(context.TODO(), types.NamespacedName{Name: tnt.GetName()}, tnt); err != nil {
+				return err
+			}
+
+			tnt.Spec.NamespaceOptions = &capsulev1beta2.NamespaceOptions{Quota: pointer.Int32(1)}
+
+			return k8sClient.Update(context.TODO(), tnt)
+		}, defaultTimeoutInterval, defaultPollInterval).Should(Succeed())
+
+		NamespaceCreation(NewNamespace(""), tnt.Spec.Owners[0], defaultTimeoutInterval).ShouldNot(Succeed())
+	})
+})
@@ -0,0 +1,104 @@
+//go:build e2e
+
+// Copyright 2020-2023 Project Capsule Authors.
+// SPDX-License-Identifier: Apache-2.0
+
+package e2e
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	capsulev1beta2 "github.com/projectcapsule/capsule/api/v1beta2"
+	"github.com/projectcapsule/capsule/pkg/api"
+)
+
+var _ = Describe("rewriting images to Registry Mirrors", func() {
+	tnt := &capsulev1beta2.Tenant{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "registry-mirror",
+		},
+		Spec: capsulev1beta2.TenantSpec{
+			Owners: capsulev1beta2.OwnerListSpec{
+				{
+					Name: "kristoff",
+					Kind: "User",
+				},
+			},
+			RegistryMirrors: []api.RegistryMirrorSpec{
+				{
+					Source: "docker.io",
+					Mirror: "mirror.internal/dockerhub",
+				},
+			},
+			ContainerRegistries: &api.AllowedListSpec{
+				Exact: []string{"mirror.internal", "quay.io"},
+			},
+		},
+	}
+
+	JustBeforeEach(func() {
+		EventuallyCreation(func() error {
+			tnt.ResourceVersion = ""
+			return k8sClient.Create(context.TODO(), tnt)
+		}).Should(Succeed())
+	})
+	JustAfterEach(func() {
+		Expect(k8sClient.Delete(context.TODO(), tnt)).Should(Succeed())
+	})
+
+	It("should deny an invalid mirror", func() {
+		invalid := tnt.DeepCopy()
+		invalid.Spec.RegistryMirrors = []api.RegistryMirrorSpec{{Source: "docker.io", Mirror: "docker.io/mirror"}}
+
+		Expect(k8sClient.Update(context.TODO(), invalid)).ShouldNot(Succeed())
+	})
+
+	It("should rewrite the mirrored images", func() {
+		ns := NewNamespace("")
+		NamespaceCreation(ns, tnt.Spec.Owners[0], defaultTimeoutInterval).Should(Succeed())
+
+		pod := &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "container",
+			},
+			Spec: corev1.PodSpec{
+				InitContainers: []corev1.Container{
+					{
+						Name:  "init",
+						Image: "busybox:1.36",
+					},
+				},
+				Containers: []corev1.Container{
+					{
+						Name:  "container",
+						Image: "docker.io/library/nginx:alpine",
+					},
+					{
+						Name:  "sidecar",
+						Image: "quay.io/google-containers/pause-amd64:3.0",
+					},
+				},
+			},
+		}
+
+		cs := ownerClient(tnt.Spec.Owners[0])
+		EventuallyCreation(func() (err error) {
+			created, err := cs.CoreV1().Pods(ns.Name).Create(context.Background(), pod, metav1.CreateOptions{})
+			if err == nil {
+				pod = created
+			}
+
+			return err
+		}).Should(Succeed())
+
+		Expect(pod.Spec.InitContainers[0].Image).To(Equal("mirror.internal/dockerhub/library/busybox:1.36"))
+		Expect(pod.Spec.Containers[0].Image).To(Equal("mirror.internal/dockerhub/library/nginx:alpine"))
+		Expect(pod.Spec.Containers[1].Image).To(Equal("quay.io/google-containers/pause-amd64:3.0"))
+		Expect(pod.GetAnnotations()).To(HaveKeyWithValue(api.OriginalImagesAnnotation, `{"container":"docker.io/library/nginx:alpine","init":"busybox:1.36"}`))
+	})
+})
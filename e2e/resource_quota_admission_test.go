@@ -0,0 +1,99 @@
+//go:build e2e
+
+// Copyright 2020-2023 Project Capsule Authors.
+// SPDX-License-Identifier: Apache-2.0
+
+package e2e
+
+import (
+	"context"
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	capsulev1beta2 "github.com/projectcapsule/capsule/api/v1beta2"
+	"github.com/projectcapsule/capsule/pkg/api"
+)
+
+var _ = Describe("enforcing a Tenant resource quota at admission time", func() {
+	tnt := &capsulev1beta2.Tenant{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "tenant-quota-admission",
+		},
+		Spec: capsulev1beta2.TenantSpec{
+			Owners: capsulev1beta2.OwnerListSpec{
+				{
+					Name: "nina",
+					Kind: "User",
+				},
+			},
+			ResourceQuota: api.ResourceQuotaSpec{
+				Scope: api.ResourceQuotaScopeTenant,
+				Items: []corev1.ResourceQuotaSpec{
+					{
+						Hard: map[corev1.ResourceName]resource.Quantity{
+							corev1.ResourcePods: resource.MustParse("2"),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	nsl := []string{"quota-admission-dev", "quota-admission-prod"}
+
+	JustBeforeEach(func() {
+		EventuallyCreation(func() error {
+			tnt.ResourceVersion = ""
+
+			return k8sClient.Create(context.TODO(), tnt)
+		}).Should(Succeed())
+
+		for _, i := range nsl {
+			ns := NewNamespace(i)
+			NamespaceCreation(ns, tnt.Spec.Owners[0], defaultTimeoutInterval).Should(Succeed())
+			TenantNamespaceList(tnt, defaultTimeoutInterval).Should(ContainElement(ns.GetName()))
+		}
+	})
+
+	JustAfterEach(func() {
+		Expect(k8sClient.Delete(context.TODO(), tnt)).Should(Succeed())
+	})
+
+	It("should deny Pods exceeding the Tenant budget without waiting for the reconciliation", func() {
+		cs := ownerClient(tnt.Spec.Owners[0])
+
+		pod := func(name string) *corev1.Pod {
+			return &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: name,
+				},
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{
+						{
+							Name:  "container",
+							Image: "quay.io/google-containers/pause-amd64:3.0",
+						},
+					},
+				},
+			}
+		}
+
+		for i, ns := range nsl {
+			EventuallyCreation(func() error {
+				_, err := cs.CoreV1().Pods(ns).Create(context.Background(), pod(fmt.Sprintf("pause-%d", i)), metav1.CreateOptions{})
+
+				return err
+			}).Should(Succeed())
+		}
+
+		for _, ns := range nsl {
+			_, err := cs.CoreV1().Pods(ns).Create(context.Background(), pod("exceeding"), metav1.CreateOptions{})
+			Expect(err).ShouldNot(Succeed())
+		}
+	})
+})
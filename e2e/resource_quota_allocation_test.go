@@ -0,0 +1,143 @@
+//go:build e2e
+
+// Copyright 2020-2023 Project Capsule Authors.
+// SPDX-License-Identifier: Apache-2.0
+
+package e2e
+
+import (
+	"context"
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	capsulev1beta2 "github.com/projectcapsule/capsule/api/v1beta2"
+	"github.com/projectcapsule/capsule/pkg/api"
+)
+
+var _ = Describe("splitting a Tenant resource quota across Namespaces", func() {
+	tnt := &capsulev1beta2.Tenant{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "tenant-quota-allocation",
+		},
+		Spec: capsulev1beta2.TenantSpec{
+			Owners: capsulev1beta2.OwnerListSpec{
+				{
+					Name: "olga",
+					Kind: "User",
+				},
+			},
+			ResourceQuota: api.ResourceQuotaSpec{
+				Scope: api.ResourceQuotaScopeTenant,
+				Items: []corev1.ResourceQuotaSpec{
+					{
+						Hard: map[corev1.ResourceName]resource.Quantity{
+							corev1.ResourceLimitsCPU: resource.MustParse("10"),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	nsl := []string{"quota-allocation-prod", "quota-allocation-dev", "quota-allocation-test"}
+
+	allocation := func(namespace, cpu string) *capsulev1beta2.ResourceQuotaAllocation {
+		return &capsulev1beta2.ResourceQuotaAllocation{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "cpu",
+				Namespace: namespace,
+			},
+			Spec: capsulev1beta2.ResourceQuotaAllocationSpec{
+				Index: 0,
+				Hard: corev1.ResourceList{
+					corev1.ResourceLimitsCPU: resource.MustParse(cpu),
+				},
+			},
+		}
+	}
+
+	hardCPU := func(namespace string) func() string {
+		return func() string {
+			rq := &corev1.ResourceQuota{}
+			if err := k8sClient.Get(context.TODO(), types.NamespacedName{Name: fmt.Sprintf("capsule-%s-0", tnt.GetName()), Namespace: namespace}, rq); err != nil {
+				return ""
+			}
+
+			quantity := rq.Spec.Hard[corev1.ResourceLimitsCPU]
+
+			return quantity.String()
+		}
+	}
+
+	accepted := func(namespace string) func() metav1.ConditionStatus {
+		return func() metav1.ConditionStatus {
+			found := &capsulev1beta2.ResourceQuotaAllocation{}
+			if err := k8sClient.Get(context.TODO(), types.NamespacedName{Name: "cpu", Namespace: namespace}, found); err != nil {
+				return metav1.ConditionUnknown
+			}
+
+			condition := meta.FindStatusCondition(found.Status.Conditions, capsulev1beta2.ResourceQuotaAllocationConditionAccepted)
+			if condition == nil {
+				return metav1.ConditionUnknown
+			}
+
+			return condition.Status
+		}
+	}
+
+	JustBeforeEach(func() {
+		EventuallyCreation(func() error {
+			tnt.ResourceVersion = ""
+
+			return k8sClient.Create(context.TODO(), tnt)
+		}).Should(Succeed())
+
+		for _, i := range nsl {
+			ns := NewNamespace(i)
+			NamespaceCreation(ns, tnt.Spec.Owners[0], defaultTimeoutInterval).Should(Succeed())
+			TenantNamespaceList(tnt, defaultTimeoutInterval).Should(ContainElement(ns.GetName()))
+		}
+	})
+
+	JustAfterEach(func() {
+		Expect(k8sClient.Delete(context.TODO(), tnt)).Should(Succeed())
+	})
+
+	It("should reserve the allocated resources and share the remaining ones", func() {
+		EventuallyCreation(func() error {
+			return k8sClient.Create(context.TODO(), allocation(nsl[0], "6"))
+		}).Should(Succeed())
+
+		Eventually(accepted(nsl[0]), defaultTimeoutInterval, defaultPollInterval).Should(Equal(metav1.ConditionTrue))
+		Eventually(hardCPU(nsl[0]), defaultTimeoutInterval, defaultPollInterval).Should(Equal("6"))
+
+		for _, ns := range nsl[1:] {
+			Eventually(hardCPU(ns), defaultTimeoutInterval, defaultPollInterval).Should(Equal("4"))
+		}
+
+		By("rejecting an allocation exceeding the remaining budget", func() {
+			EventuallyCreation(func() error {
+				return k8sClient.Create(context.TODO(), allocation(nsl[1], "5"))
+			}).Should(Succeed())
+
+			Eventually(accepted(nsl[1]), defaultTimeoutInterval, defaultPollInterval).Should(Equal(metav1.ConditionFalse))
+			Consistently(hardCPU(nsl[1]), defaultTimeoutInterval, defaultPollInterval).Should(Equal("4"))
+		})
+
+		By("restoring the shared budget upon the allocation deletion", func() {
+			Expect(k8sClient.Delete(context.TODO(), allocation(nsl[0], "6"))).Should(Succeed())
+
+			Eventually(accepted(nsl[1]), defaultTimeoutInterval, defaultPollInterval).Should(Equal(metav1.ConditionTrue))
+			Eventually(hardCPU(nsl[0]), defaultTimeoutInterval, defaultPollInterval).Should(Equal("5"))
+			Eventually(hardCPU(nsl[1]), defaultTimeoutInterval, defaultPollInterval).Should(Equal("5"))
+			Eventually(hardCPU(nsl[2]), defaultTimeoutInterval, defaultPollInterval).Should(Equal("5"))
+		})
+	})
+})
@@ -0,0 +1,205 @@
+//go:build e2e
+
+// Copyright 2020-2023 Project Capsule Authors.
+// SPDX-License-Identifier: Apache-2.0
+
+package e2e
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/pointer"
+
+	capsulev1beta2 "github.com/projectcapsule/capsule/api/v1beta2"
+	"github.com/projectcapsule/capsule/pkg/api"
+)
+
+var _ = Describe("enforcing the LoadBalancer and NodePort Services options", func() {
+	tnt := &capsulev1beta2.Tenant{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "service-load-balancer",
+		},
+		Spec: capsulev1beta2.TenantSpec{
+			Owners: capsulev1beta2.OwnerListSpec{
+				{
+					Name: "lloyd",
+					Kind: "User",
+				},
+			},
+			ServiceOptions: &api.ServiceOptions{
+				LoadBalancer: &api.LoadBalancerOptions{
+					AllowedClasses: &api.DefaultAllowedListSpec{
+						Default: "capsule.clastix.io/internal",
+						SelectorAllowedListSpec: api.SelectorAllowedListSpec{
+							AllowedListSpec: api.AllowedListSpec{
+								Exact: []string{"capsule.clastix.io/public"},
+							},
+						},
+					},
+					AllowedIPs: &api.AllowedIPsSpec{
+						Allowed: []api.AllowedIP{"10.10.0.0/24"},
+					},
+					AllowedSourceRanges: &api.AllowedIPsSpec{
+						Allowed: []api.AllowedIP{"10.0.0.0/8"},
+					},
+					MaxServices: pointer.Int32(1),
+				},
+				NodePort: &api.NodePortOptions{
+					AllowedRanges: []api.NodePortRange{
+						{From: 31000, To: 31099},
+					},
+				},
+			},
+		},
+	}
+
+	newService := func(name string, serviceType corev1.ServiceType, nodePort int32) *corev1.Service {
+		return &corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: name,
+			},
+			Spec: corev1.ServiceSpec{
+				Type: serviceType,
+				Ports: []corev1.ServicePort{
+					{
+						Name:       "http",
+						Protocol:   corev1.ProtocolTCP,
+						Port:       80,
+						TargetPort: intstr.FromInt(8080),
+						NodePort:   nodePort,
+					},
+				},
+				Selector: map[string]string{
+					"app": name,
+				},
+			},
+		}
+	}
+
+	JustBeforeEach(func() {
+		EventuallyCreation(func() error {
+			tnt.ResourceVersion = ""
+			return k8sClient.Create(context.TODO(), tnt)
+		}).Should(Succeed())
+	})
+	JustAfterEach(func() {
+		Expect(k8sClient.Delete(context.TODO(), tnt)).Should(Succeed())
+	})
+
+	It("should assign the default LoadBalancer class", func() {
+		ns := NewNamespace("")
+		NamespaceCreation(ns, tnt.Spec.Owners[0], defaultTimeoutInterval).Should(Succeed())
+
+		cs := ownerClient(tnt.Spec.Owners[0])
+
+		svc := newService("default-class", corev1.ServiceTypeLoadBalancer, 31000)
+
+		var created *corev1.Service
+
+		EventuallyCreation(func() (err error) {
+			created, err = cs.CoreV1().Services(ns.Name).Create(context.Background(), svc, metav1.CreateOptions{})
+			return err
+		}).Should(Succeed())
+		Expect(created.Spec.LoadBalancerClass).ShouldNot(BeNil())
+		Expect(*created.Spec.LoadBalancerClass).Should(Equal("capsule.clastix.io/internal"))
+
+		By("exceeding the maximum number of LoadBalancer Services", func() {
+			svc := newService("exceeding", corev1.ServiceTypeLoadBalancer, 31001)
+			svc.Spec.LoadBalancerClass = pointer.String("capsule.clastix.io/public")
+
+			EventuallyCreation(func() error {
+				_, err := cs.CoreV1().Services(ns.Name).Create(context.Background(), svc, metav1.CreateOptions{})
+				return err
+			}).ShouldNot(Succeed())
+		})
+	})
+
+	It("should deny the forbidden LoadBalancer settings", func() {
+		ns := NewNamespace("")
+		NamespaceCreation(ns, tnt.Spec.Owners[0], defaultTimeoutInterval).Should(Succeed())
+
+		cs := ownerClient(tnt.Spec.Owners[0])
+
+		for name, mutate := range map[string]func(svc *corev1.Service){
+			"forbidden-class": func(svc *corev1.Service) {
+				svc.Spec.LoadBalancerClass = pointer.String("capsule.clastix.io/private")
+			},
+			"forbidden-ip": func(svc *corev1.Service) {
+				svc.Spec.LoadBalancerIP = "10.10.1.1"
+			},
+			"forbidden-source-range": func(svc *corev1.Service) {
+				svc.Spec.LoadBalancerSourceRanges = []string{"0.0.0.0/0"}
+			},
+			"forbidden-annotation": func(svc *corev1.Service) {
+				svc.SetAnnotations(map[string]string{corev1.AnnotationLoadBalancerSourceRangesKey: "10.0.0.0/16,192.168.0.0/16"})
+			},
+		} {
+			svc := newService(name, corev1.ServiceTypeLoadBalancer, 31010)
+			mutate(svc)
+
+			EventuallyCreation(func() error {
+				_, err := cs.CoreV1().Services(ns.Name).Create(context.Background(), svc, metav1.CreateOptions{})
+				return err
+			}).ShouldNot(Succeed())
+		}
+	})
+
+	It("should enforce the NodePort ranges", func() {
+		ns := NewNamespace("")
+		NamespaceCreation(ns, tnt.Spec.Owners[0], defaultTimeoutInterval).Should(Succeed())
+
+		cs := ownerClient(tnt.Spec.Owners[0])
+
+		By("omitting the node port", func() {
+			EventuallyCreation(func() error {
+				_, err := cs.CoreV1().Services(ns.Name).Create(context.Background(), newService("unspecified", corev1.ServiceTypeNodePort, 0), metav1.CreateOptions{})
+				return err
+			}).ShouldNot(Succeed())
+		})
+		By("using a node port out of the ranges", func() {
+			EventuallyCreation(func() error {
+				_, err := cs.CoreV1().Services(ns.Name).Create(context.Background(), newService("forbidden", corev1.ServiceTypeNodePort, 32000), metav1.CreateOptions{})
+				return err
+			}).ShouldNot(Succeed())
+		})
+		By("using a node port in the ranges", func() {
+			EventuallyCreation(func() error {
+				_, err := cs.CoreV1().Services(ns.Name).Create(context.Background(), newService("allowed", corev1.ServiceTypeNodePort, 31050), metav1.CreateOptions{})
+				return err
+			}).Should(Succeed())
+		})
+	})
+
+	It("should count the LoadBalancer Services towards the maximum number of NodePort ones", func() {
+		ns := NewNamespace("")
+		NamespaceCreation(ns, tnt.Spec.Owners[0], defaultTimeoutInterval).Should(Succeed())
+
+		Eventually(func() error {
+			if err := k8sClient.Get(context.TODO(), types.NamespacedName{Name: tnt.GetName()}, tnt); err != nil {
+				return err
+			}
+
+			tnt.Spec.ServiceOptions.NodePort.MaxServices = pointer.Int32(1)
+
+			return k8sClient.Update(context.TODO(), tnt)
+		}, defaultTimeoutInterval, defaultPollInterval).Should(Succeed())
+
+		cs := ownerClient(tnt.Spec.Owners[0])
+
+		EventuallyCreation(func() error {
+			_, err := cs.CoreV1().Services(ns.Name).Create(context.Background(), newService("load-balancer", corev1.ServiceTypeLoadBalancer, 31020), metav1.CreateOptions{})
+			return err
+		}).Should(Succeed())
+
+		EventuallyCreation(func() error {
+			_, err := cs.CoreV1().Services(ns.Name).Create(context.Background(), newService("node-port", corev1.ServiceTypeNodePort, 31021), metav1.CreateOptions{})
+			return err
+		}).ShouldNot(Succeed())
+	})
+})
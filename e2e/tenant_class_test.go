@@ -0,0 +1,128 @@
+//go:build e2e
+
+// Copyright 2020-2023 Project Capsule Authors.
+// SPDX-License-Identifier: Apache-2.0
+
+package e2e
+
+import (
+	"context"
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	capsulev1beta2 "github.com/projectcapsule/capsule/api/v1beta2"
+	"github.com/projectcapsule/capsule/pkg/api"
+)
+
+var _ = Describe("assigning a TenantClass to a Tenant", func() {
+	class := &capsulev1beta2.TenantClass{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "tenant-class",
+		},
+		Spec: capsulev1beta2.TenantClassSpec{
+			LimitRanges: api.LimitRangesSpec{
+				Items: []corev1.LimitRangeSpec{
+					{
+						Limits: []corev1.LimitRangeItem{
+							{
+								Type: corev1.LimitTypeContainer,
+								Default: corev1.ResourceList{
+									corev1.ResourceCPU: resource.MustParse("200m"),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	tnt := &capsulev1beta2.Tenant{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "tenant-class",
+		},
+		Spec: capsulev1beta2.TenantSpec{
+			TenantClassName: "tenant-class",
+			Owners: capsulev1beta2.OwnerListSpec{
+				{
+					Name: "leon",
+					Kind: "User",
+				},
+			},
+		},
+	}
+
+	JustBeforeEach(func() {
+		EventuallyCreation(func() error {
+			class.ResourceVersion = ""
+
+			return k8sClient.Create(context.TODO(), class)
+		}).Should(Succeed())
+
+		EventuallyCreation(func() error {
+			tnt.ResourceVersion = ""
+
+			return k8sClient.Create(context.TODO(), tnt)
+		}).Should(Succeed())
+	})
+
+	JustAfterEach(func() {
+		Expect(k8sClient.Delete(context.TODO(), tnt)).Should(Succeed())
+		Expect(k8sClient.Delete(context.TODO(), class)).Should(Succeed())
+	})
+
+	It("should deny referencing a non-existing class", func() {
+		t := &capsulev1beta2.Tenant{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "tenant-class-missing",
+			},
+			Spec: capsulev1beta2.TenantSpec{
+				TenantClassName: "missing",
+				Owners:          tnt.Spec.Owners,
+			},
+		}
+
+		Expect(k8sClient.Create(context.TODO(), t)).ShouldNot(Succeed())
+	})
+
+	It("should apply and roll out the class defaults", func() {
+		ns := NewNamespace("")
+		NamespaceCreation(ns, tnt.Spec.Owners[0], defaultTimeoutInterval).Should(Succeed())
+
+		defaultCPU := func() (string, error) {
+			lr := &corev1.LimitRange{}
+			if err := k8sClient.Get(context.TODO(), types.NamespacedName{Name: fmt.Sprintf("capsule-%s-0", tnt.GetName()), Namespace: ns.GetName()}, lr); err != nil {
+				return "", err
+			}
+
+			cpu := lr.Spec.Limits[0].Default[corev1.ResourceCPU]
+
+			return cpu.String(), nil
+		}
+
+		Eventually(defaultCPU, defaultTimeoutInterval, defaultPollInterval).Should(Equal("200m"))
+
+		Expect(k8sClient.Get(context.TODO(), types.NamespacedName{Name: class.GetName()}, class)).Should(Succeed())
+		class.Spec.LimitRanges.Items[0].Limits[0].Default[corev1.ResourceCPU] = resource.MustParse("500m")
+		Expect(k8sClient.Update(context.TODO(), class)).Should(Succeed())
+
+		Eventually(defaultCPU, defaultTimeoutInterval, defaultPollInterval).Should(Equal("500m"))
+
+		Eventually(func() int64 {
+			t := &capsulev1beta2.Tenant{}
+			Expect(k8sClient.Get(context.TODO(), types.NamespacedName{Name: tnt.GetName()}, t)).Should(Succeed())
+
+			if t.Status.Class == nil {
+				return 0
+			}
+
+			return t.Status.Class.Generation
+		}, defaultTimeoutInterval, defaultPollInterval).Should(Equal(class.GetGeneration()))
+	})
+})
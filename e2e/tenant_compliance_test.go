@@ -0,0 +1,111 @@
+//go:build e2e
+
+// Copyright 2020-2023 Project Capsule Authors.
+// SPDX-License-Identifier: Apache-2.0
+
+package e2e
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	capsulev1beta2 "github.com/projectcapsule/capsule/api/v1beta2"
+	"github.com/projectcapsule/capsule/pkg/api"
+)
+
+var _ = Describe("evaluating the existing objects upon the Tenant changes", func() {
+	tnt := &capsulev1beta2.Tenant{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "tenant-compliance",
+		},
+		Spec: capsulev1beta2.TenantSpec{
+			Owners: capsulev1beta2.OwnerListSpec{
+				{
+					Name: "sofia",
+					Kind: "User",
+				},
+			},
+			ContainerRegistries: &api.AllowedListSpec{
+				Exact: []string{"docker.io", "gcr.io"},
+			},
+		},
+	}
+
+	JustBeforeEach(func() {
+		EventuallyCreation(func() error {
+			tnt.ResourceVersion = ""
+			return k8sClient.Create(context.TODO(), tnt)
+		}).Should(Succeed())
+	})
+	JustAfterEach(func() {
+		Expect(k8sClient.Delete(context.TODO(), tnt)).Should(Succeed())
+	})
+
+	It("should report and remediate the objects no more complying", func() {
+		ns := NewNamespace("")
+		NamespaceCreation(ns, tnt.Spec.Owners[0], defaultTimeoutInterval).Should(Succeed())
+		TenantNamespaceList(tnt, defaultTimeoutInterval).Should(ContainElement(ns.GetName()))
+
+		pod := &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "container",
+			},
+			Spec: corev1.PodSpec{
+				Containers: []corev1.Container{
+					{
+						Name:  "container",
+						Image: "gcr.io/google_containers/pause-amd64:3.0",
+					},
+				},
+			},
+		}
+
+		cs := ownerClient(tnt.Spec.Owners[0])
+		EventuallyCreation(func() error {
+			_, err := cs.CoreV1().Pods(ns.Name).Create(context.Background(), pod, metav1.CreateOptions{})
+			return err
+		}).Should(Succeed())
+
+		By("removing the registry from the allowed ones", func() {
+			Eventually(func() error {
+				if err := k8sClient.Get(context.TODO(), types.NamespacedName{Name: tnt.GetName()}, tnt); err != nil {
+					return err
+				}
+
+				tnt.Spec.ContainerRegistries.Exact = []string{"docker.io"}
+				tnt.Spec.Enforcement = &api.EnforcementSpec{Remediation: api.RemediationActionDelete}
+
+				return k8sClient.Update(context.TODO(), tnt)
+			}, defaultTimeoutInterval, defaultPollInterval).Should(Succeed())
+		})
+
+		By("reporting the violation", func() {
+			Eventually(func() (results []capsulev1beta2.PolicyReportResult) {
+				report := &capsulev1beta2.TenantPolicyReport{}
+				if err := k8sClient.Get(context.TODO(), types.NamespacedName{Name: tnt.GetName()}, report); err != nil {
+					return nil
+				}
+
+				return report.Results
+			}, defaultTimeoutInterval, defaultPollInterval).Should(ContainElement(And(
+				HaveField("Category", capsulev1beta2.PolicyReportCategoryBackground),
+				HaveField("Rule", "ForbiddenContainerRegistry"),
+				HaveField("Properties", HaveKeyWithValue(capsulev1beta2.PolicyReportPropertyRemediation, api.RemediationActionDelete.String())),
+			)))
+		})
+
+		By("deleting the Pod", func() {
+			Eventually(func() bool {
+				err := k8sClient.Get(context.TODO(), types.NamespacedName{Namespace: ns.GetName(), Name: pod.GetName()}, &corev1.Pod{})
+
+				return apierrors.IsNotFound(err)
+			}, defaultTimeoutInterval, defaultPollInterval).Should(BeTrue())
+		})
+	})
+})
@@ -0,0 +1,74 @@
+//go:build e2e
+
+// Copyright 2020-2023 Project Capsule Authors.
+// SPDX-License-Identifier: Apache-2.0
+
+package e2e
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	capsulev1beta2 "github.com/projectcapsule/capsule/api/v1beta2"
+)
+
+var _ = Describe("reporting the Tenant conditions", func() {
+	tnt := &capsulev1beta2.Tenant{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "tenant-conditions",
+		},
+		Spec: capsulev1beta2.TenantSpec{
+			Owners: capsulev1beta2.OwnerListSpec{
+				{
+					Name: "kate",
+					Kind: "User",
+				},
+			},
+		},
+	}
+
+	JustBeforeEach(func() {
+		EventuallyCreation(func() error {
+			tnt.ResourceVersion = ""
+
+			return k8sClient.Create(context.TODO(), tnt)
+		}).Should(Succeed())
+	})
+
+	JustAfterEach(func() {
+		Expect(k8sClient.Delete(context.TODO(), tnt)).Should(Succeed())
+	})
+
+	It("should report the Tenant as Ready once reconciled", func() {
+		ns := NewNamespace("")
+		NamespaceCreation(ns, tnt.Spec.Owners[0], defaultTimeoutInterval).Should(Succeed())
+
+		Eventually(func() (ok bool) {
+			t := &capsulev1beta2.Tenant{}
+			Expect(k8sClient.Get(context.TODO(), types.NamespacedName{Name: tnt.GetName()}, t)).Should(Succeed())
+
+			if t.Status.ObservedGeneration != t.GetGeneration() {
+				return false
+			}
+
+			for _, conditionType := range []string{
+				capsulev1beta2.TenantConditionReady,
+				capsulev1beta2.TenantConditionNamespacesSynced,
+				capsulev1beta2.TenantConditionQuotasSynced,
+				capsulev1beta2.TenantConditionRBACSynced,
+				capsulev1beta2.TenantConditionPolicyReportSynced,
+			} {
+				if !meta.IsStatusConditionTrue(t.Status.Conditions, conditionType) {
+					return false
+				}
+			}
+
+			return true
+		}, defaultTimeoutInterval, defaultPollInterval).Should(BeTrue())
+	})
+})
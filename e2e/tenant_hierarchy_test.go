@@ -0,0 +1,152 @@
+//go:build e2e
+
+// Copyright 2020-2023 Project Capsule Authors.
+// SPDX-License-Identifier: Apache-2.0
+
+package e2e
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/pointer"
+
+	capsulev1beta2 "github.com/projectcapsule/capsule/api/v1beta2"
+	"github.com/projectcapsule/capsule/pkg/api"
+)
+
+var _ = Describe("creating a hierarchy of Tenants", func() {
+	parent := &capsulev1beta2.Tenant{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "hierarchy-parent",
+		},
+		Spec: capsulev1beta2.TenantSpec{
+			Owners: capsulev1beta2.OwnerListSpec{
+				{
+					Name: "joe",
+					Kind: "User",
+				},
+			},
+			NamespaceOptions: &capsulev1beta2.NamespaceOptions{
+				Quota: pointer.Int32(3),
+			},
+			ContainerRegistries: &api.AllowedListSpec{
+				Exact: []string{"docker.io", "quay.io"},
+			},
+			NodeSelector: map[string]string{
+				"kubernetes.io/os": "linux",
+			},
+		},
+	}
+
+	child := &capsulev1beta2.Tenant{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "hierarchy-child",
+		},
+		Spec: capsulev1beta2.TenantSpec{
+			Parent: "hierarchy-parent",
+			Owners: capsulev1beta2.OwnerListSpec{
+				{
+					Name: "gina",
+					Kind: "User",
+				},
+			},
+			NamespaceOptions: &capsulev1beta2.NamespaceOptions{
+				Quota: pointer.Int32(2),
+			},
+		},
+	}
+
+	JustBeforeEach(func() {
+		for _, tnt := range []*capsulev1beta2.Tenant{parent, child} {
+			t := tnt
+
+			EventuallyCreation(func() error {
+				t.ResourceVersion = ""
+
+				return k8sClient.Create(context.TODO(), t)
+			}).Should(Succeed())
+		}
+	})
+
+	JustAfterEach(func() {
+		Expect(k8sClient.Delete(context.TODO(), child)).Should(Succeed())
+		Expect(k8sClient.Delete(context.TODO(), parent)).Should(Succeed())
+	})
+
+	It("should inherit the parent policies", func() {
+		ns := NewNamespace("")
+		NamespaceCreation(ns, child.Spec.Owners[0], defaultTimeoutInterval).Should(Succeed())
+
+		Eventually(func() (ok bool) {
+			Expect(k8sClient.Get(context.Background(), types.NamespacedName{Name: ns.Name}, ns)).Should(Succeed())
+
+			ok, _ = HaveKeyWithValue("capsule.clastix.io/allowed-registries", "docker.io,quay.io").Match(ns.Annotations)
+			if !ok {
+				return
+			}
+
+			ok, _ = HaveKeyWithValue("scheduler.alpha.kubernetes.io/node-selector", "kubernetes.io/os=linux").Match(ns.Annotations)
+
+			return
+		}, defaultTimeoutInterval, defaultPollInterval).Should(BeTrue())
+
+		pod := &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "container",
+			},
+			Spec: corev1.PodSpec{
+				Containers: []corev1.Container{
+					{
+						Name:  "container",
+						Image: "gcr.io/google_containers/pause-amd64:3.0",
+					},
+				},
+			},
+		}
+
+		cs := ownerClient(child.Spec.Owners[0])
+		_, err := cs.CoreV1().Pods(ns.Name).Create(context.Background(), pod, metav1.CreateOptions{})
+		Expect(err).ShouldNot(Succeed())
+	})
+
+	It("should deny broadening the parent policies", func() {
+		Expect(k8sClient.Get(context.TODO(), types.NamespacedName{Name: child.Name}, child)).Should(Succeed())
+
+		child.Spec.ContainerRegistries = &api.AllowedListSpec{
+			Exact: []string{"gcr.io"},
+		}
+
+		Expect(k8sClient.Update(context.TODO(), child)).ShouldNot(Succeed())
+	})
+
+	It("should deny exceeding the parent budget", func() {
+		sibling := &capsulev1beta2.Tenant{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "hierarchy-sibling",
+			},
+			Spec: capsulev1beta2.TenantSpec{
+				Parent: "hierarchy-parent",
+				Owners: capsulev1beta2.OwnerListSpec{
+					{
+						Name: "gina",
+						Kind: "User",
+					},
+				},
+				NamespaceOptions: &capsulev1beta2.NamespaceOptions{
+					Quota: pointer.Int32(2),
+				},
+			},
+		}
+
+		Expect(k8sClient.Create(context.TODO(), sibling)).ShouldNot(Succeed())
+	})
+
+	It("should deny the deletion of a parent Tenant", func() {
+		Expect(k8sClient.Delete(context.TODO(), parent)).ShouldNot(Succeed())
+	})
+})
@@ -0,0 +1,151 @@
+//go:build e2e
+
+// Copyright 2020-2023 Project Capsule Authors.
+// SPDX-License-Identifier: Apache-2.0
+
+package e2e
+
+import (
+	"context"
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	capsulev1beta2 "github.com/projectcapsule/capsule/api/v1beta2"
+	"github.com/projectcapsule/capsule/pkg/api"
+)
+
+var _ = Describe("creating the templated and preset Network Policies", func() {
+	tnt := &capsulev1beta2.Tenant{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "tenant-network-policy-presets",
+		},
+		Spec: capsulev1beta2.TenantSpec{
+			Owners: capsulev1beta2.OwnerListSpec{
+				{
+					Name: "norbert",
+					Kind: "User",
+				},
+			},
+			NetworkPolicies: api.NetworkPolicySpec{
+				Items: []networkingv1.NetworkPolicySpec{
+					{
+						PodSelector: metav1.LabelSelector{},
+						PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeIngress},
+						Ingress: []networkingv1.NetworkPolicyIngressRule{
+							{
+								From: []networkingv1.NetworkPolicyPeer{
+									{
+										NamespaceSelector: &metav1.LabelSelector{
+											MatchLabels: map[string]string{
+												"capsule.clastix.io/tenant":   "{{ tenant.name }}",
+												"kubernetes.io/metadata.name": "{{ namespace }}",
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+					{
+						PodSelector: metav1.LabelSelector{},
+						PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeEgress},
+						Egress: []networkingv1.NetworkPolicyEgressRule{
+							{
+								To: []networkingv1.NetworkPolicyPeer{
+									{IPBlock: &networkingv1.IPBlock{CIDR: "0.0.0.0/0"}},
+								},
+							},
+						},
+					},
+				},
+				Presets: &api.NetworkPolicyPresets{
+					TenantIsolation:   true,
+					AllowDNS:          &api.DNSNetworkPolicyPreset{},
+					DenyCloudMetadata: true,
+				},
+			},
+		},
+	}
+
+	JustBeforeEach(func() {
+		EventuallyCreation(func() error {
+			tnt.ResourceVersion = ""
+			return k8sClient.Create(context.TODO(), tnt)
+		}).Should(Succeed())
+	})
+	JustAfterEach(func() {
+		Expect(k8sClient.Delete(context.TODO(), tnt)).Should(Succeed())
+	})
+
+	It("should render the placeholders and honour the opt-out labels", func() {
+		ns := NewNamespace("")
+		NamespaceCreation(ns, tnt.Spec.Owners[0], defaultTimeoutInterval).Should(Succeed())
+		TenantNamespaceList(tnt, defaultTimeoutInterval).Should(ContainElement(ns.GetName()))
+
+		By("rendering the templated item", func() {
+			np := &networkingv1.NetworkPolicy{}
+			Eventually(func() error {
+				return k8sClient.Get(context.TODO(), types.NamespacedName{Name: fmt.Sprintf("capsule-%s-0", tnt.GetName()), Namespace: ns.GetName()}, np)
+			}, defaultTimeoutInterval, defaultPollInterval).Should(Succeed())
+			Expect(np.Spec.Ingress[0].From[0].NamespaceSelector.MatchLabels).Should(Equal(map[string]string{
+				"capsule.clastix.io/tenant":   tnt.GetName(),
+				"kubernetes.io/metadata.name": ns.GetName(),
+			}))
+		})
+		By("creating the enabled presets", func() {
+			for _, preset := range []api.NetworkPolicyPreset{api.NetworkPolicyPresetTenantIsolation, api.NetworkPolicyPresetAllowDNS} {
+				Eventually(func() error {
+					return k8sClient.Get(context.TODO(), types.NamespacedName{Name: fmt.Sprintf("capsule-%s-%s", tnt.GetName(), preset), Namespace: ns.GetName()}, &networkingv1.NetworkPolicy{})
+				}, defaultTimeoutInterval, defaultPollInterval).Should(Succeed())
+			}
+		})
+		By("excluding the cloud metadata endpoint from the egress rules", func() {
+			Eventually(func() ([]string, error) {
+				np := &networkingv1.NetworkPolicy{}
+				if err := k8sClient.Get(context.TODO(), types.NamespacedName{Name: fmt.Sprintf("capsule-%s-1", tnt.GetName()), Namespace: ns.GetName()}, np); err != nil {
+					return nil, err
+				}
+
+				return np.Spec.Egress[0].To[0].IPBlock.Except, nil
+			}, defaultTimeoutInterval, defaultPollInterval).Should(Equal([]string{"169.254.169.254/32"}))
+
+			err := k8sClient.Get(context.TODO(), types.NamespacedName{Name: fmt.Sprintf("capsule-%s-%s", tnt.GetName(), api.NetworkPolicyPresetDenyCloudMetadata), Namespace: ns.GetName()}, &networkingv1.NetworkPolicy{})
+			Expect(err).Should(HaveOccurred())
+		})
+		By("denying the Tenant owner from opting out of the presets", func() {
+			cs := ownerClient(tnt.Spec.Owners[0])
+
+			namespace, err := cs.CoreV1().Namespaces().Get(context.Background(), ns.GetName(), metav1.GetOptions{})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			namespace.Labels[api.NetworkPolicyPresetTenantIsolation.OptOutLabel()] = api.NetworkPolicyPresetOptOutValue
+
+			_, err = cs.CoreV1().Namespaces().Update(context.Background(), namespace, metav1.UpdateOptions{})
+			Expect(err).Should(HaveOccurred())
+		})
+		By("opting out of the tenant isolation preset", func() {
+			Eventually(func() error {
+				namespace := &corev1.Namespace{}
+				if err := k8sClient.Get(context.TODO(), types.NamespacedName{Name: ns.GetName()}, namespace); err != nil {
+					return err
+				}
+
+				namespace.Labels[api.NetworkPolicyPresetTenantIsolation.OptOutLabel()] = api.NetworkPolicyPresetOptOutValue
+
+				return k8sClient.Update(context.TODO(), namespace)
+			}, defaultTimeoutInterval, defaultPollInterval).Should(Succeed())
+
+			Eventually(func() bool {
+				err := k8sClient.Get(context.TODO(), types.NamespacedName{Name: fmt.Sprintf("capsule-%s-%s", tnt.GetName(), api.NetworkPolicyPresetTenantIsolation), Namespace: ns.GetName()}, &networkingv1.NetworkPolicy{})
+
+				return err != nil
+			}, defaultTimeoutInterval, defaultPollInterval).Should(BeTrue())
+		})
+	})
+})
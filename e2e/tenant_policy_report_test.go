@@ -0,0 +1,85 @@
+//go:build e2e
+
+// Copyright 2020-2023 Project Capsule Authors.
+// SPDX-License-Identifier: Apache-2.0
+
+package e2e
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	capsulev1beta2 "github.com/projectcapsule/capsule/api/v1beta2"
+	"github.com/projectcapsule/capsule/pkg/api"
+)
+
+var _ = Describe("reporting the Tenant policy violations", func() {
+	tnt := &capsulev1beta2.Tenant{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "policy-report",
+		},
+		Spec: capsulev1beta2.TenantSpec{
+			Owners: capsulev1beta2.OwnerListSpec{
+				{
+					Name: "ruth",
+					Kind: "User",
+				},
+			},
+			ContainerRegistries: &api.AllowedListSpec{
+				Exact: []string{"docker.io"},
+			},
+		},
+	}
+
+	JustBeforeEach(func() {
+		EventuallyCreation(func() error {
+			tnt.ResourceVersion = ""
+			return k8sClient.Create(context.TODO(), tnt)
+		}).Should(Succeed())
+	})
+	JustAfterEach(func() {
+		Expect(k8sClient.Delete(context.TODO(), tnt)).Should(Succeed())
+	})
+
+	It("should report the denied requests", func() {
+		ns := NewNamespace("")
+		NamespaceCreation(ns, tnt.Spec.Owners[0], defaultTimeoutInterval).Should(Succeed())
+		TenantNamespaceList(tnt, defaultTimeoutInterval).Should(ContainElement(ns.GetName()))
+
+		pod := &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "container",
+			},
+			Spec: corev1.PodSpec{
+				Containers: []corev1.Container{
+					{
+						Name:  "container",
+						Image: "gcr.io/google_containers/pause-amd64:3.0",
+					},
+				},
+			},
+		}
+
+		cs := ownerClient(tnt.Spec.Owners[0])
+		_, err := cs.CoreV1().Pods(ns.Name).Create(context.Background(), pod, metav1.CreateOptions{})
+		Expect(err).ShouldNot(Succeed())
+
+		Eventually(func() (results []capsulev1beta2.PolicyReportResult) {
+			report := &capsulev1beta2.TenantPolicyReport{}
+			if err := k8sClient.Get(context.TODO(), types.NamespacedName{Name: tnt.GetName()}, report); err != nil {
+				return nil
+			}
+
+			return report.Results
+		}, defaultTimeoutInterval, defaultPollInterval).Should(ContainElement(And(
+			HaveField("Rule", "ForbiddenContainerRegistry"),
+			HaveField("Result", capsulev1beta2.PolicyResultFail),
+			HaveField("Properties", HaveKeyWithValue(capsulev1beta2.PolicyReportPropertyUser, tnt.Spec.Owners[0].Name)),
+		)))
+	})
+})
@@ -27,19 +27,28 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
 	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
 	ctrlwebhook "sigs.k8s.io/controller-runtime/pkg/webhook"
+	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+	gatewayv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
 
 	capsulev1beta1 "github.com/projectcapsule/capsule/api/v1beta1"
 	capsulev1beta2 "github.com/projectcapsule/capsule/api/v1beta2"
+	compliancecontroller "github.com/projectcapsule/capsule/controllers/compliance"
 	configcontroller "github.com/projectcapsule/capsule/controllers/config"
+	namespacerequestcontroller "github.com/projectcapsule/capsule/controllers/namespacerequest"
 	podlabelscontroller "github.com/projectcapsule/capsule/controllers/pod"
 	"github.com/projectcapsule/capsule/controllers/pv"
 	rbaccontroller "github.com/projectcapsule/capsule/controllers/rbac"
 	"github.com/projectcapsule/capsule/controllers/resources"
 	servicelabelscontroller "github.com/projectcapsule/capsule/controllers/servicelabels"
+	softdeletecontroller "github.com/projectcapsule/capsule/controllers/softdelete"
 	tenantcontroller "github.com/projectcapsule/capsule/controllers/tenant"
+	tenantquotacontroller "github.com/projectcapsule/capsule/controllers/tenantquota"
+	tenantusagecontroller "github.com/projectcapsule/capsule/controllers/tenantusage"
 	tlscontroller "github.com/projectcapsule/capsule/controllers/tls"
 	"github.com/projectcapsule/capsule/pkg/configuration"
 	"github.com/projectcapsule/capsule/pkg/indexer"
+	"github.com/projectcapsule/capsule/pkg/policyreport"
+	"github.com/projectcapsule/capsule/pkg/quota"
 	"github.com/projectcapsule/capsule/pkg/webhook"
 	"github.com/projectcapsule/capsule/pkg/webhook/defaults"
 	"github.com/projectcapsule/capsule/pkg/webhook/ingress"
@@ -67,6 +76,8 @@ func init() {
 	utilruntime.Must(capsulev1beta1.AddToScheme(scheme))
 	utilruntime.Must(capsulev1beta2.AddToScheme(scheme))
 	utilruntime.Must(apiextensionsv1.AddToScheme(scheme))
+	utilruntime.Must(gatewayv1beta1.AddToScheme(scheme))
+	utilruntime.Must(gatewayv1alpha2.AddToScheme(scheme))
 }
 
 func printVersion() {
@@ -190,15 +201,52 @@ func main() {
 	}
 
 	if err = (&tenantcontroller.Manager{
-		RESTConfig: manager.GetConfig(),
-		Client:     manager.GetClient(),
-		Log:        ctrl.Log.WithName("controllers").WithName("Tenant"),
-		Recorder:   manager.GetEventRecorderFor("tenant-controller"),
+		Client:        manager.GetClient(),
+		Log:           ctrl.Log.WithName("controllers").WithName("Tenant"),
+		Recorder:      manager.GetEventRecorderFor("tenant-controller"),
+		Configuration: cfg,
+		Namespace:     namespace,
 	}).SetupWithManager(manager); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "Tenant")
 		os.Exit(1)
 	}
 
+	if err = (&tenantquotacontroller.Manager{
+		Client: manager.GetClient(),
+		Log:    ctrl.Log.WithName("controllers").WithName("TenantQuota"),
+	}).SetupWithManager(manager); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "TenantQuota")
+		os.Exit(1)
+	}
+
+	if err = (&tenantusagecontroller.Manager{
+		Client:        manager.GetClient(),
+		Log:           ctrl.Log.WithName("controllers").WithName("TenantUsage"),
+		Configuration: cfg,
+	}).SetupWithManager(manager); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "TenantUsage")
+		os.Exit(1)
+	}
+
+	if err = (&softdeletecontroller.Manager{
+		Client:   manager.GetClient(),
+		Log:      ctrl.Log.WithName("controllers").WithName("SoftDelete"),
+		Recorder: manager.GetEventRecorderFor("softdelete-controller"),
+	}).SetupWithManager(manager); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "SoftDelete")
+		os.Exit(1)
+	}
+
+	if err = (&namespacerequestcontroller.Manager{
+		Client:        manager.GetClient(),
+		Log:           ctrl.Log.WithName("controllers").WithName("NamespaceRequest"),
+		Recorder:      manager.GetEventRecorderFor("namespacerequest-controller"),
+		Configuration: cfg,
+	}).SetupWithManager(manager); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "NamespaceRequest")
+		os.Exit(1)
+	}
+
 	if err = (&capsulev1beta1.Tenant{}).SetupWebhookWithManager(manager); err != nil {
 		setupLog.Error(err, "unable to create conversion webhook", "webhook", "capsulev1beta1.Tenant")
 		os.Exit(1)
@@ -216,21 +264,28 @@ func main() {
 		os.Exit(1)
 	}
 
+	// ledger of the resources admitted for the Tenant-scoped ResourceQuota items,
+	// shared between the Pod and PersistentVolumeClaim webhooks
+	quotaLedger := quota.NewLedger()
+	// counter of the objects admitted for the TenantQuota resources since their last count
+	quotaCounter := quota.NewCounter()
+
 	// webhooks: the order matters, don't change it and just append
 	webhooksList := append(
 		make([]webhook.Webhook, 0),
-		route.Pod(pod.ImagePullPolicy(), pod.ContainerRegistry(), pod.PriorityClass(), pod.RuntimeClass()),
-		route.Namespace(utils.InCapsuleGroups(cfg, namespacewebhook.PatchHandler(), namespacewebhook.QuotaHandler(), namespacewebhook.FreezeHandler(cfg), namespacewebhook.PrefixHandler(cfg), namespacewebhook.UserMetadataHandler())),
-		route.Ingress(ingress.Class(cfg, kubeVersion), ingress.Hostnames(cfg), ingress.Collision(cfg), ingress.Wildcard()),
-		route.PVC(pvc.Validating(), pvc.PersistentVolumeReuse()),
+		route.Pod(pod.ImagePullPolicy(), pod.ContainerRegistry(), pod.ImagePolicy(), pod.PodSecurity(), pod.Volumes(), pod.NodePool(), pod.PriorityClass(), pod.RuntimeClass(), pod.ResourceQuota(quotaLedger)),
+		route.Namespace(utils.InCapsuleGroups(cfg, namespacewebhook.PatchHandler(), namespacewebhook.QuotaHandler(), namespacewebhook.FreezeHandler(cfg), namespacewebhook.PrefixHandler(cfg), namespacewebhook.UserMetadataHandler(), namespacewebhook.SoftDeleteHandler())),
+		route.Ingress(ingress.Class(cfg, kubeVersion), ingress.Hostnames(cfg), ingress.Collision(cfg), ingress.Wildcard(), ingress.Ownership()),
+		route.PVC(pvc.Validating(), pvc.PersistentVolumeReuse(), pvc.ResourceQuota(quotaLedger)),
 		route.Service(service.Handler()),
 		route.TenantResourceObjects(utils.InCapsuleGroups(cfg, tntresource.WriteOpsHandler())),
 		route.NetworkPolicy(utils.InCapsuleGroups(cfg, networkpolicy.Handler())),
-		route.Tenant(tenant.NameHandler(), tenant.RoleBindingRegexHandler(), tenant.IngressClassRegexHandler(), tenant.StorageClassRegexHandler(), tenant.ContainerRegistryRegexHandler(), tenant.HostnameRegexHandler(), tenant.FreezedEmitter(), tenant.ServiceAccountNameHandler(), tenant.ForbiddenAnnotationsRegexHandler(), tenant.ProtectedHandler(), tenant.MetaHandler()),
-		route.OwnerReference(utils.InCapsuleGroups(cfg, ownerreference.Handler(cfg))),
-		route.Cordoning(tenant.CordoningHandler(cfg), tenant.ResourceCounterHandler(manager.GetClient())),
+		route.Tenant(tenant.NameHandler(), tenant.RoleBindingRegexHandler(), tenant.IngressClassRegexHandler(), tenant.StorageClassRegexHandler(), tenant.ContainerRegistryRegexHandler(), tenant.ImagePolicyHandler(), tenant.RegistryMirrorsHandler(), tenant.NodePoolHandler(), tenant.HostnameRegexHandler(), tenant.FreezedEmitter(), tenant.ServiceAccountNameHandler(), tenant.ForbiddenAnnotationsRegexHandler(), tenant.ProtectedHandler(), tenant.MetaHandler(), tenant.HierarchyHandler(cfg), tenant.ClassHandler(), tenant.NamespaceNamingPolicyHandler()),
+		route.OwnerReference(utils.InCapsuleGroups(cfg, ownerreference.Handler(cfg, quotaLedger))),
+		route.Cordoning(tenant.CordoningHandler(cfg), tenant.TenantQuotaHandler(quotaCounter)),
 		route.Node(utils.InCapsuleGroups(cfg, node.UserMetadataHandler(cfg, kubeVersion))),
 		route.Defaults(defaults.Handler(cfg, kubeVersion)),
+		route.Routes(ingress.Hostnames(cfg), ingress.Collision(cfg), ingress.Wildcard(), ingress.Gateway(), ingress.Ownership()),
 	)
 
 	nodeWebhookSupported, _ := utils.NodeWebhookSupported(kubeVersion)
@@ -238,11 +293,36 @@ func main() {
 		setupLog.Info("Disabling node labels verification webhook as current Kubernetes version doesn't have fix for CVE-2021-25735")
 	}
 
-	if err = webhook.Register(manager, webhooksList...); err != nil {
+	// reports of the policy violations detected by the webhooks, written in batches to the TenantPolicyReport resources
+	policyReporter := policyreport.NewRecorder(manager.GetClient(), ctrl.Log.WithName("policyreport"))
+
+	if err = manager.Add(policyReporter); err != nil {
+		setupLog.Error(err, "unable to setup the policy reports recorder")
+		os.Exit(1)
+	}
+
+	if err = webhook.Register(manager, policyReporter, webhooksList...); err != nil {
 		setupLog.Error(err, "unable to setup webhooks")
 		os.Exit(1)
 	}
 
+	// the existing objects are evaluated upon the Tenant changes with the same handlers of the webhooks,
+	// excluding the ones keeping track of the admitted resources
+	if err = (&compliancecontroller.Manager{
+		Client:   manager.GetClient(),
+		Log:      ctrl.Log.WithName("controllers").WithName("Compliance"),
+		Recorder: manager.GetEventRecorderFor("compliance-controller"),
+		Targets: []compliancecontroller.Target{
+			compliancecontroller.Pods(pod.ImagePullPolicy(), pod.ContainerRegistry(), pod.ImagePolicy(), pod.PodSecurity(), pod.Volumes(), pod.NodePool(), pod.PriorityClass(), pod.RuntimeClass()),
+			compliancecontroller.Ingresses(ingress.Class(cfg, kubeVersion), ingress.Hostnames(cfg), ingress.Wildcard()),
+			compliancecontroller.Services(service.Handler()),
+			compliancecontroller.PersistentVolumeClaims(pvc.Validating()),
+		},
+	}).SetupWithManager(manager); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "Compliance")
+		os.Exit(1)
+	}
+
 	rbacManager := &rbaccontroller.Manager{
 		Log:           ctrl.Log.WithName("controllers").WithName("Rbac"),
 		Client:        manager.GetClient(),
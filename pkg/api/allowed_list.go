@@ -8,6 +8,7 @@ import (
 	"sort"
 	"strings"
 
+	"k8s.io/apimachinery/pkg/api/equality"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -24,6 +25,20 @@ func (in *DefaultAllowedListSpec) MatchDefault(value string) bool {
 	return in.Default == value
 }
 
+// IsSubsetOf returns true when the current specification doesn't allow anything more than the given one,
+// default value included.
+func (in *DefaultAllowedListSpec) IsSubsetOf(parent *DefaultAllowedListSpec) bool {
+	if parent == nil {
+		return true
+	}
+
+	if len(in.Default) > 0 && !parent.MatchDefault(in.Default) && !parent.Match(in.Default) {
+		return false
+	}
+
+	return in.SelectorAllowedListSpec.IsSubsetOf(&parent.SelectorAllowedListSpec)
+}
+
 // +kubebuilder:object:generate=true
 
 type SelectorAllowedListSpec struct {
@@ -52,6 +67,48 @@ func (in *SelectorAllowedListSpec) SelectorMatch(obj client.Object) bool {
 	return false
 }
 
+// IsSubsetOf returns true when the current specification doesn't allow anything more than the given one:
+// the label selector must contain all the labels and expressions of the parent one, eventually adding further ones.
+func (in *SelectorAllowedListSpec) IsSubsetOf(parent *SelectorAllowedListSpec) bool {
+	if parent == nil {
+		return true
+	}
+
+	if !in.AllowedListSpec.IsSubsetOf(&parent.AllowedListSpec) {
+		return false
+	}
+
+	if len(in.MatchLabels) == 0 && len(in.MatchExpressions) == 0 {
+		return true
+	}
+
+	if len(parent.MatchLabels) == 0 && len(parent.MatchExpressions) == 0 {
+		return false
+	}
+
+	for k, v := range parent.MatchLabels {
+		if value, ok := in.MatchLabels[k]; !ok || value != v {
+			return false
+		}
+	}
+
+	for _, parentExpression := range parent.MatchExpressions {
+		var found bool
+
+		for _, expression := range in.MatchExpressions {
+			if found = equality.Semantic.DeepEqual(parentExpression, expression); found {
+				break
+			}
+		}
+
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
+
 // +kubebuilder:object:generate=true
 
 type AllowedListSpec struct {
@@ -88,3 +145,20 @@ func (in *AllowedListSpec) RegexMatch(value string) (ok bool) {
 
 	return
 }
+
+// IsSubsetOf returns true when all the values allowed by the current specification are allowed by the given one.
+// Exact values must be matched by the parent, and the regular expression can be just inherited as it is since
+// the inclusion of regular languages cannot be verified.
+func (in *AllowedListSpec) IsSubsetOf(parent *AllowedListSpec) bool {
+	if parent == nil {
+		return true
+	}
+
+	for _, value := range in.Exact {
+		if !parent.Match(value) {
+			return false
+		}
+	}
+
+	return len(in.Regex) == 0 || in.Regex == parent.Regex
+}
@@ -8,6 +8,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 func TestAllowedListSpec_ExactMatch(t *testing.T) {
@@ -72,3 +73,58 @@ func TestAllowedListSpec_RegexMatch(t *testing.T) {
 		}
 	}
 }
+
+func TestAllowedListSpec_IsSubsetOf(t *testing.T) {
+	type tc struct {
+		Parent *AllowedListSpec
+		Child  AllowedListSpec
+		Subset bool
+	}
+
+	for _, tc := range []tc{
+		{nil, AllowedListSpec{Exact: []string{"any"}, Regex: ".*"}, true},
+		{&AllowedListSpec{Exact: []string{"foo", "bar"}}, AllowedListSpec{Exact: []string{"foo"}}, true},
+		{&AllowedListSpec{Exact: []string{"foo", "bar"}}, AllowedListSpec{Exact: []string{"foo", "bizz"}}, false},
+		{&AllowedListSpec{Regex: `^team-\w+$`}, AllowedListSpec{Exact: []string{"team-a"}}, true},
+		{&AllowedListSpec{Regex: `^team-\w+$`}, AllowedListSpec{Regex: `^team-\w+$`}, true},
+		{&AllowedListSpec{Regex: `^team-\w+$`}, AllowedListSpec{Regex: `.*`}, false},
+		{&AllowedListSpec{Exact: []string{"foo"}}, AllowedListSpec{Regex: `^foo$`}, false},
+	} {
+		assert.Equal(t, tc.Subset, tc.Child.IsSubsetOf(tc.Parent))
+	}
+}
+
+func TestSelectorAllowedListSpec_IsSubsetOf(t *testing.T) {
+	type tc struct {
+		Parent *SelectorAllowedListSpec
+		Child  SelectorAllowedListSpec
+		Subset bool
+	}
+
+	parent := &SelectorAllowedListSpec{
+		LabelSelector: metav1.LabelSelector{
+			MatchLabels: map[string]string{"env": "prod"},
+			MatchExpressions: []metav1.LabelSelectorRequirement{
+				{Key: "tier", Operator: metav1.LabelSelectorOpIn, Values: []string{"gold"}},
+			},
+		},
+	}
+
+	for _, tc := range []tc{
+		{parent, SelectorAllowedListSpec{}, true},
+		{parent, SelectorAllowedListSpec{LabelSelector: metav1.LabelSelector{
+			MatchLabels: map[string]string{"env": "prod", "team": "a"},
+			MatchExpressions: []metav1.LabelSelectorRequirement{
+				{Key: "tier", Operator: metav1.LabelSelectorOpIn, Values: []string{"gold"}},
+			},
+		}}, true},
+		{parent, SelectorAllowedListSpec{LabelSelector: metav1.LabelSelector{
+			MatchLabels: map[string]string{"env": "prod"},
+		}}, false},
+		{&SelectorAllowedListSpec{}, SelectorAllowedListSpec{LabelSelector: metav1.LabelSelector{
+			MatchLabels: map[string]string{"env": "prod"},
+		}}, false},
+	} {
+		assert.Equal(t, tc.Subset, tc.Child.IsSubsetOf(tc.Parent))
+	}
+}
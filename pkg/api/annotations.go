@@ -9,4 +9,11 @@ const (
 	ForbiddenNamespaceAnnotationsAnnotation       = "capsule.clastix.io/forbidden-namespace-annotations"
 	ForbiddenNamespaceAnnotationsRegexpAnnotation = "capsule.clastix.io/forbidden-namespace-annotations-regexp"
 	ProtectedTenantAnnotation                     = "capsule.clastix.io/protected"
+	PolicyViolationsAnnotation                    = "capsule.clastix.io/policy-violations"
+	OriginalImagesAnnotation                      = "capsule.clastix.io/original-images"
+	DeletionScheduleAnnotation                    = "capsule.clastix.io/deletion-scheduled-at"
+	RestoreAnnotation                             = "capsule.clastix.io/restore"
+	OriginalReplicasAnnotation                    = "capsule.clastix.io/original-replicas"
+	OriginalSuspendAnnotation                     = "capsule.clastix.io/original-suspend"
+	NamespaceRequestAnnotation                    = "capsule.clastix.io/namespace-request"
 )
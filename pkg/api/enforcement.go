@@ -0,0 +1,92 @@
+// Copyright 2020-2023 Project Capsule Authors.
+// SPDX-License-Identifier: Apache-2.0
+
+package api
+
+// +kubebuilder:validation:Enum=Enforce;Warn;Audit
+type EnforcementMode string
+
+const (
+	// EnforcementModeEnforce denies the requests violating the policy.
+	EnforcementModeEnforce EnforcementMode = "Enforce"
+	// EnforcementModeWarn allows the requests violating the policy, returning an admission warning to the client.
+	EnforcementModeWarn EnforcementMode = "Warn"
+	// EnforcementModeAudit allows the requests violating the policy, recording the violation with an event and a metric.
+	EnforcementModeAudit EnforcementMode = "Audit"
+)
+
+func (e EnforcementMode) String() string {
+	return string(e)
+}
+
+// +kubebuilder:validation:Enum=None;Annotate;ScaleToZero;Delete
+type RemediationAction string
+
+const (
+	// RemediationActionNone reports the existing objects violating the policies with no further action.
+	RemediationActionNone RemediationAction = "None"
+	// RemediationActionAnnotate annotates the existing objects violating the policies with the violated ones.
+	RemediationActionAnnotate RemediationAction = "Annotate"
+	// RemediationActionScaleToZero scales to zero the workloads managing the Pods violating the policies,
+	// the other objects are annotated.
+	RemediationActionScaleToZero RemediationAction = "ScaleToZero"
+	// RemediationActionDelete deletes the existing objects violating the policies.
+	RemediationActionDelete RemediationAction = "Delete"
+)
+
+func (r RemediationAction) String() string {
+	return string(r)
+}
+
+// +kubebuilder:object:generate=true
+
+type EnforcementSpec struct {
+	// Enforcement mode applied to all the Tenant policies, unless overridden for the single policy. Optional.
+	// +kubebuilder:default=Enforce
+	Mode EnforcementMode `json:"mode,omitempty"`
+	// Enforcement mode applied to the single policies, identified by the reason of the event emitted upon violation,
+	// such as ForbiddenContainerRegistry. Optional.
+	// +listType=map
+	// +listMapKey=reason
+	Policies []PolicyEnforcementSpec `json:"policies,omitempty"`
+	// Action applied to the existing objects violating the policies in Enforce mode,
+	// detected upon the changes of the Tenant. Optional.
+	// +kubebuilder:default=None
+	Remediation RemediationAction `json:"remediation,omitempty"`
+}
+
+type PolicyEnforcementSpec struct {
+	// Reason of the event emitted upon the policy violation.
+	Reason string `json:"reason"`
+	// Enforcement mode applied to the policy.
+	Mode EnforcementMode `json:"mode"`
+}
+
+// ModeFor returns the enforcement mode for the policy identified by the given reason,
+// falling back to the Tenant one: requests are denied if no mode is specified.
+func (in *EnforcementSpec) ModeFor(reason string) EnforcementMode {
+	if in == nil {
+		return EnforcementModeEnforce
+	}
+
+	for _, policy := range in.Policies {
+		if policy.Reason == reason && len(policy.Mode) > 0 {
+			return policy.Mode
+		}
+	}
+
+	if len(in.Mode) == 0 {
+		return EnforcementModeEnforce
+	}
+
+	return in.Mode
+}
+
+// GetRemediation returns the action applied to the existing objects violating the policies in Enforce mode.
+func (in *EnforcementSpec) GetRemediation() RemediationAction {
+	if in == nil || len(in.Remediation) == 0 {
+		return RemediationActionNone
+	}
+
+	return in.Remediation
+}
@@ -0,0 +1,13 @@
+// Copyright 2020-2023 Project Capsule Authors.
+// SPDX-License-Identifier: Apache-2.0
+
+package api
+
+const (
+	HostnameOwnershipHostname HostnameOwnership = "Hostname"
+	HostnameOwnershipPath     HostnameOwnership = "Path"
+	HostnameOwnershipDisabled HostnameOwnership = "Disabled"
+)
+
+// +kubebuilder:validation:Enum=Hostname;Path;Disabled
+type HostnameOwnership string
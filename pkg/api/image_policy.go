@@ -0,0 +1,20 @@
+// Copyright 2020-2023 Project Capsule Authors.
+// SPDX-License-Identifier: Apache-2.0
+
+package api
+
+// +kubebuilder:object:generate=true
+
+type ImagePolicySpec struct {
+	// Repositories the container images can be pulled from, as registry along with an optional path prefix,
+	// such as docker.io/library or ghcr.io/projectcapsule. The images not specifying the registry are normalized to Docker Hub.
+	// Optional, all the repositories are allowed if empty.
+	AllowedRepositories []string `json:"allowedRepositories,omitempty"`
+	// Container images that cannot be used, as registry along with the repository, such as docker.io/library/nginx:
+	// when a tag or a digest is specified, only the given one is denied. Optional.
+	DeniedImages []string `json:"deniedImages,omitempty"`
+	// Forbid the images using the latest tag, or not specifying any tag nor digest. Optional.
+	ForbidLatestTag bool `json:"forbidLatestTag,omitempty"`
+	// Require the images to be pinned with a digest, such as @sha256:<hash>. Optional.
+	RequireDigest bool `json:"requireDigest,omitempty"`
+}
@@ -4,5 +4,6 @@
 package api
 
 const (
-	TenantNameLabel = "kubernetes.io/metadata.name"
+	TenantNameLabel               = "kubernetes.io/metadata.name"
+	NamespacePendingDeletionLabel = "capsule.clastix.io/pending-deletion"
 )
@@ -0,0 +1,42 @@
+// Copyright 2020-2023 Project Capsule Authors.
+// SPDX-License-Identifier: Apache-2.0
+
+package api
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// +kubebuilder:object:generate=true
+
+type NamespaceAdoptionSpec struct {
+	// Selects the existing Namespaces to adopt by their labels: an empty selector doesn't select any Namespace. Optional.
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
+	// Specifies the names of the existing Namespaces to adopt. Optional.
+	Namespaces []string `json:"namespaces,omitempty"`
+	// When enabled, the Namespaces are not adopted: the ones that would be adopted are reported in the Tenant status. Optional.
+	// +kubebuilder:default=false
+	DryRun bool `json:"dryRun,omitempty"`
+}
+
+// Selects returns true if the Namespace with the given name and labels is selected for the adoption:
+// an empty selector doesn't select any Namespace, rather than all of them.
+func (in *NamespaceAdoptionSpec) Selects(name string, nsLabels map[string]string) (bool, error) {
+	for _, namespace := range in.Namespaces {
+		if namespace == name {
+			return true, nil
+		}
+	}
+
+	if in.NamespaceSelector == nil {
+		return false, nil
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(in.NamespaceSelector)
+	if err != nil {
+		return false, err
+	}
+
+	return !selector.Empty() && selector.Matches(labels.Set(nsLabels)), nil
+}
@@ -0,0 +1,46 @@
+// Copyright 2020-2023 Project Capsule Authors.
+// SPDX-License-Identifier: Apache-2.0
+
+package api
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestNamespaceAdoptionSpec_Selects(t *testing.T) {
+	spec := &NamespaceAdoptionSpec{
+		Namespaces:        []string{"legacy-billing"},
+		NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"team": "oil"}},
+	}
+
+	for name, tc := range map[string]struct {
+		namespace string
+		labels    map[string]string
+		expected  bool
+	}{
+		"listed":       {namespace: "legacy-billing", expected: true},
+		"selected":     {namespace: "legacy-web", labels: map[string]string{"team": "oil"}, expected: true},
+		"not selected": {namespace: "legacy-web", labels: map[string]string{"team": "gas"}},
+		"no labels":    {namespace: "legacy-api"},
+	} {
+		selected, err := spec.Selects(tc.namespace, tc.labels)
+
+		assert.NoError(t, err, name)
+		assert.Equal(t, tc.expected, selected, name)
+	}
+
+	// An empty selector doesn't select all the Namespaces
+	spec = &NamespaceAdoptionSpec{NamespaceSelector: &metav1.LabelSelector{}}
+
+	selected, err := spec.Selects("kube-system", map[string]string{"kubernetes.io/metadata.name": "kube-system"})
+	assert.NoError(t, err)
+	assert.False(t, selected)
+
+	spec.NamespaceSelector.MatchExpressions = []metav1.LabelSelectorRequirement{{Key: "team", Operator: "Invalid"}}
+
+	_, err = spec.Selects("legacy-web", nil)
+	assert.Error(t, err)
+}
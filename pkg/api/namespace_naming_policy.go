@@ -0,0 +1,164 @@
+// Copyright 2020-2023 Project Capsule Authors.
+// SPDX-License-Identifier: Apache-2.0
+
+package api
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+type NamespaceNamingMode string
+
+const (
+	NamespaceNamingModeValidate NamespaceNamingMode = "Validate"
+	NamespaceNamingModeMutate   NamespaceNamingMode = "Mutate"
+
+	DefaultNamespaceNamingTemplate = "{{ tenant }}-{{ name }}"
+
+	namespaceNamingTenantPlaceholder = "tenant"
+	namespaceNamingNamePlaceholder   = "name"
+	namespaceNamingSuffixPlaceholder = "suffix"
+	// namespaceNamingSuffixHint is used by the suggestions when the suffix cannot be inferred from the requested name.
+	namespaceNamingSuffixHint = "<suffix>"
+	// dnsLabelPattern is the pattern of the name chosen by the Tenant Owner, as well as of the suffix when no one is allowed explicitly.
+	dnsLabelPattern = "[a-z0-9]([-a-z0-9]*[a-z0-9])?"
+)
+
+var namespaceNamingPlaceholder = regexp.MustCompile(`{{\s*(\w+)\s*}}`)
+
+// +kubebuilder:object:generate=true
+
+type NamespaceNamingPolicy struct {
+	// Specifies the template of the Namespace names, supporting the {{ tenant }}, {{ name }}, and {{ suffix }} placeholders:
+	// the name is chosen by the Tenant Owner, while the suffix must match one of the allowed suffixes.
+	// +kubebuilder:default="{{ tenant }}-{{ name }}"
+	Template string `json:"template,omitempty"`
+	// Specifies the regular expressions the {{ suffix }} placeholder must match, such as the environments. Optional.
+	AllowedSuffixes []string `json:"allowedSuffixes,omitempty"`
+	// Specifies the maximum length of the Namespace names. Optional.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=63
+	MaxLength *int32 `json:"maxLength,omitempty"`
+	// Specifies if the Namespaces not matching the policy are denied with a suggested name (Validate),
+	// or renamed according to the template, when possible (Mutate).
+	// +kubebuilder:default=Validate
+	// +kubebuilder:validation:Enum=Validate;Mutate
+	Mode NamespaceNamingMode `json:"mode,omitempty"`
+}
+
+func (in *NamespaceNamingPolicy) template() string {
+	if len(in.Template) == 0 {
+		return DefaultNamespaceNamingTemplate
+	}
+
+	return in.Template
+}
+
+// Validate returns an error if the template uses unknown placeholders, or the allowed suffixes cannot be compiled.
+func (in *NamespaceNamingPolicy) Validate() error {
+	for _, match := range namespaceNamingPlaceholder.FindAllStringSubmatch(in.template(), -1) {
+		switch match[1] {
+		case namespaceNamingTenantPlaceholder, namespaceNamingNamePlaceholder, namespaceNamingSuffixPlaceholder:
+			continue
+		default:
+			return fmt.Errorf("unknown placeholder %s in the namespace naming template", match[0])
+		}
+	}
+
+	for _, suffix := range in.AllowedSuffixes {
+		if _, err := regexp.Compile(suffix); err != nil {
+			return fmt.Errorf("unable to compile the allowed suffix %s: %w", suffix, err)
+		}
+	}
+
+	_, err := in.regexp("")
+
+	return err
+}
+
+func (in *NamespaceNamingPolicy) hasPlaceholder(placeholder string) bool {
+	for _, match := range namespaceNamingPlaceholder.FindAllStringSubmatch(in.template(), -1) {
+		if match[1] == placeholder {
+			return true
+		}
+	}
+
+	return false
+}
+
+// regexp compiles the template in the regular expression matching the names of the Namespaces of the given Tenant.
+func (in *NamespaceNamingPolicy) regexp(tenant string) (*regexp.Regexp, error) {
+	suffix := dnsLabelPattern
+
+	if len(in.AllowedSuffixes) > 0 {
+		suffixes := make([]string, 0, len(in.AllowedSuffixes))
+
+		for _, s := range in.AllowedSuffixes {
+			suffixes = append(suffixes, "(?:"+strings.TrimSuffix(strings.TrimPrefix(s, "^"), "$")+")")
+		}
+
+		suffix = strings.Join(suffixes, "|")
+	}
+
+	return regexp.Compile("^" + in.render(regexp.QuoteMeta, regexp.QuoteMeta(tenant), dnsLabelPattern, "(?:"+suffix+")") + "$")
+}
+
+// render replaces the template placeholders with the given values, the literal parts being transformed with the given function.
+func (in *NamespaceNamingPolicy) render(literal func(string) string, tenant, name, suffix string) string {
+	tmpl, rendered, last := in.template(), strings.Builder{}, 0
+
+	for _, indexes := range namespaceNamingPlaceholder.FindAllStringSubmatchIndex(tmpl, -1) {
+		rendered.WriteString(literal(tmpl[last:indexes[0]]))
+
+		switch tmpl[indexes[2]:indexes[3]] {
+		case namespaceNamingTenantPlaceholder:
+			rendered.WriteString(tenant)
+		case namespaceNamingNamePlaceholder:
+			rendered.WriteString(name)
+		case namespaceNamingSuffixPlaceholder:
+			rendered.WriteString(suffix)
+		}
+
+		last = indexes[1]
+	}
+
+	rendered.WriteString(literal(tmpl[last:]))
+
+	return rendered.String()
+}
+
+// Match returns true if the Namespace name matches the template, as well as the maximum length.
+func (in *NamespaceNamingPolicy) Match(tenant, namespace string) (bool, error) {
+	if in.MaxLength != nil && len(namespace) > int(*in.MaxLength) {
+		return false, nil
+	}
+
+	exp, err := in.regexp(tenant)
+	if err != nil {
+		return false, err
+	}
+
+	return exp.MatchString(namespace), nil
+}
+
+// Suggest renders the template with the requested Namespace name: the Tenant prefix is removed from the name,
+// as well as the trailing allowed suffix, if any, which is used for the suffix placeholder.
+func (in *NamespaceNamingPolicy) Suggest(tenant, namespace string) string {
+	name, suffix := strings.TrimPrefix(namespace, tenant+"-"), namespaceNamingSuffixHint
+
+	if in.hasPlaceholder(namespaceNamingSuffixPlaceholder) {
+		if index := strings.LastIndex(name, "-"); index > 0 {
+			for _, allowed := range in.AllowedSuffixes {
+				if exp, err := regexp.Compile("^(?:" + strings.TrimSuffix(strings.TrimPrefix(allowed, "^"), "$") + ")$"); err == nil && exp.MatchString(name[index+1:]) {
+					name, suffix = name[:index], name[index+1:]
+
+					break
+				}
+			}
+		}
+	}
+
+	return in.render(func(s string) string { return s }, tenant, name, suffix)
+}
@@ -0,0 +1,73 @@
+// Copyright 2020-2023 Project Capsule Authors.
+// SPDX-License-Identifier: Apache-2.0
+
+package api
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/utils/pointer"
+)
+
+func TestNamespaceNamingPolicy_Match(t *testing.T) {
+	policy := &NamespaceNamingPolicy{}
+
+	for namespace, expected := range map[string]bool{
+		"oil-production":    true,
+		"oil-web-api":       true,
+		"oil":               false,
+		"oil-":              false,
+		"gas-production":    false,
+		"oilproduction":     false,
+		"oil-Production":    false,
+		"production-oil-01": false,
+	} {
+		matched, err := policy.Match("oil", namespace)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, matched, namespace)
+	}
+
+	policy = &NamespaceNamingPolicy{
+		Template:        "{{ tenant }}-{{ name }}-{{ suffix }}",
+		AllowedSuffixes: []string{"^(dev|staging)$", "prod-eu"},
+		MaxLength:       pointer.Int32(20),
+	}
+
+	for namespace, expected := range map[string]bool{
+		"oil-web-dev":                  true,
+		"oil-web-api-staging":          true,
+		"oil-web-prod-eu":              true,
+		"oil-web-prod":                 false,
+		"oil-web":                      false,
+		"oil-dev":                      false,
+		"oil-payments-api-dev":         true,
+		"oil-payments-api-prod":        false,
+		"oil-payments-gateway-staging": false,
+	} {
+		matched, err := policy.Match("oil", namespace)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, matched, namespace)
+	}
+}
+
+func TestNamespaceNamingPolicy_Suggest(t *testing.T) {
+	assert.Equal(t, "oil-production", (&NamespaceNamingPolicy{}).Suggest("oil", "production"))
+	assert.Equal(t, "oil-production", (&NamespaceNamingPolicy{}).Suggest("oil", "oil-production"))
+
+	policy := &NamespaceNamingPolicy{
+		Template:        "{{ tenant }}-{{ name }}-{{ suffix }}",
+		AllowedSuffixes: []string{"dev", "prod"},
+	}
+
+	assert.Equal(t, "oil-web-dev", policy.Suggest("oil", "web-dev"))
+	assert.Equal(t, "oil-web-<suffix>", policy.Suggest("oil", "web"))
+	assert.Equal(t, "team-oil-web", (&NamespaceNamingPolicy{Template: "team-{{tenant}}-{{name}}"}).Suggest("oil", "web"))
+}
+
+func TestNamespaceNamingPolicy_Validate(t *testing.T) {
+	assert.NoError(t, (&NamespaceNamingPolicy{}).Validate())
+	assert.NoError(t, (&NamespaceNamingPolicy{Template: "{{tenant}}-{{ name }}-{{ suffix }}", AllowedSuffixes: []string{"dev"}}).Validate())
+	assert.Error(t, (&NamespaceNamingPolicy{Template: "{{ tenant }}-{{ env }}"}).Validate())
+	assert.Error(t, (&NamespaceNamingPolicy{AllowedSuffixes: []string{"(dev"}}).Validate())
+}
@@ -0,0 +1,18 @@
+// Copyright 2020-2023 Project Capsule Authors.
+// SPDX-License-Identifier: Apache-2.0
+
+package api
+
+// +kubebuilder:object:generate=true
+
+type NamespaceRequestsSpec struct {
+	// Specifies the number of Namespaces the requests are automatically approved up to:
+	// a request is approved when the Tenant Namespaces, including the requested one, don't exceed it.
+	// When not set, the requests must be approved by the cluster administrators. Optional.
+	// +kubebuilder:validation:Minimum=1
+	AutoApprovalQuota *int32 `json:"autoApprovalQuota,omitempty"`
+	// Specifies if the namespace quota of the Tenant is raised by one when a request is fulfilled
+	// with the Tenant being full, rather than bypassed once. Optional.
+	// +kubebuilder:default=false
+	RaiseQuota bool `json:"raiseQuota,omitempty"`
+}
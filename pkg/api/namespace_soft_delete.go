@@ -0,0 +1,16 @@
+// Copyright 2020-2023 Project Capsule Authors.
+// SPDX-License-Identifier: Apache-2.0
+
+package api
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +kubebuilder:object:generate=true
+
+type NamespaceSoftDeleteSpec struct {
+	// Specifies the time a Namespace pending deletion is kept before being actually deleted.
+	// +kubebuilder:default="24h"
+	GracePeriod metav1.Duration `json:"gracePeriod,omitempty"`
+}
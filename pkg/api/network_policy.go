@@ -4,11 +4,250 @@
 package api
 
 import (
+	"encoding/json"
+	"net"
+
+	"github.com/valyala/fasttemplate"
+	corev1 "k8s.io/api/core/v1"
 	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
 // +kubebuilder:object:generate=true
 
 type NetworkPolicySpec struct {
+	// The items support the {{ tenant.name }} and {{ namespace }} placeholders,
+	// replaced with the name of the Tenant and the one of the Namespace the NetworkPolicy is created into.
 	Items []networkingv1.NetworkPolicySpec `json:"items,omitempty"`
+	// Specifies the built-in NetworkPolicies created in the Namespaces of the Tenant:
+	// a Namespace can opt out of a preset with the networkpolicies.capsule.clastix.io/<preset>=disabled label. Optional.
+	Presets *NetworkPolicyPresets `json:"presets,omitempty"`
+}
+
+// RenderItems returns the items with the placeholders replaced according to the given Tenant and Namespace.
+func (in *NetworkPolicySpec) RenderItems(tenant, namespace string) ([]networkingv1.NetworkPolicySpec, error) {
+	items := make([]networkingv1.NetworkPolicySpec, 0, len(in.Items))
+
+	for _, item := range in.Items {
+		raw, err := json.Marshal(item)
+		if err != nil {
+			return nil, err
+		}
+
+		t := fasttemplate.New(string(raw), "{{ ", " }}")
+
+		rendered := networkingv1.NetworkPolicySpec{}
+		if err = json.Unmarshal([]byte(t.ExecuteString(map[string]interface{}{
+			"tenant.name": tenant,
+			"namespace":   namespace,
+		})), &rendered); err != nil {
+			return nil, err
+		}
+
+		items = append(items, rendered)
+	}
+
+	return items, nil
+}
+
+type NetworkPolicyPreset string
+
+const (
+	NetworkPolicyPresetTenantIsolation        NetworkPolicyPreset = "tenant-isolation"
+	NetworkPolicyPresetAllowDNS               NetworkPolicyPreset = "allow-dns"
+	NetworkPolicyPresetAllowIngressController NetworkPolicyPreset = "allow-ingress-controller"
+	NetworkPolicyPresetDenyCloudMetadata      NetworkPolicyPreset = "deny-cloud-metadata"
+
+	NetworkPolicyPresetOptOutValue = "disabled"
+	// NetworkPolicyPresetOptOutLabelPrefix is the prefix of the Namespace labels opting out of the presets,
+	// which can be set by the cluster administrators only.
+	NetworkPolicyPresetOptOutLabelPrefix = "networkpolicies.capsule.clastix.io/"
+
+	cloudMetadataCIDR = "169.254.169.254/32"
+)
+
+// OptOutLabel returns the label a Namespace can set to NetworkPolicyPresetOptOutValue to opt out of the preset.
+func (p NetworkPolicyPreset) OptOutLabel() string {
+	return NetworkPolicyPresetOptOutLabelPrefix + string(p)
+}
+
+// +kubebuilder:object:generate=true
+
+type NetworkPolicyPresets struct {
+	// Allows the ingress traffic only from the Namespaces of the same Tenant. Optional.
+	TenantIsolation bool `json:"tenantIsolation,omitempty"`
+	// Allows the egress traffic toward the cluster DNS: the NetworkPolicies restricting the egress traffic
+	// should be combined with this preset. Optional.
+	AllowDNS *DNSNetworkPolicyPreset `json:"allowDNS,omitempty"`
+	// Allows the ingress traffic from the Ingress Controller. Optional.
+	AllowIngressController *IngressControllerNetworkPolicyPreset `json:"allowIngressController,omitempty"`
+	// Excludes the cloud metadata endpoint from the egress rules of the NetworkPolicies created by Capsule,
+	// the Tenant items and the other presets: since the NetworkPolicies are additive, this doesn't deny the traffic
+	// allowed by any other NetworkPolicy, nor creates any NetworkPolicy on its own. Optional.
+	DenyCloudMetadata bool `json:"denyCloudMetadata,omitempty"`
+}
+
+// +kubebuilder:object:generate=true
+
+type DNSNetworkPolicyPreset struct {
+	// Selects the Namespace of the cluster DNS, defaulting to the kube-system one. Optional.
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
+	// Selects the Pods of the cluster DNS, defaulting to the ones labelled k8s-app=kube-dns. Optional.
+	PodSelector *metav1.LabelSelector `json:"podSelector,omitempty"`
+}
+
+// +kubebuilder:object:generate=true
+
+type IngressControllerNetworkPolicyPreset struct {
+	// Selects the Namespace of the Ingress Controller.
+	NamespaceSelector metav1.LabelSelector `json:"namespaceSelector"`
+	// Selects the Pods of the Ingress Controller, all the ones of the selected Namespaces if empty. Optional.
+	PodSelector *metav1.LabelSelector `json:"podSelector,omitempty"`
+}
+
+// Render returns the NetworkPolicies of the enabled presets, the Tenant Namespaces being selected by the given label.
+func (in *NetworkPolicyPresets) Render(tenantLabel, tenant string) map[NetworkPolicyPreset]networkingv1.NetworkPolicySpec {
+	presets := make(map[NetworkPolicyPreset]networkingv1.NetworkPolicySpec)
+
+	if in == nil {
+		return presets
+	}
+
+	if in.TenantIsolation {
+		presets[NetworkPolicyPresetTenantIsolation] = networkingv1.NetworkPolicySpec{
+			PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeIngress},
+			Ingress: []networkingv1.NetworkPolicyIngressRule{
+				{
+					From: []networkingv1.NetworkPolicyPeer{
+						{
+							NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{tenantLabel: tenant}},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	if dns := in.AllowDNS; dns != nil {
+		namespaceSelector := &metav1.LabelSelector{MatchLabels: map[string]string{TenantNameLabel: "kube-system"}}
+		if dns.NamespaceSelector != nil {
+			namespaceSelector = dns.NamespaceSelector.DeepCopy()
+		}
+
+		podSelector := &metav1.LabelSelector{MatchLabels: map[string]string{"k8s-app": "kube-dns"}}
+		if dns.PodSelector != nil {
+			podSelector = dns.PodSelector.DeepCopy()
+		}
+
+		udp, tcp, port := corev1.ProtocolUDP, corev1.ProtocolTCP, intstr.FromInt(53)
+
+		presets[NetworkPolicyPresetAllowDNS] = networkingv1.NetworkPolicySpec{
+			PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeEgress},
+			Egress: []networkingv1.NetworkPolicyEgressRule{
+				{
+					To: []networkingv1.NetworkPolicyPeer{
+						{
+							NamespaceSelector: namespaceSelector,
+							PodSelector:       podSelector,
+						},
+					},
+					Ports: []networkingv1.NetworkPolicyPort{
+						{Protocol: &udp, Port: &port},
+						{Protocol: &tcp, Port: &port},
+					},
+				},
+			},
+		}
+	}
+
+	if ingress := in.AllowIngressController; ingress != nil {
+		peer := networkingv1.NetworkPolicyPeer{
+			NamespaceSelector: ingress.NamespaceSelector.DeepCopy(),
+		}
+
+		if ingress.PodSelector != nil {
+			peer.PodSelector = ingress.PodSelector.DeepCopy()
+		}
+
+		presets[NetworkPolicyPresetAllowIngressController] = networkingv1.NetworkPolicySpec{
+			PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeIngress},
+			Ingress: []networkingv1.NetworkPolicyIngressRule{
+				{From: []networkingv1.NetworkPolicyPeer{peer}},
+			},
+		}
+	}
+
+	return presets
+}
+
+// ExcludeCloudMetadata returns a copy of the given NetworkPolicy whose egress rules don't allow the cloud metadata endpoint:
+// the rules allowing any destination are restricted to all the other ones, and the IP blocks including the endpoint exclude it.
+func ExcludeCloudMetadata(spec networkingv1.NetworkPolicySpec) networkingv1.NetworkPolicySpec {
+	out := spec.DeepCopy()
+	// The policy types are defaulted according to the egress rules, which could be all dropped
+	if len(out.PolicyTypes) == 0 && len(out.Egress) > 0 {
+		out.PolicyTypes = []networkingv1.PolicyType{networkingv1.PolicyTypeIngress, networkingv1.PolicyTypeEgress}
+	}
+
+	metadataIP, metadataNet, _ := net.ParseCIDR(cloudMetadataCIDR)
+
+	egress := make([]networkingv1.NetworkPolicyEgressRule, 0, len(out.Egress))
+
+	for _, rule := range out.Egress {
+		if len(rule.To) == 0 {
+			rule.To = []networkingv1.NetworkPolicyPeer{
+				{NamespaceSelector: &metav1.LabelSelector{}},
+				{IPBlock: &networkingv1.IPBlock{CIDR: "0.0.0.0/0", Except: []string{cloudMetadataCIDR}}},
+				{IPBlock: &networkingv1.IPBlock{CIDR: "::/0"}},
+			}
+
+			egress = append(egress, rule)
+
+			continue
+		}
+
+		peers := make([]networkingv1.NetworkPolicyPeer, 0, len(rule.To))
+
+		for _, peer := range rule.To {
+			if peer.IPBlock == nil || !ipBlockAllows(peer.IPBlock, metadataIP) {
+				peers = append(peers, peer)
+
+				continue
+			}
+			// The IP blocks matching the endpoint only are dropped, as the exceptions must be narrower than the block
+			if _, block, err := net.ParseCIDR(peer.IPBlock.CIDR); err == nil && block.String() == metadataNet.String() {
+				continue
+			}
+
+			peer.IPBlock.Except = append(peer.IPBlock.Except, cloudMetadataCIDR)
+			peers = append(peers, peer)
+		}
+		// A rule left without peers would allow any destination
+		if len(peers) == 0 {
+			continue
+		}
+
+		rule.To = peers
+		egress = append(egress, rule)
+	}
+
+	out.Egress = egress
+
+	return *out
+}
+
+// ipBlockAllows returns true if the given IP is included in the IP block, and not in any of its exceptions.
+func ipBlockAllows(block *networkingv1.IPBlock, ip net.IP) bool {
+	if _, cidr, err := net.ParseCIDR(block.CIDR); err != nil || !cidr.Contains(ip) {
+		return false
+	}
+
+	for _, except := range block.Except {
+		if _, cidr, err := net.ParseCIDR(except); err == nil && cidr.Contains(ip) {
+			return false
+		}
+	}
+
+	return true
 }
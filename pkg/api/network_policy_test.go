@@ -0,0 +1,120 @@
+// Copyright 2020-2023 Project Capsule Authors.
+// SPDX-License-Identifier: Apache-2.0
+
+package api
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+func TestNetworkPolicySpec_RenderItems(t *testing.T) {
+	spec := &NetworkPolicySpec{
+		Items: []networkingv1.NetworkPolicySpec{
+			{
+				PodSelector: metav1.LabelSelector{MatchLabels: map[string]string{"app": "web"}},
+				Ingress: []networkingv1.NetworkPolicyIngressRule{
+					{
+						From: []networkingv1.NetworkPolicyPeer{
+							{NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"capsule.clastix.io/tenant": "{{ tenant.name }}"}}},
+							{NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"kubernetes.io/metadata.name": "{{ namespace }}-monitoring"}}},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	items, err := spec.RenderItems("oil", "oil-production")
+	assert.NoError(t, err)
+	assert.Len(t, items, 1)
+	assert.Equal(t, "web", items[0].PodSelector.MatchLabels["app"])
+	assert.Equal(t, "oil", items[0].Ingress[0].From[0].NamespaceSelector.MatchLabels["capsule.clastix.io/tenant"])
+	assert.Equal(t, "oil-production-monitoring", items[0].Ingress[0].From[1].NamespaceSelector.MatchLabels["kubernetes.io/metadata.name"])
+	// the original items must be left untouched
+	assert.Equal(t, "{{ tenant.name }}", spec.Items[0].Ingress[0].From[0].NamespaceSelector.MatchLabels["capsule.clastix.io/tenant"])
+}
+
+func TestNetworkPolicyPresets_Render(t *testing.T) {
+	var nilPresets *NetworkPolicyPresets
+
+	assert.Empty(t, nilPresets.Render("capsule.clastix.io/tenant", "oil"))
+
+	presets := &NetworkPolicyPresets{
+		TenantIsolation: true,
+		AllowDNS:        &DNSNetworkPolicyPreset{},
+		AllowIngressController: &IngressControllerNetworkPolicyPreset{
+			NamespaceSelector: metav1.LabelSelector{MatchLabels: map[string]string{"kubernetes.io/metadata.name": "ingress-nginx"}},
+		},
+		DenyCloudMetadata: true,
+	}
+
+	rendered := presets.Render("capsule.clastix.io/tenant", "oil")
+	assert.Len(t, rendered, 3)
+
+	isolation := rendered[NetworkPolicyPresetTenantIsolation]
+	assert.Equal(t, []networkingv1.PolicyType{networkingv1.PolicyTypeIngress}, isolation.PolicyTypes)
+	assert.Equal(t, "oil", isolation.Ingress[0].From[0].NamespaceSelector.MatchLabels["capsule.clastix.io/tenant"])
+
+	dns := rendered[NetworkPolicyPresetAllowDNS]
+	assert.Equal(t, "kube-system", dns.Egress[0].To[0].NamespaceSelector.MatchLabels["kubernetes.io/metadata.name"])
+	assert.Equal(t, "kube-dns", dns.Egress[0].To[0].PodSelector.MatchLabels["k8s-app"])
+	assert.Len(t, dns.Egress[0].Ports, 2)
+
+	ingress := rendered[NetworkPolicyPresetAllowIngressController]
+	assert.Equal(t, "ingress-nginx", ingress.Ingress[0].From[0].NamespaceSelector.MatchLabels["kubernetes.io/metadata.name"])
+	assert.Nil(t, ingress.Ingress[0].From[0].PodSelector)
+
+	_, ok := rendered[NetworkPolicyPresetDenyCloudMetadata]
+	assert.False(t, ok)
+
+	assert.Equal(t, "networkpolicies.capsule.clastix.io/allow-dns", NetworkPolicyPresetAllowDNS.OptOutLabel())
+}
+
+func TestExcludeCloudMetadata(t *testing.T) {
+	tcp, port := corev1.ProtocolTCP, intstr.FromInt(443)
+
+	spec := networkingv1.NetworkPolicySpec{
+		Egress: []networkingv1.NetworkPolicyEgressRule{
+			{
+				Ports: []networkingv1.NetworkPolicyPort{{Protocol: &tcp, Port: &port}},
+			},
+			{
+				To: []networkingv1.NetworkPolicyPeer{
+					{PodSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "db"}}},
+					{IPBlock: &networkingv1.IPBlock{CIDR: "169.254.0.0/16"}},
+					{IPBlock: &networkingv1.IPBlock{CIDR: "10.0.0.0/8"}},
+					{IPBlock: &networkingv1.IPBlock{CIDR: "0.0.0.0/0", Except: []string{"169.254.169.0/24"}}},
+				},
+			},
+			{
+				To: []networkingv1.NetworkPolicyPeer{
+					{IPBlock: &networkingv1.IPBlock{CIDR: "169.254.169.254/32"}},
+				},
+			},
+		},
+	}
+
+	excluded := ExcludeCloudMetadata(spec)
+	// the policy types must be kept, even if the egress rules are dropped
+	assert.Equal(t, []networkingv1.PolicyType{networkingv1.PolicyTypeIngress, networkingv1.PolicyTypeEgress}, excluded.PolicyTypes)
+	assert.Len(t, excluded.Egress, 2)
+	// the rules allowing any destination are restricted
+	assert.Equal(t, "0.0.0.0/0", excluded.Egress[0].To[1].IPBlock.CIDR)
+	assert.Equal(t, []string{"169.254.169.254/32"}, excluded.Egress[0].To[1].IPBlock.Except)
+	assert.Len(t, excluded.Egress[0].Ports, 1)
+	// only the IP blocks allowing the endpoint are excluding it
+	assert.Nil(t, excluded.Egress[1].To[0].IPBlock)
+	assert.Equal(t, []string{"169.254.169.254/32"}, excluded.Egress[1].To[1].IPBlock.Except)
+	assert.Empty(t, excluded.Egress[1].To[2].IPBlock.Except)
+	assert.Equal(t, []string{"169.254.169.0/24"}, excluded.Egress[1].To[3].IPBlock.Except)
+	// the original policy must be left untouched
+	assert.Empty(t, spec.PolicyTypes)
+	assert.Empty(t, spec.Egress[0].To)
+	assert.Empty(t, spec.Egress[1].To[1].IPBlock.Except)
+}
@@ -0,0 +1,56 @@
+// Copyright 2020-2023 Project Capsule Authors.
+// SPDX-License-Identifier: Apache-2.0
+
+package api
+
+import (
+	corev1 "k8s.io/api/core/v1"
+)
+
+// +kubebuilder:object:generate=true
+
+type NodePoolSpec struct {
+	// Inject the Tenant node selector into the Pods, rather than relying on the PodNodeSelector admission plugin. Optional.
+	InjectNodeSelector bool `json:"injectNodeSelector,omitempty"`
+	// Node affinity required by the Pods of the Tenant, combined with the one of the Pods. Optional.
+	RequiredNodeAffinity *corev1.NodeSelector `json:"requiredNodeAffinity,omitempty"`
+	// Tolerations injected into the Pods of the Tenant. Optional.
+	Tolerations []corev1.Toleration `json:"tolerations,omitempty"`
+	// Taints of the nodes dedicated to the Tenant: the Pods of the other Tenants cannot tolerate them. Optional.
+	ReservedTaints []ReservedTaintSpec `json:"reservedTaints,omitempty"`
+}
+
+// +kubebuilder:object:generate=true
+
+type ReservedTaintSpec struct {
+	// Key of the taint.
+	Key string `json:"key"`
+	// Value of the taint. Optional, any value if empty.
+	Value string `json:"value,omitempty"`
+	// Effect of the taint. Optional, any effect if empty.
+	// +kubebuilder:validation:Enum=NoSchedule;PreferNoSchedule;NoExecute
+	Effect corev1.TaintEffect `json:"effect,omitempty"`
+}
+
+// IsToleratedBy returns true if the given toleration tolerates the reserved taint, or a part of it,
+// such as tolerating any value of its key.
+func (in ReservedTaintSpec) IsToleratedBy(toleration corev1.Toleration) bool {
+	// An empty key along with the Exists operator tolerates everything.
+	if len(toleration.Key) == 0 {
+		return toleration.Operator == corev1.TolerationOpExists
+	}
+
+	if toleration.Key != in.Key {
+		return false
+	}
+
+	if len(in.Effect) > 0 && len(toleration.Effect) > 0 && toleration.Effect != in.Effect {
+		return false
+	}
+
+	if len(in.Value) > 0 && toleration.Operator != corev1.TolerationOpExists && toleration.Value != in.Value {
+		return false
+	}
+
+	return true
+}
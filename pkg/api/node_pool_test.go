@@ -0,0 +1,34 @@
+// Copyright 2020-2023 Project Capsule Authors.
+// SPDX-License-Identifier: Apache-2.0
+
+package api
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestReservedTaintSpec_IsToleratedBy(t *testing.T) {
+	taint := ReservedTaintSpec{Key: "pool", Value: "oil", Effect: corev1.TaintEffectNoSchedule}
+
+	for _, toleration := range []corev1.Toleration{
+		{Operator: corev1.TolerationOpExists},
+		{Key: "pool", Operator: corev1.TolerationOpExists},
+		{Key: "pool", Operator: corev1.TolerationOpEqual, Value: "oil"},
+		{Key: "pool", Value: "oil", Effect: corev1.TaintEffectNoSchedule},
+	} {
+		assert.True(t, taint.IsToleratedBy(toleration), toleration)
+	}
+
+	for _, toleration := range []corev1.Toleration{
+		{Key: "zone", Operator: corev1.TolerationOpExists},
+		{Key: "pool", Operator: corev1.TolerationOpEqual, Value: "gas"},
+		{Key: "pool", Operator: corev1.TolerationOpExists, Effect: corev1.TaintEffectNoExecute},
+	} {
+		assert.False(t, taint.IsToleratedBy(toleration), toleration)
+	}
+
+	assert.True(t, ReservedTaintSpec{Key: "pool"}.IsToleratedBy(corev1.Toleration{Key: "pool", Value: "gas", Effect: corev1.TaintEffectNoExecute}))
+}
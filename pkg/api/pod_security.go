@@ -0,0 +1,200 @@
+// Copyright 2020-2023 Project Capsule Authors.
+// SPDX-License-Identifier: Apache-2.0
+
+package api
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +kubebuilder:validation:Enum=privileged;baseline;restricted
+type PodSecurityLevel string
+
+const (
+	PodSecurityLevelPrivileged PodSecurityLevel = "privileged"
+	PodSecurityLevelBaseline   PodSecurityLevel = "baseline"
+	PodSecurityLevelRestricted PodSecurityLevel = "restricted"
+
+	PodSecurityVersionLatest = "latest"
+
+	podSecurityLabelPrefix = "pod-security.kubernetes.io/"
+)
+
+// +kubebuilder:object:generate=true
+
+type PodSecuritySpec struct {
+	// Pod Security Standard enforced on the Tenant Namespaces by the Pod Security Admission:
+	// the Pods violating it are rejected. Optional.
+	Enforce *PodSecurityStandardSpec `json:"enforce,omitempty"`
+	// Pod Security Standard audited on the Tenant Namespaces by the Pod Security Admission:
+	// the Pods violating it are recorded in the audit log. Optional.
+	Audit *PodSecurityStandardSpec `json:"audit,omitempty"`
+	// Pod Security Standard warned on the Tenant Namespaces by the Pod Security Admission:
+	// the Pods violating it are allowed, along with a warning to the client. Optional.
+	Warn *PodSecurityStandardSpec `json:"warn,omitempty"`
+	// Restrictions validated by Capsule on the Pods of the Tenant, regardless of the Pod Security Admission. Optional.
+	Restrictions *PodSecurityRestrictionsSpec `json:"restrictions,omitempty"`
+}
+
+// +kubebuilder:object:generate=true
+
+type PodSecurityStandardSpec struct {
+	// Level of the Pod Security Standard.
+	Level PodSecurityLevel `json:"level"`
+	// Version of the Pod Security Standard, such as v1.28. Optional, latest by default.
+	// +kubebuilder:validation:Pattern=`^(latest|v1\.(0|[1-9][0-9]*))$`
+	Version string `json:"version,omitempty"`
+}
+
+// +kubebuilder:object:generate=true
+
+type PodSecurityRestrictionsSpec struct {
+	// Forbid the Pods using the host network. Optional.
+	ForbidHostNetwork bool `json:"forbidHostNetwork,omitempty"`
+	// Forbid the Pods mounting hostPath volumes. Optional.
+	ForbidHostPath bool `json:"forbidHostPath,omitempty"`
+	// Forbid the privileged containers. Optional.
+	ForbidPrivileged bool `json:"forbidPrivileged,omitempty"`
+	// Capabilities the containers are allowed to add: when set, any other capability is forbidden,
+	// and an empty list forbids adding any. Optional, no restriction if unset.
+	AllowedCapabilities *[]corev1.Capability `json:"allowedCapabilities,omitempty"`
+	// Exceptions granted to a subset of the Tenant Pods. Optional.
+	Exceptions []PodSecurityExceptionSpec `json:"exceptions,omitempty"`
+}
+
+// +kubebuilder:object:generate=true
+
+type PodSecurityExceptionSpec struct {
+	// Namespaces the exception applies to. Optional, all the Tenant Namespaces if empty.
+	Namespaces []string `json:"namespaces,omitempty"`
+	// Selector of the Pods the exception applies to. Optional, all the Pods if unset.
+	PodSelector *metav1.LabelSelector `json:"podSelector,omitempty"`
+	// Allow the host network. Optional.
+	AllowHostNetwork bool `json:"allowHostNetwork,omitempty"`
+	// Allow the hostPath volumes. Optional.
+	AllowHostPath bool `json:"allowHostPath,omitempty"`
+	// Allow the privileged containers. Optional.
+	AllowPrivileged bool `json:"allowPrivileged,omitempty"`
+	// Capabilities allowed in addition to the Tenant ones. Optional.
+	AllowedCapabilities []corev1.Capability `json:"allowedCapabilities,omitempty"`
+}
+
+// Standards returns the Pod Security Standards, keyed by the Pod Security Admission mode.
+func (in *PodSecuritySpec) Standards() map[string]*PodSecurityStandardSpec {
+	standards := make(map[string]*PodSecurityStandardSpec)
+
+	if in == nil {
+		return standards
+	}
+
+	for mode, standard := range map[string]*PodSecurityStandardSpec{"enforce": in.Enforce, "audit": in.Audit, "warn": in.Warn} {
+		if standard != nil {
+			standards[mode] = standard
+		}
+	}
+
+	return standards
+}
+
+// PodSecurityLabels returns the Pod Security Admission labels enforced on the Tenant Namespaces.
+func PodSecurityLabels(spec *PodSecuritySpec) map[string]string {
+	labels := make(map[string]string)
+
+	for mode, standard := range spec.Standards() {
+		labels[PodSecurityLevelLabel(mode)] = string(standard.Level)
+		labels[PodSecurityVersionLabel(mode)] = standard.GetVersion()
+	}
+
+	return labels
+}
+
+// PodSecurityLoweredLabels returns the labels of the Namespace lowering the Tenant Pod Security Standards:
+// a missing level label is considered as privileged, and a missing version one as latest, as for the Pod Security Admission.
+func PodSecurityLoweredLabels(spec *PodSecuritySpec, labels map[string]string) (lowered []string) {
+	for mode, standard := range spec.Standards() {
+		levelLabel, versionLabel := PodSecurityLevelLabel(mode), PodSecurityVersionLabel(mode)
+
+		if PodSecurityLevel(labels[levelLabel]).Compare(standard.Level) < 0 {
+			lowered = append(lowered, levelLabel)
+		}
+
+		if ComparePodSecurityVersions(labels[versionLabel], standard.GetVersion()) < 0 {
+			lowered = append(lowered, versionLabel)
+		}
+	}
+
+	sort.Strings(lowered)
+
+	return lowered
+}
+
+func PodSecurityLevelLabel(mode string) string {
+	return podSecurityLabelPrefix + mode
+}
+
+func PodSecurityVersionLabel(mode string) string {
+	return podSecurityLabelPrefix + mode + "-version"
+}
+
+func (in *PodSecurityStandardSpec) GetVersion() string {
+	if len(in.Version) == 0 {
+		return PodSecurityVersionLatest
+	}
+
+	return in.Version
+}
+
+// Compare returns a negative value if the level is less restrictive than the given one, zero if equal,
+// and a positive value otherwise: unknown levels are the least restrictive.
+func (in PodSecurityLevel) Compare(level PodSecurityLevel) int {
+	rank := func(l PodSecurityLevel) int {
+		switch l {
+		case PodSecurityLevelRestricted:
+			return 2
+		case PodSecurityLevelBaseline:
+			return 1
+		default:
+			return 0
+		}
+	}
+
+	return rank(in) - rank(level)
+}
+
+// ComparePodSecurityVersions compares two Pod Security Standard versions, such as v1.28:
+// latest, as well as an empty version, is the most recent one, while the invalid ones are the oldest.
+func ComparePodSecurityVersions(a, b string) int {
+	minor := func(version string) int {
+		// The Pod Security Admission defaults to the latest version.
+		if version == PodSecurityVersionLatest || len(version) == 0 {
+			return int(^uint(0) >> 1)
+		}
+
+		if !strings.HasPrefix(version, "v1.") {
+			return -1
+		}
+
+		value, err := strconv.Atoi(strings.TrimPrefix(version, "v1."))
+		if err != nil {
+			return -1
+		}
+
+		return value
+	}
+
+	ma, mb := minor(a), minor(b)
+
+	switch {
+	case ma < mb:
+		return -1
+	case ma > mb:
+		return 1
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,57 @@
+// Copyright 2020-2023 Project Capsule Authors.
+// SPDX-License-Identifier: Apache-2.0
+
+package api
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPodSecurityLabels(t *testing.T) {
+	spec := &PodSecuritySpec{
+		Enforce: &PodSecurityStandardSpec{Level: PodSecurityLevelBaseline, Version: "v1.28"},
+		Warn:    &PodSecurityStandardSpec{Level: PodSecurityLevelRestricted},
+	}
+
+	assert.Equal(t, map[string]string{
+		"pod-security.kubernetes.io/enforce":         "baseline",
+		"pod-security.kubernetes.io/enforce-version": "v1.28",
+		"pod-security.kubernetes.io/warn":            "restricted",
+		"pod-security.kubernetes.io/warn-version":    "latest",
+	}, PodSecurityLabels(spec))
+
+	assert.Empty(t, PodSecurityLabels(nil))
+}
+
+func TestPodSecurityLoweredLabels(t *testing.T) {
+	spec := &PodSecuritySpec{
+		Enforce: &PodSecurityStandardSpec{Level: PodSecurityLevelBaseline, Version: "v1.28"},
+		Warn:    &PodSecurityStandardSpec{Level: PodSecurityLevelRestricted},
+	}
+
+	assert.Empty(t, PodSecurityLoweredLabels(spec, PodSecurityLabels(spec)))
+	assert.Empty(t, PodSecurityLoweredLabels(spec, map[string]string{
+		"pod-security.kubernetes.io/enforce":         "restricted",
+		"pod-security.kubernetes.io/enforce-version": "v1.29",
+		"pod-security.kubernetes.io/warn":            "restricted",
+	}))
+	assert.Equal(t, []string{"pod-security.kubernetes.io/enforce", "pod-security.kubernetes.io/warn"}, PodSecurityLoweredLabels(spec, map[string]string{
+		"pod-security.kubernetes.io/enforce-version": "latest",
+		"pod-security.kubernetes.io/warn":            "baseline",
+	}))
+	assert.Equal(t, []string{"pod-security.kubernetes.io/enforce-version", "pod-security.kubernetes.io/warn-version"}, PodSecurityLoweredLabels(spec, map[string]string{
+		"pod-security.kubernetes.io/enforce":         "baseline",
+		"pod-security.kubernetes.io/enforce-version": "v1.9",
+		"pod-security.kubernetes.io/warn":            "restricted",
+		"pod-security.kubernetes.io/warn-version":    "v1.30",
+	}))
+}
+
+func TestComparePodSecurityVersions(t *testing.T) {
+	assert.Equal(t, 0, ComparePodSecurityVersions("latest", ""))
+	assert.Equal(t, 1, ComparePodSecurityVersions("latest", "v1.28"))
+	assert.Equal(t, 1, ComparePodSecurityVersions("v1.28", "v1.9"))
+	assert.Equal(t, -1, ComparePodSecurityVersions("foo", "v1.0"))
+}
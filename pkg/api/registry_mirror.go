@@ -0,0 +1,14 @@
+// Copyright 2020-2023 Project Capsule Authors.
+// SPDX-License-Identifier: Apache-2.0
+
+package api
+
+// +kubebuilder:object:generate=true
+
+type RegistryMirrorSpec struct {
+	// Registry, along with an optional path prefix, of the images to rewrite, such as docker.io or docker.io/bitnami.
+	// The images not specifying the registry are normalized to Docker Hub, such as nginx to docker.io/library/nginx.
+	Source string `json:"source"`
+	// Registry, along with an optional path prefix, replacing the source one, such as mirror.internal/dockerhub.
+	Mirror string `json:"mirror"`
+}
@@ -0,0 +1,118 @@
+// Copyright 2020-2023 Project Capsule Authors.
+// SPDX-License-Identifier: Apache-2.0
+
+package api
+
+import (
+	"net"
+	"strings"
+)
+
+// +kubebuilder:object:generate=true
+
+type LoadBalancerOptions struct {
+	// Specifies the allowed LoadBalancer classes: a default value can be specified, and all the Services of type LoadBalancer
+	// created without a class will inherit it. Optional.
+	AllowedClasses *DefaultAllowedListSpec `json:"allowedClasses,omitempty"`
+	// Specifies the CIDRs the IP requested by the loadBalancerIP field must belong to. An empty list means no IPs can be requested. Optional.
+	AllowedIPs *AllowedIPsSpec `json:"allowedIPs,omitempty"`
+	// Specifies the CIDRs the loadBalancerSourceRanges must belong to. An empty list means no source ranges can be specified. Optional.
+	AllowedSourceRanges *AllowedIPsSpec `json:"allowedSourceRanges,omitempty"`
+	// Specifies the maximum number of Services of type LoadBalancer in the Tenant.
+	// The limit is enforced on the cached Services, thus it could be exceeded by concurrent requests. Optional.
+	// +kubebuilder:validation:Minimum=0
+	MaxServices *int32 `json:"maxServices,omitempty"`
+}
+
+// +kubebuilder:object:generate=true
+
+type NodePortOptions struct {
+	// Specifies the ranges the node ports of the Services must belong to: these must be explicitly specified,
+	// since the ones allocated by the API Server cannot be validated. Optional.
+	AllowedRanges []NodePortRange `json:"allowedRanges,omitempty"`
+	// Specifies the maximum number of Services allocating node ports in the Tenant, either of type NodePort or LoadBalancer,
+	// unless the allocation is disabled. The limit is enforced on the cached Services, thus it could be exceeded by concurrent requests. Optional.
+	// +kubebuilder:validation:Minimum=0
+	MaxServices *int32 `json:"maxServices,omitempty"`
+}
+
+type NodePortRange struct {
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	From int32 `json:"from"`
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	To int32 `json:"to"`
+}
+
+func (in NodePortRange) Contains(port int32) bool {
+	return port >= in.From && port <= in.To
+}
+
+// +kubebuilder:object:generate=true
+
+type AllowedIPsSpec struct {
+	Allowed []AllowedIP `json:"allowed"`
+}
+
+// ContainsIP returns true if the IP belongs to any of the allowed CIDRs:
+// the single IPs are handled as /32, or /128, ones.
+func (in *AllowedIPsSpec) ContainsIP(ip net.IP) bool {
+	if ip == nil {
+		return false
+	}
+
+	for _, allowed := range in.Allowed {
+		if _, cidr, err := net.ParseCIDR(normalizeCIDR(string(allowed))); err == nil && cidr.Contains(ip) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ContainsCIDR returns true if the CIDR is fully included by any of the allowed ones.
+func (in *AllowedIPsSpec) ContainsCIDR(value string) bool {
+	_, cidr, err := net.ParseCIDR(normalizeCIDR(value))
+	if err != nil {
+		return false
+	}
+
+	ones, _ := cidr.Mask.Size()
+
+	for _, allowed := range in.Allowed {
+		_, allowedCIDR, err := net.ParseCIDR(normalizeCIDR(string(allowed)))
+		if err != nil {
+			continue
+		}
+
+		if allowedOnes, _ := allowedCIDR.Mask.Size(); allowedOnes <= ones && allowedCIDR.Contains(cidr.IP) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (in *AllowedIPsSpec) String() string {
+	values := make([]string, 0, len(in.Allowed))
+
+	for _, allowed := range in.Allowed {
+		values = append(values, string(allowed))
+	}
+
+	return strings.Join(values, ", ")
+}
+
+func normalizeCIDR(value string) string {
+	switch {
+	case strings.Contains(value, "/"):
+		break
+	case strings.Contains(value, ":"):
+		value += "/128"
+	default:
+		value += "/32"
+	}
+
+	return value
+}
@@ -0,0 +1,46 @@
+// Copyright 2020-2023 Project Capsule Authors.
+// SPDX-License-Identifier: Apache-2.0
+
+package api
+
+import (
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAllowedIPsSpec_ContainsIP(t *testing.T) {
+	spec := &AllowedIPsSpec{Allowed: []AllowedIP{"10.0.0.0/24", "192.168.1.10", "fd00::/64"}}
+
+	for _, ip := range []string{"10.0.0.1", "10.0.0.255", "192.168.1.10", "fd00::1"} {
+		assert.True(t, spec.ContainsIP(net.ParseIP(ip)), ip)
+	}
+
+	for _, ip := range []string{"10.0.1.1", "192.168.1.11", "fd01::1"} {
+		assert.False(t, spec.ContainsIP(net.ParseIP(ip)), ip)
+	}
+
+	assert.False(t, spec.ContainsIP(nil))
+}
+
+func TestAllowedIPsSpec_ContainsCIDR(t *testing.T) {
+	spec := &AllowedIPsSpec{Allowed: []AllowedIP{"10.0.0.0/16", "192.168.1.10"}}
+
+	for _, cidr := range []string{"10.0.0.0/16", "10.0.1.0/24", "10.0.1.1", "192.168.1.10/32"} {
+		assert.True(t, spec.ContainsCIDR(cidr), cidr)
+	}
+
+	for _, cidr := range []string{"10.0.0.0/8", "10.1.0.0/24", "192.168.1.0/24", "0.0.0.0/0", "invalid"} {
+		assert.False(t, spec.ContainsCIDR(cidr), cidr)
+	}
+}
+
+func TestNodePortRange_Contains(t *testing.T) {
+	r := NodePortRange{From: 30000, To: 30100}
+
+	assert.True(t, r.Contains(30000))
+	assert.True(t, r.Contains(30100))
+	assert.False(t, r.Contains(29999))
+	assert.False(t, r.Contains(30101))
+}
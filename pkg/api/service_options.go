@@ -12,6 +12,10 @@ type ServiceOptions struct {
 	AllowedServices *AllowedServices `json:"allowedServices,omitempty"`
 	// Specifies the external IPs that can be used in Services with type ClusterIP. An empty list means no IPs are allowed. Optional.
 	ExternalServiceIPs *ExternalServiceIPsSpec `json:"externalIPs,omitempty"`
+	// Specifies the options for the Services of type LoadBalancer, such as the allowed classes and IPs. Optional.
+	LoadBalancer *LoadBalancerOptions `json:"loadBalancer,omitempty"`
+	// Specifies the options for the node ports of the Services of type NodePort and LoadBalancer. Optional.
+	NodePort *NodePortOptions `json:"nodePort,omitempty"`
 	// Define the labels that a Tenant Owner cannot set for their Service resources.
 	ForbiddenLabels ForbiddenListSpec `json:"forbiddenLabels,omitempty"`
 	// Define the annotations that a Tenant Owner cannot set for their Service resources.
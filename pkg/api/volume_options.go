@@ -0,0 +1,30 @@
+// Copyright 2020-2023 Project Capsule Authors.
+// SPDX-License-Identifier: Apache-2.0
+
+package api
+
+// +kubebuilder:validation:Enum=hostPath;emptyDir;gcePersistentDisk;awsElasticBlockStore;gitRepo;secret;nfs;iscsi;glusterfs;persistentVolumeClaim;rbd;flexVolume;cinder;cephfs;flocker;downwardAPI;fc;azureFile;configMap;vsphereVolume;quobyte;azureDisk;photonPersistentDisk;projected;portworxVolume;scaleIO;storageos;csi;ephemeral
+type VolumeType string
+
+// +kubebuilder:object:generate=true
+
+type VolumeOptions struct {
+	// Volume types the Pods in the Tenant can use, named after the field of the volume source,
+	// such as configMap, persistentVolumeClaim, or hostPath. Optional, all the types are allowed if empty.
+	AllowedTypes []VolumeType `json:"allowedTypes,omitempty"`
+	// Host paths the hostPath volumes can mount, matched by prefix. Optional, all the paths are allowed if empty.
+	AllowedHostPaths []AllowedHostPath `json:"allowedHostPaths,omitempty"`
+	// CSI drivers the inline ephemeral csi volumes can use. Optional, all the drivers are allowed if unset.
+	AllowedCSIDrivers *AllowedListSpec `json:"allowedCSIDrivers,omitempty"`
+	// Servers the nfs volumes can mount from, as hostname or IP address. Optional, all the servers are allowed if unset.
+	AllowedNFSServers *AllowedListSpec `json:"allowedNFSServers,omitempty"`
+}
+
+// +kubebuilder:object:generate=true
+
+type AllowedHostPath struct {
+	// Prefix of the host path, matched by whole path components: /var/log allows /var/log/pods, but not /var/logs.
+	PathPrefix string `json:"pathPrefix"`
+	// Require the containers to mount the volume as read-only. Optional.
+	ReadOnly bool `json:"readOnly,omitempty"`
+}
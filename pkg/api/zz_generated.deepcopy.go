@@ -12,6 +12,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	networkingv1 "k8s.io/api/networking/v1"
 	"k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
@@ -63,6 +64,41 @@ func (in *AdditionalRoleBindingsSpec) DeepCopy() *AdditionalRoleBindingsSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AllowedHostPath) DeepCopyInto(out *AllowedHostPath) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AllowedHostPath.
+func (in *AllowedHostPath) DeepCopy() *AllowedHostPath {
+	if in == nil {
+		return nil
+	}
+	out := new(AllowedHostPath)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AllowedIPsSpec) DeepCopyInto(out *AllowedIPsSpec) {
+	*out = *in
+	if in.Allowed != nil {
+		in, out := &in.Allowed, &out.Allowed
+		*out = make([]AllowedIP, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AllowedIPsSpec.
+func (in *AllowedIPsSpec) DeepCopy() *AllowedIPsSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AllowedIPsSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AllowedListSpec) DeepCopyInto(out *AllowedListSpec) {
 	*out = *in
@@ -113,6 +149,31 @@ func (in *AllowedServices) DeepCopy() *AllowedServices {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DNSNetworkPolicyPreset) DeepCopyInto(out *DNSNetworkPolicyPreset) {
+	*out = *in
+	if in.NamespaceSelector != nil {
+		in, out := &in.NamespaceSelector, &out.NamespaceSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PodSelector != nil {
+		in, out := &in.PodSelector, &out.PodSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSNetworkPolicyPreset.
+func (in *DNSNetworkPolicyPreset) DeepCopy() *DNSNetworkPolicyPreset {
+	if in == nil {
+		return nil
+	}
+	out := new(DNSNetworkPolicyPreset)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DefaultAllowedListSpec) DeepCopyInto(out *DefaultAllowedListSpec) {
 	*out = *in
@@ -129,6 +190,26 @@ func (in *DefaultAllowedListSpec) DeepCopy() *DefaultAllowedListSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EnforcementSpec) DeepCopyInto(out *EnforcementSpec) {
+	*out = *in
+	if in.Policies != nil {
+		in, out := &in.Policies, &out.Policies
+		*out = make([]PolicyEnforcementSpec, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnforcementSpec.
+func (in *EnforcementSpec) DeepCopy() *EnforcementSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(EnforcementSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ExternalServiceIPsSpec) DeepCopyInto(out *ExternalServiceIPsSpec) {
 	*out = *in
@@ -169,6 +250,52 @@ func (in *ForbiddenListSpec) DeepCopy() *ForbiddenListSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImagePolicySpec) DeepCopyInto(out *ImagePolicySpec) {
+	*out = *in
+	if in.AllowedRepositories != nil {
+		in, out := &in.AllowedRepositories, &out.AllowedRepositories
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.DeniedImages != nil {
+		in, out := &in.DeniedImages, &out.DeniedImages
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImagePolicySpec.
+func (in *ImagePolicySpec) DeepCopy() *ImagePolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ImagePolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IngressControllerNetworkPolicyPreset) DeepCopyInto(out *IngressControllerNetworkPolicyPreset) {
+	*out = *in
+	in.NamespaceSelector.DeepCopyInto(&out.NamespaceSelector)
+	if in.PodSelector != nil {
+		in, out := &in.PodSelector, &out.PodSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngressControllerNetworkPolicyPreset.
+func (in *IngressControllerNetworkPolicyPreset) DeepCopy() *IngressControllerNetworkPolicyPreset {
+	if in == nil {
+		return nil
+	}
+	out := new(IngressControllerNetworkPolicyPreset)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LimitRangesSpec) DeepCopyInto(out *LimitRangesSpec) {
 	*out = *in
@@ -191,6 +318,152 @@ func (in *LimitRangesSpec) DeepCopy() *LimitRangesSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoadBalancerOptions) DeepCopyInto(out *LoadBalancerOptions) {
+	*out = *in
+	if in.AllowedClasses != nil {
+		in, out := &in.AllowedClasses, &out.AllowedClasses
+		*out = new(DefaultAllowedListSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AllowedIPs != nil {
+		in, out := &in.AllowedIPs, &out.AllowedIPs
+		*out = new(AllowedIPsSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AllowedSourceRanges != nil {
+		in, out := &in.AllowedSourceRanges, &out.AllowedSourceRanges
+		*out = new(AllowedIPsSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MaxServices != nil {
+		in, out := &in.MaxServices, &out.MaxServices
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerOptions.
+func (in *LoadBalancerOptions) DeepCopy() *LoadBalancerOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(LoadBalancerOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NamespaceAdoptionSpec) DeepCopyInto(out *NamespaceAdoptionSpec) {
+	*out = *in
+	if in.NamespaceSelector != nil {
+		in, out := &in.NamespaceSelector, &out.NamespaceSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Namespaces != nil {
+		in, out := &in.Namespaces, &out.Namespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespaceAdoptionSpec.
+func (in *NamespaceAdoptionSpec) DeepCopy() *NamespaceAdoptionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NamespaceAdoptionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NamespaceNamingPolicy) DeepCopyInto(out *NamespaceNamingPolicy) {
+	*out = *in
+	if in.AllowedSuffixes != nil {
+		in, out := &in.AllowedSuffixes, &out.AllowedSuffixes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.MaxLength != nil {
+		in, out := &in.MaxLength, &out.MaxLength
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespaceNamingPolicy.
+func (in *NamespaceNamingPolicy) DeepCopy() *NamespaceNamingPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(NamespaceNamingPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NamespaceRequestsSpec) DeepCopyInto(out *NamespaceRequestsSpec) {
+	*out = *in
+	if in.AutoApprovalQuota != nil {
+		in, out := &in.AutoApprovalQuota, &out.AutoApprovalQuota
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespaceRequestsSpec.
+func (in *NamespaceRequestsSpec) DeepCopy() *NamespaceRequestsSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NamespaceRequestsSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NamespaceSoftDeleteSpec) DeepCopyInto(out *NamespaceSoftDeleteSpec) {
+	*out = *in
+	out.GracePeriod = in.GracePeriod
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespaceSoftDeleteSpec.
+func (in *NamespaceSoftDeleteSpec) DeepCopy() *NamespaceSoftDeleteSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NamespaceSoftDeleteSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkPolicyPresets) DeepCopyInto(out *NetworkPolicyPresets) {
+	*out = *in
+	if in.AllowDNS != nil {
+		in, out := &in.AllowDNS, &out.AllowDNS
+		*out = new(DNSNetworkPolicyPreset)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AllowIngressController != nil {
+		in, out := &in.AllowIngressController, &out.AllowIngressController
+		*out = new(IngressControllerNetworkPolicyPreset)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkPolicyPresets.
+func (in *NetworkPolicyPresets) DeepCopy() *NetworkPolicyPresets {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkPolicyPresets)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NetworkPolicySpec) DeepCopyInto(out *NetworkPolicySpec) {
 	*out = *in
@@ -201,6 +474,11 @@ func (in *NetworkPolicySpec) DeepCopyInto(out *NetworkPolicySpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Presets != nil {
+		in, out := &in.Presets, &out.Presets
+		*out = new(NetworkPolicyPresets)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkPolicySpec.
@@ -213,6 +491,63 @@ func (in *NetworkPolicySpec) DeepCopy() *NetworkPolicySpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodePoolSpec) DeepCopyInto(out *NodePoolSpec) {
+	*out = *in
+	if in.RequiredNodeAffinity != nil {
+		in, out := &in.RequiredNodeAffinity, &out.RequiredNodeAffinity
+		*out = new(corev1.NodeSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Tolerations != nil {
+		in, out := &in.Tolerations, &out.Tolerations
+		*out = make([]corev1.Toleration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ReservedTaints != nil {
+		in, out := &in.ReservedTaints, &out.ReservedTaints
+		*out = make([]ReservedTaintSpec, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodePoolSpec.
+func (in *NodePoolSpec) DeepCopy() *NodePoolSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NodePoolSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodePortOptions) DeepCopyInto(out *NodePortOptions) {
+	*out = *in
+	if in.AllowedRanges != nil {
+		in, out := &in.AllowedRanges, &out.AllowedRanges
+		*out = make([]NodePortRange, len(*in))
+		copy(*out, *in)
+	}
+	if in.MaxServices != nil {
+		in, out := &in.MaxServices, &out.MaxServices
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodePortOptions.
+func (in *NodePortOptions) DeepCopy() *NodePortOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(NodePortOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PodOptions) DeepCopyInto(out *PodOptions) {
 	*out = *in
@@ -233,6 +568,147 @@ func (in *PodOptions) DeepCopy() *PodOptions {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodSecurityExceptionSpec) DeepCopyInto(out *PodSecurityExceptionSpec) {
+	*out = *in
+	if in.Namespaces != nil {
+		in, out := &in.Namespaces, &out.Namespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.PodSelector != nil {
+		in, out := &in.PodSelector, &out.PodSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AllowedCapabilities != nil {
+		in, out := &in.AllowedCapabilities, &out.AllowedCapabilities
+		*out = make([]corev1.Capability, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodSecurityExceptionSpec.
+func (in *PodSecurityExceptionSpec) DeepCopy() *PodSecurityExceptionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PodSecurityExceptionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodSecurityRestrictionsSpec) DeepCopyInto(out *PodSecurityRestrictionsSpec) {
+	*out = *in
+	if in.AllowedCapabilities != nil {
+		in, out := &in.AllowedCapabilities, &out.AllowedCapabilities
+		*out = new([]corev1.Capability)
+		if **in != nil {
+			in, out := *in, *out
+			*out = make([]corev1.Capability, len(*in))
+			copy(*out, *in)
+		}
+	}
+	if in.Exceptions != nil {
+		in, out := &in.Exceptions, &out.Exceptions
+		*out = make([]PodSecurityExceptionSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodSecurityRestrictionsSpec.
+func (in *PodSecurityRestrictionsSpec) DeepCopy() *PodSecurityRestrictionsSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PodSecurityRestrictionsSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodSecuritySpec) DeepCopyInto(out *PodSecuritySpec) {
+	*out = *in
+	if in.Enforce != nil {
+		in, out := &in.Enforce, &out.Enforce
+		*out = new(PodSecurityStandardSpec)
+		**out = **in
+	}
+	if in.Audit != nil {
+		in, out := &in.Audit, &out.Audit
+		*out = new(PodSecurityStandardSpec)
+		**out = **in
+	}
+	if in.Warn != nil {
+		in, out := &in.Warn, &out.Warn
+		*out = new(PodSecurityStandardSpec)
+		**out = **in
+	}
+	if in.Restrictions != nil {
+		in, out := &in.Restrictions, &out.Restrictions
+		*out = new(PodSecurityRestrictionsSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodSecuritySpec.
+func (in *PodSecuritySpec) DeepCopy() *PodSecuritySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PodSecuritySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodSecurityStandardSpec) DeepCopyInto(out *PodSecurityStandardSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodSecurityStandardSpec.
+func (in *PodSecurityStandardSpec) DeepCopy() *PodSecurityStandardSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PodSecurityStandardSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RegistryMirrorSpec) DeepCopyInto(out *RegistryMirrorSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegistryMirrorSpec.
+func (in *RegistryMirrorSpec) DeepCopy() *RegistryMirrorSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RegistryMirrorSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReservedTaintSpec) DeepCopyInto(out *ReservedTaintSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReservedTaintSpec.
+func (in *ReservedTaintSpec) DeepCopy() *ReservedTaintSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ReservedTaintSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ResourceQuotaSpec) DeepCopyInto(out *ResourceQuotaSpec) {
 	*out = *in
@@ -290,6 +766,16 @@ func (in *ServiceOptions) DeepCopyInto(out *ServiceOptions) {
 		*out = new(ExternalServiceIPsSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.LoadBalancer != nil {
+		in, out := &in.LoadBalancer, &out.LoadBalancer
+		*out = new(LoadBalancerOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NodePort != nil {
+		in, out := &in.NodePort, &out.NodePort
+		*out = new(NodePortOptions)
+		(*in).DeepCopyInto(*out)
+	}
 	in.ForbiddenLabels.DeepCopyInto(&out.ForbiddenLabels)
 	in.ForbiddenAnnotations.DeepCopyInto(&out.ForbiddenAnnotations)
 }
@@ -303,3 +789,38 @@ func (in *ServiceOptions) DeepCopy() *ServiceOptions {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeOptions) DeepCopyInto(out *VolumeOptions) {
+	*out = *in
+	if in.AllowedTypes != nil {
+		in, out := &in.AllowedTypes, &out.AllowedTypes
+		*out = make([]VolumeType, len(*in))
+		copy(*out, *in)
+	}
+	if in.AllowedHostPaths != nil {
+		in, out := &in.AllowedHostPaths, &out.AllowedHostPaths
+		*out = make([]AllowedHostPath, len(*in))
+		copy(*out, *in)
+	}
+	if in.AllowedCSIDrivers != nil {
+		in, out := &in.AllowedCSIDrivers, &out.AllowedCSIDrivers
+		*out = new(AllowedListSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AllowedNFSServers != nil {
+		in, out := &in.AllowedNFSServers, &out.AllowedNFSServers
+		*out = new(AllowedListSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeOptions.
+func (in *VolumeOptions) DeepCopy() *VolumeOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeOptions)
+	in.DeepCopyInto(out)
+	return out
+}
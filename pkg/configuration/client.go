@@ -6,6 +6,7 @@ package configuration
 import (
 	"context"
 	"regexp"
+	"time"
 
 	"github.com/pkg/errors"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -100,3 +101,19 @@ func (c *capsuleConfiguration) ForbiddenUserNodeAnnotations() *capsuleapi.Forbid
 
 	return &c.retrievalFn().Spec.NodeMetadata.ForbiddenAnnotations
 }
+
+func (c *capsuleConfiguration) UsageReportPeriod() time.Duration {
+	if c.retrievalFn().Spec.UsageReport == nil {
+		return 0
+	}
+
+	return c.retrievalFn().Spec.UsageReport.Period.Duration
+}
+
+func (c *capsuleConfiguration) UsageReportHistoryLimit() int {
+	if c.retrievalFn().Spec.UsageReport == nil {
+		return 0
+	}
+
+	return c.retrievalFn().Spec.UsageReport.HistoryLimit
+}
@@ -5,6 +5,7 @@ package configuration
 
 import (
 	"regexp"
+	"time"
 
 	capsuleapi "github.com/projectcapsule/capsule/pkg/api"
 )
@@ -26,4 +27,7 @@ type Configuration interface {
 	UserGroups() []string
 	ForbiddenUserNodeLabels() *capsuleapi.ForbiddenListSpec
 	ForbiddenUserNodeAnnotations() *capsuleapi.ForbiddenListSpec
+	// UsageReportPeriod returns the period of the TenantUsageReport snapshots, zero when disabled.
+	UsageReportPeriod() time.Duration
+	UsageReportHistoryLimit() int
 }
@@ -13,11 +13,14 @@ import (
 	networkingv1beta1 "k8s.io/api/networking/v1beta1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
+	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+	gatewayv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
 
 	capsulev1beta2 "github.com/projectcapsule/capsule/api/v1beta2"
 	"github.com/projectcapsule/capsule/pkg/indexer/ingress"
 	"github.com/projectcapsule/capsule/pkg/indexer/namespace"
 	"github.com/projectcapsule/capsule/pkg/indexer/tenant"
+	"github.com/projectcapsule/capsule/pkg/indexer/tenantquota"
 	"github.com/projectcapsule/capsule/pkg/indexer/tenantresource"
 	"github.com/projectcapsule/capsule/pkg/utils"
 )
@@ -32,12 +35,24 @@ func AddToManager(ctx context.Context, log logr.Logger, mgr manager.Manager) err
 	indexers := []CustomIndexer{
 		tenant.NamespacesReference{Obj: &capsulev1beta2.Tenant{}},
 		tenant.OwnerReference{},
+		tenant.ParentReference{},
+		tenant.ClassReference{},
 		namespace.OwnerReference{},
 		ingress.HostnamePath{Obj: &extensionsv1beta1.Ingress{}},
 		ingress.HostnamePath{Obj: &networkingv1beta1.Ingress{}},
 		ingress.HostnamePath{Obj: &networkingv1.Ingress{}},
+		ingress.HostnamePath{Obj: &gatewayv1beta1.HTTPRoute{}},
+		ingress.HostnamePath{Obj: &gatewayv1alpha2.GRPCRoute{}},
+		ingress.HostnamePath{Obj: &gatewayv1alpha2.TLSRoute{}},
+		ingress.Hostname{Obj: &extensionsv1beta1.Ingress{}},
+		ingress.Hostname{Obj: &networkingv1beta1.Ingress{}},
+		ingress.Hostname{Obj: &networkingv1.Ingress{}},
+		ingress.Hostname{Obj: &gatewayv1beta1.HTTPRoute{}},
+		ingress.Hostname{Obj: &gatewayv1alpha2.GRPCRoute{}},
+		ingress.Hostname{Obj: &gatewayv1alpha2.TLSRoute{}},
 		tenantresource.GlobalProcessedItems{},
 		tenantresource.LocalProcessedItems{},
+		tenantquota.TenantReference{},
 	}
 
 	for _, f := range indexers {
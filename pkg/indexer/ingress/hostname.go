@@ -0,0 +1,38 @@
+// Copyright 2020-2023 Project Capsule Authors.
+// SPDX-License-Identifier: Apache-2.0
+
+package ingress
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	Host = "hostname"
+)
+
+// Hostname indexes the Ingress and the Gateway API routes by the hostnames,
+// regardless of the paths: these are evaluated according to their type.
+type Hostname struct {
+	Obj metav1.Object
+}
+
+//nolint:forcetypeassert
+func (s Hostname) Object() client.Object {
+	return s.Obj.(client.Object)
+}
+
+func (s Hostname) Field() string {
+	return Host
+}
+
+func (s Hostname) Func() client.IndexerFunc {
+	return func(object client.Object) (entries []string) {
+		for host := range hostPathMap(object) {
+			entries = append(entries, host)
+		}
+
+		return
+	}
+}
@@ -4,12 +4,36 @@
 package ingress
 
 import (
+	"strings"
+
 	extensionsv1beta1 "k8s.io/api/extensions/v1beta1"
 	networkingv1 "k8s.io/api/networking/v1"
 	networkingv1beta1 "k8s.io/api/networking/v1beta1"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+	gatewayv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
 )
 
+func hostPathMap(object client.Object) map[string]sets.Set[string] {
+	switch obj := object.(type) {
+	case *networkingv1.Ingress:
+		return hostPathMapForNetworkingV1(obj)
+	case *networkingv1beta1.Ingress:
+		return hostPathMapForNetworkingV1Beta1(obj)
+	case *extensionsv1beta1.Ingress:
+		return hostPathMapForExtensionsV1Beta1(obj)
+	case *gatewayv1beta1.HTTPRoute:
+		return hostPathMapForHTTPRoute(obj)
+	case *gatewayv1alpha2.GRPCRoute:
+		return hostPathMapForGRPCRoute(obj)
+	case *gatewayv1alpha2.TLSRoute:
+		return hostPathMapForTLSRoute(obj)
+	default:
+		return make(map[string]sets.Set[string])
+	}
+}
+
 func hostPathMapForExtensionsV1Beta1(ing *extensionsv1beta1.Ingress) map[string]sets.Set[string] {
 	hostPathMap := make(map[string]sets.Set[string])
 
@@ -69,3 +93,87 @@ func hostPathMapForNetworkingV1(ing *networkingv1.Ingress) map[string]sets.Set[s
 
 	return hostPathMap
 }
+
+func hostPathMapForHTTPRoute(route *gatewayv1beta1.HTTPRoute) map[string]sets.Set[string] {
+	paths := sets.New[string]()
+
+	for _, rule := range route.Spec.Rules {
+		if len(rule.Matches) == 0 {
+			paths.Insert("/")
+		}
+
+		for _, match := range rule.Matches {
+			if match.Path == nil || match.Path.Value == nil {
+				paths.Insert("/")
+
+				continue
+			}
+
+			paths.Insert(*match.Path.Value)
+		}
+	}
+
+	if paths.Len() == 0 {
+		paths.Insert("/")
+	}
+
+	return hostPathMapForRoute(route.Spec.Hostnames, paths)
+}
+
+func hostPathMapForGRPCRoute(route *gatewayv1alpha2.GRPCRoute) map[string]sets.Set[string] {
+	paths := sets.New[string]()
+
+	for _, rule := range route.Spec.Rules {
+		if len(rule.Matches) == 0 {
+			paths.Insert("/")
+		}
+
+		for _, match := range rule.Matches {
+			elems := []string{""}
+
+			if method := match.Method; method != nil {
+				if method.Service != nil && len(*method.Service) > 0 {
+					elems = append(elems, *method.Service)
+				}
+
+				if method.Method != nil && len(*method.Method) > 0 {
+					elems = append(elems, *method.Method)
+				}
+			}
+
+			if len(elems) == 1 {
+				paths.Insert("/")
+
+				continue
+			}
+
+			paths.Insert(strings.Join(elems, "/"))
+		}
+	}
+
+	if paths.Len() == 0 {
+		paths.Insert("/")
+	}
+
+	return hostPathMapForRoute(route.Spec.Hostnames, paths)
+}
+
+func hostPathMapForTLSRoute(route *gatewayv1alpha2.TLSRoute) map[string]sets.Set[string] {
+	return hostPathMapForRoute(route.Spec.Hostnames, sets.New[string](""))
+}
+
+func hostPathMapForRoute(hostnames []gatewayv1beta1.Hostname, paths sets.Set[string]) map[string]sets.Set[string] {
+	hostPathMap := make(map[string]sets.Set[string])
+
+	if len(hostnames) == 0 {
+		hostPathMap[""] = paths
+
+		return hostPathMap
+	}
+
+	for _, hostname := range hostnames {
+		hostPathMap[string(hostname)] = paths.Clone()
+	}
+
+	return hostPathMap
+}
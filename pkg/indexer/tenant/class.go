@@ -0,0 +1,37 @@
+// Copyright 2020-2023 Project Capsule Authors.
+// SPDX-License-Identifier: Apache-2.0
+
+package tenant
+
+import (
+	"fmt"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	capsulev1beta2 "github.com/projectcapsule/capsule/api/v1beta2"
+)
+
+type ClassReference struct{}
+
+func (o ClassReference) Object() client.Object {
+	return &capsulev1beta2.Tenant{}
+}
+
+func (o ClassReference) Field() string {
+	return ".spec.tenantClassName"
+}
+
+func (o ClassReference) Func() client.IndexerFunc {
+	return func(object client.Object) []string {
+		tenant, ok := object.(*capsulev1beta2.Tenant)
+		if !ok {
+			panic(fmt.Errorf("expected type *capsulev1beta2.Tenant, got %T", tenant))
+		}
+
+		if len(tenant.Spec.TenantClassName) == 0 {
+			return nil
+		}
+
+		return []string{tenant.Spec.TenantClassName}
+	}
+}
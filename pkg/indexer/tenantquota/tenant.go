@@ -0,0 +1,32 @@
+// Copyright 2020-2023 Project Capsule Authors.
+// SPDX-License-Identifier: Apache-2.0
+
+package tenantquota
+
+import (
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	capsulev1beta2 "github.com/projectcapsule/capsule/api/v1beta2"
+)
+
+const (
+	IndexerFieldName = ".spec.tenantName"
+)
+
+type TenantReference struct{}
+
+func (t TenantReference) Object() client.Object {
+	return &capsulev1beta2.TenantQuota{}
+}
+
+func (t TenantReference) Field() string {
+	return IndexerFieldName
+}
+
+func (t TenantReference) Func() client.IndexerFunc {
+	return func(object client.Object) []string {
+		tq := object.(*capsulev1beta2.TenantQuota) //nolint:forcetypeassert
+
+		return []string{tq.Spec.TenantName}
+	}
+}
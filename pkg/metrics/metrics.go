@@ -0,0 +1,25 @@
+// Copyright 2020-2023 Project Capsule Authors.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package metrics contains the Capsule Prometheus collectors, registered in the controller-runtime metrics registry
+// and served by the manager metrics server.
+package metrics
+
+const (
+	metricsNamespace = "capsule"
+
+	tenantSubsystem    = "tenant"
+	webhookSubsystem   = "webhook"
+	resourcesSubsystem = "resources"
+
+	tenantLabel    = "tenant"
+	indexLabel     = "index"
+	resourceLabel  = "resource"
+	pathLabel      = "path"
+	handlerLabel   = "handler"
+	operationLabel = "operation"
+	outcomeLabel   = "outcome"
+	reasonLabel    = "reason"
+	kindLabel      = "kind"
+	modeLabel      = "mode"
+)
@@ -0,0 +1,31 @@
+// Copyright 2020-2023 Project Capsule Authors.
+// SPDX-License-Identifier: Apache-2.0
+
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const (
+	OperationPruned = "pruned"
+	OperationFailed = "failed"
+)
+
+var replicatedObjects = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Namespace: metricsNamespace,
+	Subsystem: resourcesSubsystem,
+	Name:      "replicated_objects_total",
+	Help:      "Objects processed by the TenantResource and GlobalTenantResource controllers, by kind and operation.",
+}, []string{kindLabel, operationLabel})
+
+func init() {
+	metrics.Registry.MustRegister(replicatedObjects)
+}
+
+// ObserveReplicatedObject keeps track of a replicated object: the operation is the result of the create or update,
+// or the pruning, or the failure of it.
+func ObserveReplicatedObject(kind, operation string) {
+	replicatedObjects.WithLabelValues(kind, operation).Inc()
+}
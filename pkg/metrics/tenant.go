@@ -0,0 +1,171 @@
+// Copyright 2020-2023 Project Capsule Authors.
+// SPDX-License-Identifier: Apache-2.0
+
+package metrics
+
+import (
+	"strconv"
+
+	"github.com/prometheus/client_golang/prometheus"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	capsulev1beta2 "github.com/projectcapsule/capsule/api/v1beta2"
+)
+
+var (
+	tenantUsageCPU = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Subsystem: tenantSubsystem,
+		Name:      "usage_cpu_requests_cores",
+		Help:      "CPU requested by the running Pods across the Tenant Namespaces.",
+	}, []string{tenantLabel})
+	tenantUsageMemory = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Subsystem: tenantSubsystem,
+		Name:      "usage_memory_requests_bytes",
+		Help:      "Memory requested by the running Pods across the Tenant Namespaces.",
+	}, []string{tenantLabel})
+	tenantUsageStorage = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Subsystem: tenantSubsystem,
+		Name:      "usage_storage_requests_bytes",
+		Help:      "Storage requested by the PersistentVolumeClaims across the Tenant Namespaces.",
+	}, []string{tenantLabel})
+	tenantUsagePods = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Subsystem: tenantSubsystem,
+		Name:      "usage_pods",
+		Help:      "Running Pods across the Tenant Namespaces.",
+	}, []string{tenantLabel})
+	tenantUsageLoadBalancers = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Subsystem: tenantSubsystem,
+		Name:      "usage_loadbalancers",
+		Help:      "Services of type LoadBalancer across the Tenant Namespaces.",
+	}, []string{tenantLabel})
+	tenantUsagePersistentVolumeClaims = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Subsystem: tenantSubsystem,
+		Name:      "usage_persistentvolumeclaims",
+		Help:      "PersistentVolumeClaims across the Tenant Namespaces.",
+	}, []string{tenantLabel})
+	tenantNamespaces = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Subsystem: tenantSubsystem,
+		Name:      "namespaces",
+		Help:      "Namespaces assigned to the Tenant.",
+	}, []string{tenantLabel})
+	tenantNamespacesQuota = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Subsystem: tenantSubsystem,
+		Name:      "namespaces_quota",
+		Help:      "Maximum amount of Namespaces allowed for the Tenant, not reported when unlimited.",
+	}, []string{tenantLabel})
+	tenantCordoned = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Subsystem: tenantSubsystem,
+		Name:      "cordoned",
+		Help:      "Whether the Tenant is cordoned.",
+	}, []string{tenantLabel})
+	tenantResourceQuotaUsed = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Subsystem: tenantSubsystem,
+		Name:      "resourcequota_used",
+		Help:      "Resources used across the Tenant Namespaces for the Tenant-scoped ResourceQuota items.",
+	}, []string{tenantLabel, indexLabel, resourceLabel})
+	tenantResourceQuotaHard = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Subsystem: tenantSubsystem,
+		Name:      "resourcequota_hard",
+		Help:      "Hard limits of the Tenant-scoped ResourceQuota items.",
+	}, []string{tenantLabel, indexLabel, resourceLabel})
+)
+
+func init() {
+	metrics.Registry.MustRegister(
+		tenantUsageCPU,
+		tenantUsageMemory,
+		tenantUsageStorage,
+		tenantUsagePods,
+		tenantUsageLoadBalancers,
+		tenantUsagePersistentVolumeClaims,
+		tenantNamespaces,
+		tenantNamespacesQuota,
+		tenantCordoned,
+		tenantResourceQuotaUsed,
+		tenantResourceQuotaHard,
+	)
+}
+
+// SetTenantUsage exposes the consolidated usage of the given Tenant.
+func SetTenantUsage(tenant string, usage capsulev1beta2.TenantUsage) {
+	tenantUsageCPU.WithLabelValues(tenant).Set(usage.CPU.AsApproximateFloat64())
+	tenantUsageMemory.WithLabelValues(tenant).Set(usage.Memory.AsApproximateFloat64())
+	tenantUsageStorage.WithLabelValues(tenant).Set(usage.Storage.AsApproximateFloat64())
+	tenantUsagePods.WithLabelValues(tenant).Set(float64(usage.Pods))
+	tenantUsageLoadBalancers.WithLabelValues(tenant).Set(float64(usage.LoadBalancers))
+	tenantUsagePersistentVolumeClaims.WithLabelValues(tenant).Set(float64(usage.PersistentVolumeClaims))
+}
+
+// DeleteTenantUsage removes the usage series of the given Tenant, upon its deletion.
+func DeleteTenantUsage(tenant string) {
+	for _, gauge := range []*prometheus.GaugeVec{
+		tenantUsageCPU,
+		tenantUsageMemory,
+		tenantUsageStorage,
+		tenantUsagePods,
+		tenantUsageLoadBalancers,
+		tenantUsagePersistentVolumeClaims,
+	} {
+		gauge.DeleteLabelValues(tenant)
+	}
+}
+
+// SetTenantState exposes the Namespaces count, along with its quota, and the cordoning state of the given Tenant.
+func SetTenantState(tnt *capsulev1beta2.Tenant) {
+	tenantNamespaces.WithLabelValues(tnt.GetName()).Set(float64(tnt.Status.Size))
+
+	if quota := tnt.Spec.NamespaceOptions; quota != nil && quota.Quota != nil {
+		tenantNamespacesQuota.WithLabelValues(tnt.GetName()).Set(float64(*quota.Quota))
+	} else {
+		tenantNamespacesQuota.DeleteLabelValues(tnt.GetName())
+	}
+
+	var cordoned float64
+	if tnt.Spec.Cordoned {
+		cordoned = 1
+	}
+
+	tenantCordoned.WithLabelValues(tnt.GetName()).Set(cordoned)
+}
+
+// SetTenantResourceQuotas exposes the used and hard amounts of the Tenant-scoped ResourceQuota items of the given Tenant,
+// mapped by item index: the previous series are removed, since items or resources could have been removed.
+func SetTenantResourceQuotas(tenant string, used, hard []corev1.ResourceList) {
+	for _, gauge := range []*prometheus.GaugeVec{tenantResourceQuotaUsed, tenantResourceQuotaHard} {
+		gauge.DeletePartialMatch(prometheus.Labels{tenantLabel: tenant})
+	}
+
+	for index := range hard {
+		for name, quantity := range hard[index] {
+			value := used[index][name]
+
+			tenantResourceQuotaUsed.WithLabelValues(tenant, strconv.Itoa(index), name.String()).Set(value.AsApproximateFloat64())
+			tenantResourceQuotaHard.WithLabelValues(tenant, strconv.Itoa(index), name.String()).Set(quantity.AsApproximateFloat64())
+		}
+	}
+}
+
+// DeleteTenantState removes the state series of the given Tenant, upon its deletion.
+func DeleteTenantState(tenant string) {
+	for _, gauge := range []*prometheus.GaugeVec{
+		tenantNamespaces,
+		tenantNamespacesQuota,
+		tenantCordoned,
+		tenantResourceQuotaUsed,
+		tenantResourceQuotaHard,
+	} {
+		gauge.DeletePartialMatch(prometheus.Labels{tenantLabel: tenant})
+	}
+}
@@ -0,0 +1,59 @@
+// Copyright 2020-2023 Project Capsule Authors.
+// SPDX-License-Identifier: Apache-2.0
+
+package metrics
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const (
+	OutcomeAllowed = "allowed"
+	OutcomeDenied  = "denied"
+)
+
+var (
+	webhookRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Subsystem: webhookSubsystem,
+		Name:      "requests_total",
+		Help:      "Admission requests handled by the Capsule webhooks, along with the handler responding and the denial reason.",
+	}, []string{pathLabel, handlerLabel, operationLabel, outcomeLabel, reasonLabel})
+	webhookRequestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: metricsNamespace,
+		Subsystem: webhookSubsystem,
+		Name:      "request_duration_seconds",
+		Help:      "Time spent handling the admission requests by the Capsule webhooks.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{pathLabel, handlerLabel, operationLabel, outcomeLabel})
+	webhookPolicyViolations = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Subsystem: webhookSubsystem,
+		Name:      "policy_violations_total",
+		Help:      "Policy violations detected by the Capsule webhooks, along with the Tenant enforcement mode applied.",
+	}, []string{pathLabel, handlerLabel, reasonLabel, modeLabel})
+)
+
+func init() {
+	metrics.Registry.MustRegister(
+		webhookRequests,
+		webhookRequestDuration,
+		webhookPolicyViolations,
+	)
+}
+
+// ObserveWebhookRequest keeps track of an admission request: the handler is the one responding to the request,
+// empty if none of them did, and the reason is set only for denied requests.
+func ObserveWebhookRequest(path, handler, operation, outcome, reason string, duration time.Duration) {
+	webhookRequests.WithLabelValues(path, handler, operation, outcome, reason).Inc()
+	webhookRequestDuration.WithLabelValues(path, handler, operation, outcome).Observe(duration.Seconds())
+}
+
+// ObservePolicyViolation keeps track of a policy violation, identified by the reason of the event emitted by the handler:
+// the mode is the one enforced for the Tenant of the request.
+func ObservePolicyViolation(path, handler, reason, mode string) {
+	webhookPolicyViolations.WithLabelValues(path, handler, reason, mode).Inc()
+}
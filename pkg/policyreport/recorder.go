@@ -0,0 +1,123 @@
+// Copyright 2020-2023 Project Capsule Authors.
+// SPDX-License-Identifier: Apache-2.0
+
+package policyreport
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	capsulev1beta2 "github.com/projectcapsule/capsule/api/v1beta2"
+)
+
+// defaultFlushPeriod is the interval the violations detected by the admission webhooks are written to the reports.
+const defaultFlushPeriod = 5 * time.Second
+
+// Recorder keeps track of the violations detected by the admission webhooks, writing them to the TenantPolicyReport
+// of each Tenant in batches: admission requests are not waiting for the reports to be updated.
+// It's meant to be added to the manager, running on all the replicas serving the webhooks.
+type Recorder struct {
+	client client.Client
+	log    logr.Logger
+	period time.Duration
+
+	mu      sync.Mutex
+	pending map[string][]capsulev1beta2.PolicyReportResult
+}
+
+func NewRecorder(c client.Client, log logr.Logger) *Recorder {
+	return &Recorder{
+		client:  c,
+		log:     log,
+		period:  defaultFlushPeriod,
+		pending: make(map[string][]capsulev1beta2.PolicyReportResult),
+	}
+}
+
+// Record queues the given admission result for the report of the Tenant.
+func (r *Recorder) Record(tenant string, result capsulev1beta2.PolicyReportResult) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	results := r.pending[tenant]
+	// Upon a write failure results are kept, thus discarding the oldest ones to bound the memory usage
+	if len(results) >= capsulev1beta2.TenantPolicyReportMaxAdmissionResults {
+		results = results[1:]
+	}
+
+	r.pending[tenant] = append(results, result)
+}
+
+func (r *Recorder) Start(ctx context.Context) error {
+	ticker := time.NewTicker(r.period)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			// Flushing the last results with a fresh context, since the manager one is already canceled
+			flushCtx, cancel := context.WithTimeout(context.Background(), r.period)
+			r.flush(flushCtx)
+			cancel()
+
+			return nil
+		case <-ticker.C:
+			r.flush(ctx)
+		}
+	}
+}
+
+func (r *Recorder) NeedLeaderElection() bool {
+	return false
+}
+
+func (r *Recorder) flush(ctx context.Context) {
+	r.mu.Lock()
+	pending := r.pending
+	r.pending = make(map[string][]capsulev1beta2.PolicyReportResult)
+	r.mu.Unlock()
+
+	for tenant, results := range pending {
+		if err := r.write(ctx, tenant, results); err != nil {
+			r.log.Error(err, "Cannot update the TenantPolicyReport", "tenant", tenant)
+
+			r.requeue(tenant, results)
+		}
+	}
+}
+
+func (r *Recorder) write(ctx context.Context, tenant string, results []capsulev1beta2.PolicyReportResult) error {
+	tnt := &capsulev1beta2.Tenant{}
+	if err := r.client.Get(ctx, types.NamespacedName{Name: tenant}, tnt); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+
+		return err
+	}
+
+	return Update(ctx, r.client, tnt, func(report *capsulev1beta2.TenantPolicyReport) {
+		for _, result := range results {
+			report.AddAdmissionResult(result)
+		}
+	})
+}
+
+// requeue keeps the results not written for the next flush, before the ones recorded in the meanwhile.
+func (r *Recorder) requeue(tenant string, results []capsulev1beta2.PolicyReportResult) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	results = append(results, r.pending[tenant]...)
+	if excess := len(results) - capsulev1beta2.TenantPolicyReportMaxAdmissionResults; excess > 0 {
+		results = results[excess:]
+	}
+
+	r.pending[tenant] = results
+}
@@ -0,0 +1,50 @@
+// Copyright 2020-2023 Project Capsule Authors.
+// SPDX-License-Identifier: Apache-2.0
+
+package policyreport
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	capsulev1beta2 "github.com/projectcapsule/capsule/api/v1beta2"
+)
+
+func TestRecorder_Flush(t *testing.T) {
+	scheme := runtime.NewScheme()
+	assert.NoError(t, capsulev1beta2.AddToScheme(scheme))
+
+	c := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(&capsulev1beta2.Tenant{ObjectMeta: metav1.ObjectMeta{Name: "oil", UID: "oil-uid"}}).
+		Build()
+
+	recorder := NewRecorder(c, logr.Discard())
+
+	recorder.Record("oil", capsulev1beta2.PolicyReportResult{Policy: "pods", Rule: "ForbiddenContainerRegistry", Result: capsulev1beta2.PolicyResultFail})
+	recorder.Record("oil", capsulev1beta2.PolicyReportResult{Policy: "services", Rule: "ForbiddenNodePort", Result: capsulev1beta2.PolicyResultWarn})
+	// Results of deleted Tenants are discarded
+	recorder.Record("gas", capsulev1beta2.PolicyReportResult{Policy: "pods", Rule: "ForbiddenContainerRegistry", Result: capsulev1beta2.PolicyResultFail})
+
+	recorder.flush(context.Background())
+
+	assert.Empty(t, recorder.pending)
+
+	report := &capsulev1beta2.TenantPolicyReport{}
+	assert.NoError(t, c.Get(context.Background(), types.NamespacedName{Name: "oil"}, report))
+
+	assert.Len(t, report.Results, 2)
+	assert.Equal(t, capsulev1beta2.PolicyReportSummary{Fail: 1, Warn: 1}, report.Summary)
+	assert.Equal(t, "Tenant", report.Scope.Kind)
+	assert.Equal(t, types.UID("oil-uid"), report.GetOwnerReferences()[0].UID)
+
+	err := c.Get(context.Background(), types.NamespacedName{Name: "gas"}, &capsulev1beta2.TenantPolicyReport{})
+	assert.Error(t, err)
+}
@@ -0,0 +1,46 @@
+// Copyright 2020-2023 Project Capsule Authors.
+// SPDX-License-Identifier: Apache-2.0
+
+package policyreport
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	capsulev1beta2 "github.com/projectcapsule/capsule/api/v1beta2"
+)
+
+// Update applies the given function to the TenantPolicyReport of the Tenant, creating it if missing:
+// the report is named after the Tenant, and it's garbage collected upon its deletion.
+func Update(ctx context.Context, c client.Client, tnt *capsulev1beta2.Tenant, fn func(report *capsulev1beta2.TenantPolicyReport)) error {
+	return retry.OnError(retry.DefaultBackoff, func(err error) bool {
+		return apierrors.IsConflict(err) || apierrors.IsAlreadyExists(err)
+	}, func() error {
+		report := &capsulev1beta2.TenantPolicyReport{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: tnt.GetName(),
+			},
+		}
+
+		_, err := controllerutil.CreateOrUpdate(ctx, c, report, func() error {
+			report.Scope = &corev1.ObjectReference{
+				APIVersion: capsulev1beta2.GroupVersion.String(),
+				Kind:       "Tenant",
+				Name:       tnt.GetName(),
+				UID:        tnt.GetUID(),
+			}
+
+			fn(report)
+
+			return controllerutil.SetControllerReference(tnt, report, c.Scheme())
+		})
+
+		return err
+	})
+}
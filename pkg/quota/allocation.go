@@ -0,0 +1,127 @@
+// Copyright 2020-2023 Project Capsule Authors.
+// SPDX-License-Identifier: Apache-2.0
+
+package quota
+
+import (
+	"fmt"
+	"sort"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/types"
+
+	capsulev1beta2 "github.com/projectcapsule/capsule/api/v1beta2"
+	"github.com/projectcapsule/capsule/pkg/api"
+)
+
+// Allocations maps the resources reserved to the Tenant Namespaces, by Tenant ResourceQuota item index and Namespace name.
+type Allocations map[int]map[string]corev1.ResourceList
+
+// Allocated returns the amount of the given resource reserved to the Namespace, if any.
+func (a Allocations) Allocated(index int, namespace string, name corev1.ResourceName) (resource.Quantity, bool) {
+	quantity, ok := a[index][namespace][name]
+
+	return quantity, ok
+}
+
+// Shared returns the amount of the given resource left to the Namespaces with no allocation.
+func (a Allocations) Shared(index int, name corev1.ResourceName, hard resource.Quantity) resource.Quantity {
+	shared := hard.DeepCopy()
+
+	for _, allocated := range a[index] {
+		if quantity, ok := allocated[name]; ok {
+			shared.Sub(quantity)
+		}
+	}
+
+	return shared
+}
+
+// sharing returns the filter of the Namespaces sharing the budget of the given resource with the provided Namespace:
+// a Namespace with an allocation is on its own, while the other ones are sharing the remaining Tenant budget.
+func (a Allocations) sharing(index int, namespace string, name corev1.ResourceName) func(string) bool {
+	if _, ok := a.Allocated(index, namespace, name); ok {
+		return func(ns string) bool {
+			return ns == namespace
+		}
+	}
+
+	return func(ns string) bool {
+		_, ok := a.Allocated(index, ns, name)
+
+		return !ok
+	}
+}
+
+// Allocate validates the ResourceQuotaAllocation resources against the Tenant-scoped ResourceQuota items,
+// returning the accepted allocations along with the reason of the rejected ones.
+// Allocations are accepted in order of creation as long as they fit the Tenant budget:
+// a newer allocation cannot evict an older one, although the Namespace allocations are summed.
+func Allocate(spec api.ResourceQuotaSpec, items []capsulev1beta2.ResourceQuotaAllocation) (Allocations, map[types.NamespacedName]error) {
+	sorted := make([]capsulev1beta2.ResourceQuotaAllocation, len(items))
+	copy(sorted, items)
+
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if left, right := sorted[i].GetCreationTimestamp(), sorted[j].GetCreationTimestamp(); !left.Equal(&right) {
+			return left.Before(&right)
+		}
+
+		if sorted[i].GetNamespace() != sorted[j].GetNamespace() {
+			return sorted[i].GetNamespace() < sorted[j].GetNamespace()
+		}
+
+		return sorted[i].GetName() < sorted[j].GetName()
+	})
+
+	allocations, rejected := Allocations{}, map[types.NamespacedName]error{}
+
+	for _, item := range sorted {
+		if err := allocations.allocate(spec, item); err != nil {
+			rejected[types.NamespacedName{Namespace: item.GetNamespace(), Name: item.GetName()}] = err
+		}
+	}
+
+	return allocations, rejected
+}
+
+func (a Allocations) allocate(spec api.ResourceQuotaSpec, item capsulev1beta2.ResourceQuotaAllocation) error {
+	if spec.Scope != api.ResourceQuotaScopeTenant {
+		return NewAllocationInvalidError("the Tenant ResourceQuota items are not Tenant-scoped")
+	}
+
+	index := item.Spec.Index
+
+	if index < 0 || index >= len(spec.Items) {
+		return NewAllocationInvalidError(fmt.Sprintf("the Tenant has no ResourceQuota item #%d", index))
+	}
+
+	hard := spec.Items[index].Hard
+
+	for name, requested := range item.Spec.Hard {
+		if requested.Sign() <= 0 {
+			return NewAllocationInvalidError(fmt.Sprintf("the reserved quantity of the resource %s must be positive", name))
+		}
+
+		limit, ok := hard[name]
+		if !ok {
+			return NewAllocationInvalidError(fmt.Sprintf("the resource %s is not limited by the Tenant ResourceQuota item #%d", name, index))
+		}
+
+		if available := a.Shared(index, name, limit); requested.Cmp(available) > 0 {
+			return NewAllocationExceededError(index, name, requested, available)
+		}
+	}
+
+	if _, ok := a[index]; !ok {
+		a[index] = map[string]corev1.ResourceList{}
+	}
+
+	if _, ok := a[index][item.GetNamespace()]; !ok {
+		a[index][item.GetNamespace()] = corev1.ResourceList{}
+	}
+
+	addResources(a[index][item.GetNamespace()], item.Spec.Hard)
+
+	return nil
+}
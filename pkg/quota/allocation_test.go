@@ -0,0 +1,89 @@
+// Copyright 2020-2023 Project Capsule Authors.
+// SPDX-License-Identifier: Apache-2.0
+
+package quota
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	capsulev1beta2 "github.com/projectcapsule/capsule/api/v1beta2"
+	"github.com/projectcapsule/capsule/pkg/api"
+)
+
+func TestAllocate(t *testing.T) {
+	created := time.Date(2023, 10, 1, 10, 0, 0, 0, time.UTC)
+
+	allocation := func(namespace, name string, index int, minutes int, cpu string) capsulev1beta2.ResourceQuotaAllocation {
+		return capsulev1beta2.ResourceQuotaAllocation{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              name,
+				Namespace:         namespace,
+				CreationTimestamp: metav1.NewTime(created.Add(time.Duration(minutes) * time.Minute)),
+			},
+			Spec: capsulev1beta2.ResourceQuotaAllocationSpec{
+				Index: index,
+				Hard:  corev1.ResourceList{corev1.ResourceLimitsCPU: resource.MustParse(cpu)},
+			},
+		}
+	}
+
+	spec := api.ResourceQuotaSpec{
+		Scope: api.ResourceQuotaScopeTenant,
+		Items: []corev1.ResourceQuotaSpec{
+			{Hard: corev1.ResourceList{corev1.ResourceLimitsCPU: resource.MustParse("10")}},
+		},
+	}
+
+	items := []capsulev1beta2.ResourceQuotaAllocation{
+		// Newer allocation not fitting the remaining budget
+		allocation("oil-development", "cpu", 0, 2, "4"),
+		allocation("oil-production", "cpu", 0, 0, "6"),
+		// Allocations of the same Namespace are summed
+		allocation("oil-production", "more-cpu", 0, 1, "1"),
+		allocation("oil-production", "unknown-item", 1, 3, "1"),
+		// Non-positive allocations would grow the shared budget
+		allocation("oil-staging", "negative-cpu", 0, 5, "-4"),
+		allocation("oil-staging", "zero-cpu", 0, 6, "0"),
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "unknown-resource", Namespace: "oil-production", CreationTimestamp: metav1.NewTime(created.Add(4 * time.Minute))},
+			Spec: capsulev1beta2.ResourceQuotaAllocationSpec{
+				Hard: corev1.ResourceList{corev1.ResourcePods: resource.MustParse("1")},
+			},
+		},
+	}
+
+	allocations, rejected := Allocate(spec, items)
+
+	allocated, ok := allocations.Allocated(0, "oil-production", corev1.ResourceLimitsCPU)
+	assert.True(t, ok)
+	assert.Equal(t, "7", allocated.String())
+
+	_, ok = allocations.Allocated(0, "oil-development", corev1.ResourceLimitsCPU)
+	assert.False(t, ok)
+
+	shared := allocations.Shared(0, corev1.ResourceLimitsCPU, spec.Items[0].Hard[corev1.ResourceLimitsCPU])
+	assert.Equal(t, "3", shared.String())
+
+	_, ok = allocations.Allocated(0, "oil-staging", corev1.ResourceLimitsCPU)
+	assert.False(t, ok)
+
+	assert.Len(t, rejected, 5)
+	assert.True(t, IsAllocationExceeded(rejected[types.NamespacedName{Namespace: "oil-development", Name: "cpu"}]))
+	assert.Error(t, rejected[types.NamespacedName{Namespace: "oil-production", Name: "unknown-item"}])
+	assert.False(t, IsAllocationExceeded(rejected[types.NamespacedName{Namespace: "oil-production", Name: "unknown-resource"}]))
+	assert.Error(t, rejected[types.NamespacedName{Namespace: "oil-staging", Name: "negative-cpu"}])
+	assert.Error(t, rejected[types.NamespacedName{Namespace: "oil-staging", Name: "zero-cpu"}])
+	// Allocations are not allowed when the budget is replicated in each Namespace
+	spec.Scope = api.ResourceQuotaScopeNamespace
+
+	allocations, rejected = Allocate(spec, items)
+	assert.Empty(t, allocations)
+	assert.Len(t, rejected, len(items))
+}
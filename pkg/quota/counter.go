@@ -0,0 +1,143 @@
+// Copyright 2020-2023 Project Capsule Authors.
+// SPDX-License-Identifier: Apache-2.0
+
+package quota
+
+import (
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	capsulev1beta2 "github.com/projectcapsule/capsule/api/v1beta2"
+)
+
+type counterKey struct {
+	quota     string
+	groupKind schema.GroupKind
+}
+
+type counterDelta struct {
+	timestamp time.Time
+	delta     int64
+}
+
+// Counter keeps track of the objects admitted, and deleted, for the TenantQuota resources since their last count:
+// the used amount is the one reported in the TenantQuota status, along with the changes happened afterwards,
+// thus admission doesn't need to update any object.
+type Counter struct {
+	mu     sync.Mutex
+	deltas map[counterKey][]counterDelta
+	now    func() time.Time
+}
+
+func NewCounter() *Counter {
+	return &Counter{
+		deltas: make(map[counterKey][]counterDelta),
+		now:    time.Now,
+	}
+}
+
+// Reserve checks if a new object of the given kind is fitting the TenantQuota, keeping track of it unless it's a dry-run.
+func (c *Counter) Reserve(tq *capsulev1beta2.TenantQuota, gk schema.GroupKind, dryRun bool) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	res, ok := limitedResource(tq, gk)
+	if !ok {
+		return nil
+	}
+
+	if used := tq.GetUsed(gk) + c.pending(tq, gk); used >= res.Hard {
+		return NewTenantQuotaLimitReachedError(tq.Spec.TenantName, gk, res.Hard)
+	}
+
+	if !dryRun {
+		c.track(tq, gk, 1)
+	}
+
+	return nil
+}
+
+// ReserveAll checks if a new object of the given kind is fitting all the given TenantQuota resources, keeping track of it
+// in each of them unless it's a dry-run: nothing is tracked when any of them is exceeded, which is returned along with the error.
+func (c *Counter) ReserveAll(tqs []capsulev1beta2.TenantQuota, gk schema.GroupKind, dryRun bool) (*capsulev1beta2.TenantQuota, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	limited := make([]*capsulev1beta2.TenantQuota, 0, len(tqs))
+
+	for i := range tqs {
+		tq := &tqs[i]
+
+		res, ok := limitedResource(tq, gk)
+		if !ok {
+			continue
+		}
+
+		if used := tq.GetUsed(gk) + c.pending(tq, gk); used >= res.Hard {
+			return tq, NewTenantQuotaLimitReachedError(tq.Spec.TenantName, gk, res.Hard)
+		}
+
+		limited = append(limited, tq)
+	}
+
+	if !dryRun {
+		for _, tq := range limited {
+			c.track(tq, gk, 1)
+		}
+	}
+
+	return nil, nil
+}
+
+// Release keeps track of a deleted object of the given kind.
+func (c *Counter) Release(tq *capsulev1beta2.TenantQuota, gk schema.GroupKind) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := limitedResource(tq, gk); ok {
+		// Pruning the changes already counted before tracking the new one
+		c.pending(tq, gk)
+		c.track(tq, gk, -1)
+	}
+}
+
+func limitedResource(tq *capsulev1beta2.TenantQuota, gk schema.GroupKind) (capsulev1beta2.TenantQuotaResource, bool) {
+	for _, res := range tq.Spec.Resources {
+		if res.GroupKind() == gk {
+			return res, true
+		}
+	}
+
+	return capsulev1beta2.TenantQuotaResource{}, false
+}
+
+func (c *Counter) track(tq *capsulev1beta2.TenantQuota, gk schema.GroupKind, delta int64) {
+	key := counterKey{quota: tq.GetName(), groupKind: gk}
+
+	c.deltas[key] = append(c.deltas[key], counterDelta{timestamp: c.now(), delta: delta})
+}
+
+// pending returns the changes happened since the last count, pruning the ones already counted.
+func (c *Counter) pending(tq *capsulev1beta2.TenantQuota, gk schema.GroupKind) (pending int64) {
+	key := counterKey{quota: tq.GetName(), groupKind: gk}
+	// The count time is serialized with seconds precision:
+	// the changes happened in the same second are still considered, at the cost of being too restrictive.
+	lastCount := tq.Status.LastCountTime.Time
+
+	deltas := make([]counterDelta, 0, len(c.deltas[key]))
+
+	for _, d := range c.deltas[key] {
+		if d.timestamp.Before(lastCount) {
+			continue
+		}
+
+		deltas = append(deltas, d)
+		pending += d.delta
+	}
+
+	c.deltas[key] = deltas
+
+	return pending
+}
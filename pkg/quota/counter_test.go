@@ -0,0 +1,96 @@
+// Copyright 2020-2023 Project Capsule Authors.
+// SPDX-License-Identifier: Apache-2.0
+
+package quota
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	capsulev1beta2 "github.com/projectcapsule/capsule/api/v1beta2"
+)
+
+func TestCounter_Reserve(t *testing.T) {
+	now := time.Date(2023, 10, 1, 10, 0, 0, 0, time.UTC)
+
+	mysql := capsulev1beta2.TenantQuotaResource{Group: "databases.acme.corp", Version: "v1", Kind: "MySQL", Hard: 2}
+
+	tq := &capsulev1beta2.TenantQuota{
+		ObjectMeta: metav1.ObjectMeta{Name: "oil"},
+		Spec: capsulev1beta2.TenantQuotaSpec{
+			TenantName: "oil",
+			Resources:  []capsulev1beta2.TenantQuotaResource{mysql},
+		},
+		Status: capsulev1beta2.TenantQuotaStatus{
+			LastCountTime: metav1.NewTime(now),
+			Resources:     []capsulev1beta2.TenantQuotaResourceStatus{{TenantQuotaResource: mysql, Used: 1}},
+		},
+	}
+
+	counter := NewCounter()
+	counter.now = func() time.Time { return now.Add(time.Second) }
+
+	gk := mysql.GroupKind()
+
+	assert.NoError(t, counter.Reserve(tq, schema.GroupKind{Kind: "ConfigMap"}, false))
+	assert.NoError(t, counter.Reserve(tq, gk, true))
+	assert.NoError(t, counter.Reserve(tq, gk, false))
+	assert.True(t, IsTenantQuotaLimitReached(counter.Reserve(tq, gk, false)))
+	// Deleted objects are freeing the quota before the next count
+	counter.Release(tq, gk)
+	assert.NoError(t, counter.Reserve(tq, gk, false))
+	// Changes happened before the last count are not considered anymore
+	tq.Status.LastCountTime = metav1.NewTime(now.Add(time.Minute))
+	tq.Status.Resources[0].Used = 1
+	counter.now = func() time.Time { return now.Add(2 * time.Minute) }
+
+	assert.NoError(t, counter.Reserve(tq, gk, false))
+	assert.True(t, IsTenantQuotaLimitReached(counter.Reserve(tq, gk, false)))
+}
+
+func TestCounter_ReserveAll(t *testing.T) {
+	now := time.Date(2023, 10, 1, 10, 0, 0, 0, time.UTC)
+
+	mysql := capsulev1beta2.TenantQuotaResource{Group: "databases.acme.corp", Version: "v1", Kind: "MySQL", Hard: 2}
+
+	tq := func(name string, hard, used int64) capsulev1beta2.TenantQuota {
+		res := mysql
+		res.Hard = hard
+
+		return capsulev1beta2.TenantQuota{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec: capsulev1beta2.TenantQuotaSpec{
+				TenantName: "oil",
+				Resources:  []capsulev1beta2.TenantQuotaResource{res},
+			},
+			Status: capsulev1beta2.TenantQuotaStatus{
+				LastCountTime: metav1.NewTime(now),
+				Resources:     []capsulev1beta2.TenantQuotaResourceStatus{{TenantQuotaResource: res, Used: used}},
+			},
+		}
+	}
+
+	counter := NewCounter()
+	counter.now = func() time.Time { return now.Add(time.Second) }
+
+	gk := mysql.GroupKind()
+
+	tqs := []capsulev1beta2.TenantQuota{tq("large", 10, 1), tq("small", 2, 2)}
+
+	exceeded, err := counter.ReserveAll(tqs, gk, false)
+	assert.True(t, IsTenantQuotaLimitReached(err))
+	assert.Equal(t, "small", exceeded.GetName())
+	// Nothing is tracked when any TenantQuota is exceeded
+	assert.Equal(t, int64(0), counter.pending(&tqs[0], gk))
+
+	tqs[1].Status.Resources[0].Used = 1
+
+	_, err = counter.ReserveAll(tqs, gk, false)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), counter.pending(&tqs[0], gk))
+	assert.Equal(t, int64(1), counter.pending(&tqs[1], gk))
+}
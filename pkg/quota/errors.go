@@ -0,0 +1,119 @@
+// Copyright 2020-2023 Project Capsule Authors.
+// SPDX-License-Identifier: Apache-2.0
+
+package quota
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+type tenantQuotaExceededError struct {
+	tenant    string
+	index     int
+	name      corev1.ResourceName
+	requested resource.Quantity
+	used      resource.Quantity
+	hard      resource.Quantity
+}
+
+func NewTenantQuotaExceededError(tenant string, index int, name corev1.ResourceName, requested, used, hard resource.Quantity) error {
+	return &tenantQuotaExceededError{
+		tenant:    tenant,
+		index:     index,
+		name:      name,
+		requested: requested,
+		used:      used,
+		hard:      hard,
+	}
+}
+
+func (e tenantQuotaExceededError) Error() string {
+	return fmt.Sprintf("exceeded quota of Tenant %s for the ResourceQuota item #%d: requested %s=%s, used %s=%s, limited %s=%s", e.tenant, e.index, e.name, e.requested.String(), e.name, e.used.String(), e.name, e.hard.String())
+}
+
+type tenantQuotaConflictError struct {
+	tenant string
+}
+
+func NewTenantQuotaConflictError(tenant string) error {
+	return &tenantQuotaConflictError{tenant: tenant}
+}
+
+func (e tenantQuotaConflictError) Error() string {
+	return fmt.Sprintf("cannot reserve the requested resources for the Tenant %s due to concurrent requests, please retry", e.tenant)
+}
+
+// IsTenantQuotaExceeded returns true if the error is reporting a Tenant quota violation.
+func IsTenantQuotaExceeded(err error) bool {
+	_, ok := err.(*tenantQuotaExceededError) //nolint:errorlint
+
+	return ok
+}
+
+type tenantQuotaLimitReachedError struct {
+	tenant    string
+	groupKind schema.GroupKind
+	hard      int64
+}
+
+func NewTenantQuotaLimitReachedError(tenant string, gk schema.GroupKind, hard int64) error {
+	return &tenantQuotaLimitReachedError{
+		tenant:    tenant,
+		groupKind: gk,
+		hard:      hard,
+	}
+}
+
+func (e tenantQuotaLimitReachedError) Error() string {
+	return fmt.Sprintf("resource %s has reached the quota limit of %d items for the Tenant %s", e.groupKind.String(), e.hard, e.tenant)
+}
+
+// IsTenantQuotaLimitReached returns true if the error is reporting a TenantQuota violation.
+func IsTenantQuotaLimitReached(err error) bool {
+	_, ok := err.(*tenantQuotaLimitReachedError) //nolint:errorlint
+
+	return ok
+}
+
+type allocationInvalidError struct {
+	reason string
+}
+
+func NewAllocationInvalidError(reason string) error {
+	return &allocationInvalidError{reason: reason}
+}
+
+func (e allocationInvalidError) Error() string {
+	return fmt.Sprintf("invalid allocation: %s", e.reason)
+}
+
+type allocationExceededError struct {
+	index     int
+	name      corev1.ResourceName
+	requested resource.Quantity
+	available resource.Quantity
+}
+
+func NewAllocationExceededError(index int, name corev1.ResourceName, requested, available resource.Quantity) error {
+	return &allocationExceededError{
+		index:     index,
+		name:      name,
+		requested: requested,
+		available: available,
+	}
+}
+
+func (e allocationExceededError) Error() string {
+	return fmt.Sprintf("allocation exceeds the Tenant budget for the ResourceQuota item #%d: requested %s=%s, available %s=%s", e.index, e.name, e.requested.String(), e.name, e.available.String())
+}
+
+// IsAllocationExceeded returns true if the error is reporting an allocation not fitting the Tenant budget.
+func IsAllocationExceeded(err error) bool {
+	_, ok := err.(*allocationExceededError) //nolint:errorlint
+
+	return ok
+}
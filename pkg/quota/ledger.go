@@ -0,0 +1,326 @@
+// Copyright 2020-2023 Project Capsule Authors.
+// SPDX-License-Identifier: Apache-2.0
+
+package quota
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	capsulev1beta2 "github.com/projectcapsule/capsule/api/v1beta2"
+	"github.com/projectcapsule/capsule/pkg/api"
+	"github.com/projectcapsule/capsule/pkg/utils"
+)
+
+const (
+	// defaultReservationTTL is the time after which an admitted request not yet tracked by the Namespace ResourceQuota
+	// is no more taken into account, e.g. since it has been rejected by a following admission controller.
+	defaultReservationTTL = 30 * time.Second
+	// maxReserveAttempts is the number of attempts to reserve the requested resources upon concurrent reservations.
+	maxReserveAttempts = 5
+)
+
+type ledgerKey struct {
+	tenant string
+	index  int
+}
+
+type ledgerEntry struct {
+	version      uint64
+	reservations []reservation
+}
+
+// reservation keeps track of the resources admitted for the given Namespace: these are taken into account
+// until the used resources of the Namespace ResourceQuota, updated by the Kubernetes quota admission controller,
+// are reflecting them. The baseline is the amount used by the Namespace upon the reservation, including the
+// reservations still pending in the same Namespace, which are expected to be tracked before.
+type reservation struct {
+	namespace  string
+	baseline   corev1.ResourceList
+	usage      corev1.ResourceList
+	expiration time.Time
+}
+
+// Ledger keeps track of the resources admitted for the Tenant-scoped ResourceQuota items, one entry per Tenant and item index,
+// making the Tenant budget strict rather than eventually consistent: the used resources are computed by summing the status
+// of the Namespace ResourceQuota resources along with the admitted requests not yet tracked by them.
+// Reservations are optimistically locked: in case of concurrent reservations on the same entry, the usage is computed again.
+type Ledger struct {
+	mu      sync.Mutex
+	entries map[ledgerKey]*ledgerEntry
+	ttl     time.Duration
+	now     func() time.Time
+}
+
+func NewLedger() *Ledger {
+	return &Ledger{
+		entries: make(map[ledgerKey]*ledgerEntry),
+		ttl:     defaultReservationTTL,
+		now:     time.Now,
+	}
+}
+
+// Reserve checks if the resources requested in the given Namespace, mapped by the Tenant ResourceQuota item index,
+// are fitting the Tenant budget, reserving them unless it's a dry-run.
+// The provided Tenant must be already resolved, since its ResourceQuota items are used as hard limits.
+// A Namespace with a ResourceQuotaAllocation is limited by the allocated resources, while the other ones are sharing
+// the remaining Tenant budget: the resources allocated to a Namespace cannot be used by the other ones.
+func (l *Ledger) Reserve(ctx context.Context, c client.Reader, tnt *capsulev1beta2.Tenant, namespace string, requests map[int]corev1.ResourceList, dryRun bool) (err error) {
+	requests = l.trackedRequests(tnt, requests)
+	if len(requests) == 0 {
+		return nil
+	}
+
+	allocations, err := l.allocations(ctx, c, tnt)
+	if err != nil {
+		return err
+	}
+
+	for attempt := 0; attempt < maxReserveAttempts; attempt++ {
+		versions := l.versions(tnt.GetName(), requests)
+
+		namespacesUsed := make(map[int]map[string]corev1.ResourceList, len(requests))
+
+		for index, requested := range requests {
+			if namespacesUsed[index], err = l.usedResources(ctx, c, tnt.GetName(), index); err != nil {
+				return err
+			}
+
+			hard := tnt.Spec.ResourceQuota.Items[index].Hard
+
+			for name, quantity := range requested {
+				limit, allocated := allocations.Allocated(index, namespace, name)
+				if !allocated {
+					limit = allocations.Shared(index, name, hard[name])
+				}
+
+				sharing := allocations.sharing(index, namespace, name)
+
+				var used resource.Quantity
+
+				for ns, namespaceUsed := range namespacesUsed[index] {
+					if sharing(ns) {
+						used.Add(namespaceUsed[name])
+					}
+				}
+
+				total := used.DeepCopy()
+				total.Add(l.pendingResources(tnt.GetName(), index, namespacesUsed[index], name, sharing))
+				total.Add(quantity)
+
+				if total.Cmp(limit) > 0 {
+					return NewTenantQuotaExceededError(tnt.GetName(), index, name, quantity, used, limit)
+				}
+			}
+		}
+
+		if dryRun || l.commit(tnt.GetName(), namespace, requests, versions, namespacesUsed) {
+			return nil
+		}
+	}
+
+	return NewTenantQuotaConflictError(tnt.GetName())
+}
+
+// trackedRequests filters the requested resources, keeping the ones limited by the Tenant-scoped ResourceQuota items.
+func (l *Ledger) trackedRequests(tnt *capsulev1beta2.Tenant, requests map[int]corev1.ResourceList) map[int]corev1.ResourceList {
+	tracked := make(map[int]corev1.ResourceList)
+
+	if tnt.Spec.ResourceQuota.Scope != api.ResourceQuotaScopeTenant {
+		return tracked
+	}
+
+	for index, requested := range requests {
+		if index < 0 || index >= len(tnt.Spec.ResourceQuota.Items) {
+			continue
+		}
+
+		hard := tnt.Spec.ResourceQuota.Items[index].Hard
+
+		for name, quantity := range requested {
+			if _, ok := hard[name]; !ok {
+				continue
+			}
+
+			if _, ok := tracked[index]; !ok {
+				tracked[index] = corev1.ResourceList{}
+			}
+
+			tracked[index][name] = quantity
+		}
+	}
+
+	return tracked
+}
+
+// allocations returns the accepted ResourceQuotaAllocation resources of the Tenant Namespaces,
+// validated against the Tenant budget as done by the Tenant controller.
+func (l *Ledger) allocations(ctx context.Context, c client.Reader, tnt *capsulev1beta2.Tenant) (Allocations, error) {
+	var items []capsulev1beta2.ResourceQuotaAllocation
+
+	for _, ns := range tnt.Status.Namespaces {
+		list := &capsulev1beta2.ResourceQuotaAllocationList{}
+		if err := c.List(ctx, list, client.InNamespace(ns)); err != nil {
+			return nil, err
+		}
+
+		items = append(items, list.Items...)
+	}
+
+	allocations, _ := Allocate(tnt.Spec.ResourceQuota, items)
+
+	return allocations, nil
+}
+
+// usedResources returns the resources used by each Namespace of the Tenant for the given ResourceQuota item index.
+func (l *Ledger) usedResources(ctx context.Context, c client.Reader, tenant string, index int) (map[string]corev1.ResourceList, error) {
+	tenantLabel, err := utils.GetTypeLabel(&capsulev1beta2.Tenant{})
+	if err != nil {
+		return nil, err
+	}
+
+	typeLabel, err := utils.GetTypeLabel(&corev1.ResourceQuota{})
+	if err != nil {
+		return nil, err
+	}
+
+	list := &corev1.ResourceQuotaList{}
+	if err = c.List(ctx, list, client.MatchingLabels{tenantLabel: tenant, typeLabel: strconv.Itoa(index)}); err != nil {
+		return nil, err
+	}
+
+	used := make(map[string]corev1.ResourceList, len(list.Items))
+
+	for _, item := range list.Items {
+		used[item.GetNamespace()] = item.Status.Used
+	}
+
+	return used, nil
+}
+
+// pendingResources returns the reserved amount of the given resource not yet tracked by the Namespace ResourceQuota resources,
+// in the Namespaces sharing the same budget.
+func (l *Ledger) pendingResources(tenant string, index int, namespacesUsed map[string]corev1.ResourceList, name corev1.ResourceName, sharing func(string) bool) (pending resource.Quantity) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	entry, ok := l.entries[ledgerKey{tenant: tenant, index: index}]
+	if !ok {
+		return pending
+	}
+
+	now := l.now()
+
+	for _, r := range entry.reservations {
+		if sharing(r.namespace) && r.isPending(now, namespacesUsed[r.namespace]) {
+			pending.Add(r.usage[name])
+		}
+	}
+
+	return pending
+}
+
+func (l *Ledger) versions(tenant string, requests map[int]corev1.ResourceList) map[int]uint64 {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	versions := make(map[int]uint64, len(requests))
+
+	for index := range requests {
+		if entry, ok := l.entries[ledgerKey{tenant: tenant, index: index}]; ok {
+			versions[index] = entry.version
+		}
+	}
+
+	return versions
+}
+
+// commit stores the reservations only if no other one has been committed in the meanwhile for the same entries,
+// pruning the ones already tracked by the Namespace ResourceQuota resources, or expired.
+func (l *Ledger) commit(tenant, namespace string, requests map[int]corev1.ResourceList, versions map[int]uint64, namespacesUsed map[int]map[string]corev1.ResourceList) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	for index := range requests {
+		var version uint64
+
+		if entry, ok := l.entries[ledgerKey{tenant: tenant, index: index}]; ok {
+			version = entry.version
+		}
+
+		if version != versions[index] {
+			return false
+		}
+	}
+
+	now := l.now()
+
+	for index, requested := range requests {
+		key := ledgerKey{tenant: tenant, index: index}
+
+		entry, ok := l.entries[key]
+		if !ok {
+			entry = &ledgerEntry{}
+			l.entries[key] = entry
+		}
+
+		reservations := make([]reservation, 0, len(entry.reservations)+1)
+
+		baseline := corev1.ResourceList{}
+
+		for name := range requested {
+			baseline[name] = namespacesUsed[index][namespace][name].DeepCopy()
+		}
+
+		for _, r := range entry.reservations {
+			if !r.isPending(now, namespacesUsed[index][r.namespace]) {
+				continue
+			}
+
+			reservations = append(reservations, r)
+
+			if r.namespace == namespace {
+				for name := range baseline {
+					quantity := baseline[name]
+					quantity.Add(r.usage[name])
+					baseline[name] = quantity
+				}
+			}
+		}
+
+		entry.reservations = append(reservations, reservation{
+			namespace:  namespace,
+			baseline:   baseline,
+			usage:      requested,
+			expiration: now.Add(l.ttl),
+		})
+		entry.version++
+	}
+
+	return true
+}
+
+// isPending returns true if the reservation is not expired, and not yet reflected by the used resources of its Namespace:
+// a decreased usage, e.g. upon deletions, keeps the reservation pending until it expires.
+func (r reservation) isPending(now time.Time, used corev1.ResourceList) bool {
+	if !now.Before(r.expiration) {
+		return false
+	}
+
+	for name, quantity := range r.usage {
+		expected := r.baseline[name].DeepCopy()
+		expected.Add(quantity)
+
+		if current := used[name]; current.Cmp(expected) < 0 {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,188 @@
+// Copyright 2020-2023 Project Capsule Authors.
+// SPDX-License-Identifier: Apache-2.0
+
+package quota
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	capsulev1beta2 "github.com/projectcapsule/capsule/api/v1beta2"
+	"github.com/projectcapsule/capsule/pkg/api"
+)
+
+func TestLedger_Reserve(t *testing.T) {
+	tnt := &capsulev1beta2.Tenant{
+		ObjectMeta: metav1.ObjectMeta{Name: "oil"},
+		Spec: capsulev1beta2.TenantSpec{
+			ResourceQuota: api.ResourceQuotaSpec{
+				Scope: api.ResourceQuotaScopeTenant,
+				Items: []corev1.ResourceQuotaSpec{
+					{Hard: corev1.ResourceList{corev1.ResourcePods: resource.MustParse("3")}},
+				},
+			},
+		},
+	}
+
+	rq := func(namespace string, used string) *corev1.ResourceQuota {
+		return &corev1.ResourceQuota{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "capsule-oil-0",
+				Namespace: namespace,
+				Labels: map[string]string{
+					"capsule.clastix.io/tenant":         "oil",
+					"capsule.clastix.io/resource-quota": "0",
+				},
+			},
+			Status: corev1.ResourceQuotaStatus{
+				Used: corev1.ResourceList{corev1.ResourcePods: resource.MustParse(used)},
+			},
+		}
+	}
+
+	c := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(rq("oil-dev", "1"), rq("oil-prod", "1")).Build()
+
+	now := time.Now()
+
+	ledger := NewLedger()
+	ledger.now = func() time.Time { return now }
+
+	pod := map[int]corev1.ResourceList{0: {corev1.ResourcePods: resource.MustParse("1"), corev1.ResourceCPU: resource.MustParse("1")}}
+
+	assert.NoError(t, ledger.Reserve(context.Background(), c, tnt, "oil-dev", pod, true))
+	assert.NoError(t, ledger.Reserve(context.Background(), c, tnt, "oil-dev", pod, false))
+	// The previous reservation is not yet tracked by the Namespace ResourceQuota
+	err := ledger.Reserve(context.Background(), c, tnt, "oil-prod", pod, false)
+	assert.True(t, IsTenantQuotaExceeded(err))
+	// Expired reservations are not taken into account
+	now = now.Add(defaultReservationTTL)
+	assert.NoError(t, ledger.Reserve(context.Background(), c, tnt, "oil-prod", pod, false))
+	// Namespace-scoped items are not enforced by the ledger
+	tnt.Spec.ResourceQuota.Scope = api.ResourceQuotaScopeNamespace
+	assert.NoError(t, ledger.Reserve(context.Background(), c, tnt, "oil-prod", pod, false))
+}
+
+func TestLedger_ReserveTracked(t *testing.T) {
+	tnt := &capsulev1beta2.Tenant{
+		ObjectMeta: metav1.ObjectMeta{Name: "oil"},
+		Spec: capsulev1beta2.TenantSpec{
+			ResourceQuota: api.ResourceQuotaSpec{
+				Scope: api.ResourceQuotaScopeTenant,
+				Items: []corev1.ResourceQuotaSpec{
+					{Hard: corev1.ResourceList{corev1.ResourcePods: resource.MustParse("4")}},
+				},
+			},
+		},
+	}
+
+	rq := func(namespace string, used corev1.ResourceList) *corev1.ResourceQuota {
+		return &corev1.ResourceQuota{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "capsule-oil-0",
+				Namespace: namespace,
+				Labels: map[string]string{
+					"capsule.clastix.io/tenant":         "oil",
+					"capsule.clastix.io/resource-quota": "0",
+				},
+			},
+			Status: corev1.ResourceQuotaStatus{
+				Used: used,
+			},
+		}
+	}
+
+	dev, prod := rq("oil-dev", corev1.ResourceList{corev1.ResourcePods: resource.MustParse("1")}), rq("oil-prod", corev1.ResourceList{corev1.ResourcePods: resource.MustParse("0")})
+
+	c := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(dev, prod).Build()
+
+	ctx := context.Background()
+
+	update := func(quota *corev1.ResourceQuota, used corev1.ResourceList) {
+		quota.Status.Used = used
+
+		assert.NoError(t, c.Update(ctx, quota))
+	}
+
+	ledger := NewLedger()
+
+	pendingPods := func() int64 {
+		used, err := ledger.usedResources(ctx, c, "oil", 0)
+		assert.NoError(t, err)
+
+		quantity := ledger.pendingResources("oil", 0, used, corev1.ResourcePods, func(string) bool { return true })
+
+		return quantity.Value()
+	}
+
+	pod := map[int]corev1.ResourceList{0: {corev1.ResourcePods: resource.MustParse("1")}}
+
+	assert.NoError(t, ledger.Reserve(ctx, c, tnt, "oil-dev", pod, false))
+	// Updates of the Namespace ResourceQuota not reflecting the reservation are keeping it
+	update(dev, corev1.ResourceList{corev1.ResourcePods: resource.MustParse("1"), corev1.ResourceCPU: resource.MustParse("1")})
+	assert.Equal(t, int64(1), pendingPods())
+
+	assert.NoError(t, ledger.Reserve(ctx, c, tnt, "oil-prod", pod, false))
+	assert.NoError(t, ledger.Reserve(ctx, c, tnt, "oil-prod", pod, false))
+	assert.True(t, IsTenantQuotaExceeded(ledger.Reserve(ctx, c, tnt, "oil-dev", pod, false)))
+	// Reservations are released one by one, as soon as the used resources are reflecting them
+	update(prod, corev1.ResourceList{corev1.ResourcePods: resource.MustParse("1")})
+	assert.Equal(t, int64(2), pendingPods())
+
+	update(dev, corev1.ResourceList{corev1.ResourcePods: resource.MustParse("2")})
+	assert.Equal(t, int64(1), pendingPods())
+}
+
+func TestLedger_ReserveAllocated(t *testing.T) {
+	tnt := &capsulev1beta2.Tenant{
+		ObjectMeta: metav1.ObjectMeta{Name: "oil"},
+		Spec: capsulev1beta2.TenantSpec{
+			ResourceQuota: api.ResourceQuotaSpec{
+				Scope: api.ResourceQuotaScopeTenant,
+				Items: []corev1.ResourceQuotaSpec{
+					{Hard: corev1.ResourceList{corev1.ResourcePods: resource.MustParse("4")}},
+				},
+			},
+		},
+		Status: capsulev1beta2.TenantStatus{
+			Namespaces: []string{"oil-dev", "oil-prod"},
+		},
+	}
+
+	allocation := &capsulev1beta2.ResourceQuotaAllocation{
+		ObjectMeta: metav1.ObjectMeta{Name: "production", Namespace: "oil-prod"},
+		Spec: capsulev1beta2.ResourceQuotaAllocationSpec{
+			Index: 0,
+			Hard:  corev1.ResourceList{corev1.ResourcePods: resource.MustParse("3")},
+		},
+	}
+
+	s := runtime.NewScheme()
+	assert.NoError(t, scheme.AddToScheme(s))
+	assert.NoError(t, capsulev1beta2.AddToScheme(s))
+
+	c := fake.NewClientBuilder().WithScheme(s).WithObjects(allocation).Build()
+
+	ctx := context.Background()
+
+	ledger := NewLedger()
+
+	pod := map[int]corev1.ResourceList{0: {corev1.ResourcePods: resource.MustParse("1")}}
+	// The Namespaces with no allocation are sharing the remaining budget, regardless of the unused allocated resources
+	assert.NoError(t, ledger.Reserve(ctx, c, tnt, "oil-dev", pod, false))
+	assert.True(t, IsTenantQuotaExceeded(ledger.Reserve(ctx, c, tnt, "oil-dev", pod, false)))
+	// The allocated resources are reserved to the Namespace
+	for i := 0; i < 3; i++ {
+		assert.NoError(t, ledger.Reserve(ctx, c, tnt, "oil-prod", pod, false))
+	}
+
+	assert.True(t, IsTenantQuotaExceeded(ledger.Reserve(ctx, c, tnt, "oil-prod", pod, false)))
+}
@@ -0,0 +1,45 @@
+// Copyright 2020-2023 Project Capsule Authors.
+// SPDX-License-Identifier: Apache-2.0
+
+package quota
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// NamespaceUsage returns the resources consumed in the given Namespace by the Pods and the PersistentVolumeClaims
+// tracked by the ResourceQuota item: the Pods in a terminal phase are not consuming resources anymore.
+func NamespaceUsage(ctx context.Context, c client.Reader, namespace string, spec corev1.ResourceQuotaSpec) (corev1.ResourceList, error) {
+	usage := corev1.ResourceList{}
+
+	pods := &corev1.PodList{}
+	if err := c.List(ctx, pods, client.InNamespace(namespace)); err != nil {
+		return nil, err
+	}
+
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+
+		if pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed || !PodMatchesScopes(spec, pod) {
+			continue
+		}
+
+		addResources(usage, PodUsage(pod))
+	}
+
+	pvcs := &corev1.PersistentVolumeClaimList{}
+	if err := c.List(ctx, pvcs, client.InNamespace(namespace)); err != nil {
+		return nil, err
+	}
+
+	for i := range pvcs.Items {
+		if pvc := &pvcs.Items[i]; PersistentVolumeClaimMatchesScopes(spec, pvc) {
+			addResources(usage, PersistentVolumeClaimUsage(pvc))
+		}
+	}
+
+	return usage, nil
+}
@@ -0,0 +1,65 @@
+// Copyright 2020-2023 Project Capsule Authors.
+// SPDX-License-Identifier: Apache-2.0
+
+package quota
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestNamespaceUsage(t *testing.T) {
+	pod := func(name, cpu string, phase corev1.PodPhase) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "oil-dev"},
+			Spec: corev1.PodSpec{
+				Containers: []corev1.Container{
+					{Resources: corev1.ResourceRequirements{Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse(cpu)}}},
+				},
+			},
+			Status: corev1.PodStatus{Phase: phase},
+		}
+	}
+
+	pvc := &corev1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{Name: "data", Namespace: "oil-dev"},
+		Spec: corev1.PersistentVolumeClaimSpec{
+			Resources: corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{corev1.ResourceStorage: resource.MustParse("10Gi")},
+			},
+		},
+	}
+
+	c := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(
+		pod("running", "500m", corev1.PodRunning),
+		pod("pending", "1", corev1.PodPending),
+		pod("completed", "2", corev1.PodSucceeded),
+		pvc,
+	).Build()
+
+	usage, err := NamespaceUsage(context.Background(), c, "oil-dev", corev1.ResourceQuotaSpec{})
+	assert.NoError(t, err)
+
+	for name, expected := range map[corev1.ResourceName]string{
+		corev1.ResourcePods:                   "2",
+		corev1.ResourceRequestsCPU:            "1500m",
+		corev1.ResourcePersistentVolumeClaims: "1",
+		corev1.ResourceRequestsStorage:        "10Gi",
+	} {
+		actual := usage[name]
+		assert.Equal(t, 0, actual.Cmp(resource.MustParse(expected)), "unexpected %s usage: %s", name, actual.String())
+	}
+	// The scoped ResourceQuota items are not tracking the PersistentVolumeClaims
+	usage, err = NamespaceUsage(context.Background(), c, "oil-dev", corev1.ResourceQuotaSpec{Scopes: []corev1.ResourceQuotaScope{corev1.ResourceQuotaScopeNotBestEffort}})
+	assert.NoError(t, err)
+
+	_, ok := usage[corev1.ResourceRequestsStorage]
+	assert.False(t, ok)
+}
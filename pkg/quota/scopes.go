@@ -0,0 +1,107 @@
+// Copyright 2020-2023 Project Capsule Authors.
+// SPDX-License-Identifier: Apache-2.0
+
+package quota
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// PodMatchesScopes returns true if the given Pod is tracked by the ResourceQuota,
+// evaluating both the scopes and the scope selector.
+func PodMatchesScopes(spec corev1.ResourceQuotaSpec, pod *corev1.Pod) bool {
+	for _, scope := range spec.Scopes {
+		if !podMatchesScope(pod, corev1.ScopedResourceSelectorRequirement{ScopeName: scope, Operator: corev1.ScopeSelectorOpExists}) {
+			return false
+		}
+	}
+
+	if spec.ScopeSelector != nil {
+		for _, requirement := range spec.ScopeSelector.MatchExpressions {
+			if !podMatchesScope(pod, requirement) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// PersistentVolumeClaimMatchesScopes returns true if the given PersistentVolumeClaim is tracked by the ResourceQuota:
+// the quota scopes are referring to Pods only, thus a scoped ResourceQuota is not tracking any PersistentVolumeClaim.
+func PersistentVolumeClaimMatchesScopes(spec corev1.ResourceQuotaSpec, _ *corev1.PersistentVolumeClaim) bool {
+	return len(spec.Scopes) == 0 && (spec.ScopeSelector == nil || len(spec.ScopeSelector.MatchExpressions) == 0)
+}
+
+func podMatchesScope(pod *corev1.Pod, requirement corev1.ScopedResourceSelectorRequirement) bool {
+	switch requirement.ScopeName {
+	case corev1.ResourceQuotaScopeTerminating:
+		return pod.Spec.ActiveDeadlineSeconds != nil
+	case corev1.ResourceQuotaScopeNotTerminating:
+		return pod.Spec.ActiveDeadlineSeconds == nil
+	case corev1.ResourceQuotaScopeBestEffort:
+		return isBestEffort(pod)
+	case corev1.ResourceQuotaScopeNotBestEffort:
+		return !isBestEffort(pod)
+	case corev1.ResourceQuotaScopePriorityClass:
+		switch requirement.Operator {
+		case corev1.ScopeSelectorOpExists:
+			return len(pod.Spec.PriorityClassName) > 0
+		case corev1.ScopeSelectorOpDoesNotExist:
+			return len(pod.Spec.PriorityClassName) == 0
+		case corev1.ScopeSelectorOpIn:
+			return sets.New[string](requirement.Values...).Has(pod.Spec.PriorityClassName)
+		case corev1.ScopeSelectorOpNotIn:
+			return !sets.New[string](requirement.Values...).Has(pod.Spec.PriorityClassName)
+		}
+	case corev1.ResourceQuotaScopeCrossNamespacePodAffinity:
+		return hasCrossNamespacePodAffinity(pod)
+	}
+
+	return false
+}
+
+func isBestEffort(pod *corev1.Pod) bool {
+	for _, containers := range [][]corev1.Container{pod.Spec.InitContainers, pod.Spec.Containers} {
+		for _, container := range containers {
+			if len(container.Resources.Requests) > 0 || len(container.Resources.Limits) > 0 {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+func hasCrossNamespacePodAffinity(pod *corev1.Pod) bool {
+	if pod.Spec.Affinity == nil {
+		return false
+	}
+
+	var terms []corev1.PodAffinityTerm
+
+	if affinity := pod.Spec.Affinity.PodAffinity; affinity != nil {
+		terms = append(terms, affinity.RequiredDuringSchedulingIgnoredDuringExecution...)
+
+		for _, weighted := range affinity.PreferredDuringSchedulingIgnoredDuringExecution {
+			terms = append(terms, weighted.PodAffinityTerm)
+		}
+	}
+
+	if antiAffinity := pod.Spec.Affinity.PodAntiAffinity; antiAffinity != nil {
+		terms = append(terms, antiAffinity.RequiredDuringSchedulingIgnoredDuringExecution...)
+
+		for _, weighted := range antiAffinity.PreferredDuringSchedulingIgnoredDuringExecution {
+			terms = append(terms, weighted.PodAffinityTerm)
+		}
+	}
+
+	for _, term := range terms {
+		if len(term.Namespaces) > 0 || term.NamespaceSelector != nil {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,126 @@
+// Copyright 2020-2023 Project Capsule Authors.
+// SPDX-License-Identifier: Apache-2.0
+
+package quota
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+const (
+	countPods                   corev1.ResourceName = "count/pods"
+	countPersistentVolumeClaims corev1.ResourceName = "count/persistentvolumeclaims"
+	storageClassSuffix                              = ".storageclass.storage.k8s.io/"
+)
+
+// PodUsage returns the resources consumed by the given Pod, named as the ResourceQuota ones:
+// the effective requests are the highest between the sum of the containers and any of the init containers,
+// along with the Pod overhead, as computed by the Kubernetes quota evaluator.
+func PodUsage(pod *corev1.Pod) corev1.ResourceList {
+	usage := corev1.ResourceList{
+		corev1.ResourcePods: resource.MustParse("1"),
+		countPods:           resource.MustParse("1"),
+	}
+
+	requests, limits := corev1.ResourceList{}, corev1.ResourceList{}
+
+	for _, container := range pod.Spec.Containers {
+		addResources(requests, container.Resources.Requests)
+		addResources(limits, container.Resources.Limits)
+	}
+
+	for _, container := range pod.Spec.InitContainers {
+		maxResources(requests, container.Resources.Requests)
+		maxResources(limits, container.Resources.Limits)
+	}
+
+	if pod.Spec.Overhead != nil {
+		addResources(requests, pod.Spec.Overhead)
+		addResources(limits, pod.Spec.Overhead)
+	}
+
+	for name, quantity := range requests {
+		usage[prefixedResourceName("requests.", name)] = quantity.DeepCopy()
+		// CPU, memory and ephemeral storage requests can be limited also using the bare resource name
+		switch name {
+		case corev1.ResourceCPU, corev1.ResourceMemory, corev1.ResourceEphemeralStorage:
+			usage[name] = quantity.DeepCopy()
+		}
+	}
+
+	for name, quantity := range limits {
+		usage[prefixedResourceName("limits.", name)] = quantity.DeepCopy()
+	}
+
+	return usage
+}
+
+// PersistentVolumeClaimUsage returns the resources consumed by the given PersistentVolumeClaim, named as the ResourceQuota ones,
+// including the ones scoped to its StorageClass.
+func PersistentVolumeClaimUsage(pvc *corev1.PersistentVolumeClaim) corev1.ResourceList {
+	usage := corev1.ResourceList{
+		corev1.ResourcePersistentVolumeClaims: resource.MustParse("1"),
+		countPersistentVolumeClaims:           resource.MustParse("1"),
+	}
+
+	storage, ok := pvc.Spec.Resources.Requests[corev1.ResourceStorage]
+	if ok {
+		usage[corev1.ResourceRequestsStorage] = storage.DeepCopy()
+	}
+
+	if pvc.Spec.StorageClassName != nil && len(*pvc.Spec.StorageClassName) > 0 {
+		prefix := *pvc.Spec.StorageClassName + storageClassSuffix
+
+		usage[corev1.ResourceName(prefix+string(corev1.ResourcePersistentVolumeClaims))] = resource.MustParse("1")
+
+		if ok {
+			usage[corev1.ResourceName(prefix+string(corev1.ResourceRequestsStorage))] = storage.DeepCopy()
+		}
+	}
+
+	return usage
+}
+
+// PersistentVolumeClaimUsageIncrease returns the resources additionally consumed by the updated PersistentVolumeClaim,
+// such as upon the expansion of its storage request: the unchanged, or decreased, resources are not included.
+func PersistentVolumeClaimUsageIncrease(oldPVC, pvc *corev1.PersistentVolumeClaim) corev1.ResourceList {
+	increase, previous := corev1.ResourceList{}, PersistentVolumeClaimUsage(oldPVC)
+
+	for name, quantity := range PersistentVolumeClaimUsage(pvc) {
+		delta := quantity.DeepCopy()
+
+		if value, ok := previous[name]; ok {
+			delta.Sub(value)
+		}
+
+		if delta.Sign() > 0 {
+			increase[name] = delta
+		}
+	}
+
+	return increase
+}
+
+// prefixedResourceName returns the quota resource name for the given requests, or limits, resource.
+func prefixedResourceName(prefix string, name corev1.ResourceName) corev1.ResourceName {
+	return corev1.ResourceName(fmt.Sprintf("%s%s", prefix, name))
+}
+
+func addResources(dst, src corev1.ResourceList) {
+	for name, quantity := range src {
+		value := dst[name]
+		value.Add(quantity)
+		dst[name] = value
+	}
+}
+
+func maxResources(dst, src corev1.ResourceList) {
+	for name, quantity := range src {
+		if value, ok := dst[name]; !ok || quantity.Cmp(value) > 0 {
+			dst[name] = quantity.DeepCopy()
+		}
+	}
+}
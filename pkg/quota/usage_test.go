@@ -0,0 +1,127 @@
+// Copyright 2020-2023 Project Capsule Authors.
+// SPDX-License-Identifier: Apache-2.0
+
+package quota
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/utils/pointer"
+)
+
+func TestPodUsage(t *testing.T) {
+	container := func(cpu, memory string) corev1.Container {
+		return corev1.Container{
+			Resources: corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse(cpu),
+					corev1.ResourceMemory: resource.MustParse(memory),
+				},
+				Limits: corev1.ResourceList{
+					corev1.ResourceCPU: resource.MustParse(cpu),
+				},
+			},
+		}
+	}
+
+	pod := &corev1.Pod{
+		Spec: corev1.PodSpec{
+			InitContainers: []corev1.Container{container("2", "64Mi")},
+			Containers:     []corev1.Container{container("500m", "128Mi"), container("500m", "128Mi")},
+			Overhead:       corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("100m")},
+		},
+	}
+
+	usage := PodUsage(pod)
+
+	for name, expected := range map[corev1.ResourceName]string{
+		corev1.ResourcePods:           "1",
+		countPods:                     "1",
+		corev1.ResourceRequestsCPU:    "2100m",
+		corev1.ResourceCPU:            "2100m",
+		corev1.ResourceRequestsMemory: "256Mi",
+		corev1.ResourceMemory:         "256Mi",
+		corev1.ResourceLimitsCPU:      "2100m",
+	} {
+		actual := usage[name]
+		assert.Equal(t, 0, actual.Cmp(resource.MustParse(expected)), "unexpected %s usage: %s", name, actual.String())
+	}
+
+	assert.NotContains(t, usage, corev1.ResourceLimitsMemory)
+}
+
+func TestPersistentVolumeClaimUsage(t *testing.T) {
+	pvc := &corev1.PersistentVolumeClaim{
+		Spec: corev1.PersistentVolumeClaimSpec{
+			StorageClassName: pointer.String("fast"),
+			Resources: corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{corev1.ResourceStorage: resource.MustParse("10Gi")},
+			},
+		},
+	}
+
+	usage := PersistentVolumeClaimUsage(pvc)
+
+	for name, expected := range map[corev1.ResourceName]string{
+		corev1.ResourcePersistentVolumeClaims:                     "1",
+		countPersistentVolumeClaims:                               "1",
+		corev1.ResourceRequestsStorage:                            "10Gi",
+		"fast.storageclass.storage.k8s.io/requests.storage":       "10Gi",
+		"fast.storageclass.storage.k8s.io/persistentvolumeclaims": "1",
+	} {
+		actual := usage[name]
+		assert.Equal(t, 0, actual.Cmp(resource.MustParse(expected)), "unexpected %s usage: %s", name, actual.String())
+	}
+}
+
+func TestPersistentVolumeClaimUsageIncrease(t *testing.T) {
+	pvc := func(storage string) *corev1.PersistentVolumeClaim {
+		return &corev1.PersistentVolumeClaim{
+			Spec: corev1.PersistentVolumeClaimSpec{
+				StorageClassName: pointer.String("fast"),
+				Resources: corev1.ResourceRequirements{
+					Requests: corev1.ResourceList{corev1.ResourceStorage: resource.MustParse(storage)},
+				},
+			},
+		}
+	}
+
+	increase := PersistentVolumeClaimUsageIncrease(pvc("10Gi"), pvc("15Gi"))
+	assert.Len(t, increase, 2)
+
+	for _, name := range []corev1.ResourceName{corev1.ResourceRequestsStorage, "fast.storageclass.storage.k8s.io/requests.storage"} {
+		actual := increase[name]
+		assert.Equal(t, 0, actual.Cmp(resource.MustParse("5Gi")), "unexpected %s increase: %s", name, actual.String())
+	}
+
+	assert.Empty(t, PersistentVolumeClaimUsageIncrease(pvc("10Gi"), pvc("10Gi")))
+	assert.Empty(t, PersistentVolumeClaimUsageIncrease(pvc("10Gi"), pvc("5Gi")))
+}
+
+func TestPodMatchesScopes(t *testing.T) {
+	bestEffort := &corev1.Pod{Spec: corev1.PodSpec{Containers: []corev1.Container{{}}, PriorityClassName: "high"}}
+
+	testCases := []struct {
+		name     string
+		spec     corev1.ResourceQuotaSpec
+		expected bool
+	}{
+		{"no scopes", corev1.ResourceQuotaSpec{}, true},
+		{"best effort", corev1.ResourceQuotaSpec{Scopes: []corev1.ResourceQuotaScope{corev1.ResourceQuotaScopeBestEffort}}, true},
+		{"not best effort", corev1.ResourceQuotaSpec{Scopes: []corev1.ResourceQuotaScope{corev1.ResourceQuotaScopeNotBestEffort}}, false},
+		{"terminating", corev1.ResourceQuotaSpec{Scopes: []corev1.ResourceQuotaScope{corev1.ResourceQuotaScopeTerminating}}, false},
+		{"priority class in", corev1.ResourceQuotaSpec{ScopeSelector: &corev1.ScopeSelector{MatchExpressions: []corev1.ScopedResourceSelectorRequirement{
+			{ScopeName: corev1.ResourceQuotaScopePriorityClass, Operator: corev1.ScopeSelectorOpIn, Values: []string{"high"}},
+		}}}, true},
+		{"priority class not in", corev1.ResourceQuotaSpec{ScopeSelector: &corev1.ScopeSelector{MatchExpressions: []corev1.ScopedResourceSelectorRequirement{
+			{ScopeName: corev1.ResourceQuotaScopePriorityClass, Operator: corev1.ScopeSelectorOpNotIn, Values: []string{"high"}},
+		}}}, false},
+	}
+
+	for _, tc := range testCases {
+		assert.Equal(t, tc.expected, PodMatchesScopes(tc.spec, bestEffort), tc.name)
+	}
+}
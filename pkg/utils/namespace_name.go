@@ -0,0 +1,62 @@
+// Copyright 2020-2023 Project Capsule Authors.
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"fmt"
+	"strings"
+
+	capsulev1beta2 "github.com/projectcapsule/capsule/api/v1beta2"
+)
+
+// NamespaceNamingPolicyError is returned when the Namespace name doesn't match the naming policy of the Tenant.
+type NamespaceNamingPolicyError struct {
+	namespace  string
+	suggestion string
+	maxLength  *int32
+}
+
+func (n NamespaceNamingPolicyError) Error() string {
+	msg := fmt.Sprintf("The namespace %s doesn't match the tenant naming policy, expected a name like %s", n.namespace, n.suggestion)
+
+	if n.maxLength != nil {
+		msg += fmt.Sprintf(", up to %d characters", *n.maxLength)
+	}
+
+	return msg
+}
+
+// TenantPrefixError is returned when the Namespace name doesn't match the Tenant prefix, if enforced.
+type TenantPrefixError struct {
+	expected string
+}
+
+func (t TenantPrefixError) Error() string {
+	return fmt.Sprintf("The namespace doesn't match the tenant prefix, expected %s", t.expected)
+}
+
+// ValidateNamespaceName returns an error if the Namespace name doesn't comply with the naming policy of the Tenant,
+// or with the Tenant prefix when enforced: the naming policy takes precedence over the Tenant prefix enforcement.
+func ValidateNamespaceName(tnt *capsulev1beta2.Tenant, name string, forceTenantPrefix bool) error {
+	if tnt.Spec.NamespaceOptions != nil && tnt.Spec.NamespaceOptions.NamingPolicy != nil {
+		policy := tnt.Spec.NamespaceOptions.NamingPolicy
+
+		matched, err := policy.Match(tnt.GetName(), name)
+		if err != nil {
+			return err
+		}
+
+		if !matched {
+			return &NamespaceNamingPolicyError{namespace: name, suggestion: policy.Suggest(tnt.GetName(), name), maxLength: policy.MaxLength}
+		}
+
+		return nil
+	}
+
+	if forceTenantPrefix && !strings.HasPrefix(name, fmt.Sprintf("%s-", tnt.GetName())) {
+		return &TenantPrefixError{expected: fmt.Sprintf("%s-%s", tnt.GetName(), name)}
+	}
+
+	return nil
+}
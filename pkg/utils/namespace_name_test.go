@@ -0,0 +1,34 @@
+// Copyright 2020-2023 Project Capsule Authors.
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	capsulev1beta2 "github.com/projectcapsule/capsule/api/v1beta2"
+	"github.com/projectcapsule/capsule/pkg/api"
+)
+
+func TestValidateNamespaceName(t *testing.T) {
+	tnt := &capsulev1beta2.Tenant{ObjectMeta: metav1.ObjectMeta{Name: "oil"}}
+
+	assert.NoError(t, ValidateNamespaceName(tnt, "production", false))
+	assert.NoError(t, ValidateNamespaceName(tnt, "oil-production", true))
+
+	var prefixErr *TenantPrefixError
+	assert.True(t, errors.As(ValidateNamespaceName(tnt, "production", true), &prefixErr))
+
+	tnt.Spec.NamespaceOptions = &capsulev1beta2.NamespaceOptions{
+		NamingPolicy: &api.NamespaceNamingPolicy{Template: "{{ tenant }}-{{ name }}"},
+	}
+	// the naming policy takes precedence over the Tenant prefix
+	assert.NoError(t, ValidateNamespaceName(tnt, "oil-production", true))
+
+	var namingErr *NamespaceNamingPolicyError
+	assert.True(t, errors.As(ValidateNamespaceName(tnt, "production", true), &namingErr))
+}
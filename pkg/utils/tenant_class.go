@@ -0,0 +1,42 @@
+// Copyright 2020-2023 Project Capsule Authors.
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	capsulev1beta2 "github.com/projectcapsule/capsule/api/v1beta2"
+)
+
+// GetTenantClass returns the TenantClass referenced by the given Tenant, nil if none.
+func GetTenantClass(ctx context.Context, c client.Reader, tnt *capsulev1beta2.Tenant) (*capsulev1beta2.TenantClass, error) {
+	if len(tnt.Spec.TenantClassName) == 0 {
+		return nil, nil //nolint:nilnil
+	}
+
+	class := &capsulev1beta2.TenantClass{}
+	if err := c.Get(ctx, types.NamespacedName{Name: tnt.Spec.TenantClassName}, class); err != nil {
+		return nil, fmt.Errorf("cannot retrieve the TenantClass %s: %w", tnt.Spec.TenantClassName, err)
+	}
+
+	return class, nil
+}
+
+// ResolveTenantClass merges the defaults of the TenantClass referenced by the given Tenant into its specification,
+// returning the applied class, nil if none.
+// The Tenant is modified in place, thus it must not be persisted afterwards.
+func ResolveTenantClass(ctx context.Context, c client.Reader, tnt *capsulev1beta2.Tenant) (*capsulev1beta2.TenantClass, error) {
+	class, err := GetTenantClass(ctx, c, tnt)
+	if err != nil || class == nil {
+		return nil, err
+	}
+
+	tnt.ApplyClass(class)
+
+	return class, nil
+}
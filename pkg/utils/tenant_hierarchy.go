@@ -0,0 +1,104 @@
+// Copyright 2020-2023 Project Capsule Authors.
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	capsulev1beta2 "github.com/projectcapsule/capsule/api/v1beta2"
+)
+
+// GetTenantAncestors returns the chain of parents of the given Tenant, starting from the closest one.
+func GetTenantAncestors(ctx context.Context, c client.Reader, tnt *capsulev1beta2.Tenant) ([]capsulev1beta2.Tenant, error) {
+	var ancestors []capsulev1beta2.Tenant
+
+	visited := sets.New[string](tnt.GetName())
+
+	for parent := tnt.Spec.Parent; len(parent) > 0; {
+		if visited.Has(parent) {
+			return nil, fmt.Errorf("the hierarchy of the Tenant %s contains a cycle through %s", tnt.GetName(), parent)
+		}
+
+		visited.Insert(parent)
+
+		ancestor := capsulev1beta2.Tenant{}
+		if err := c.Get(ctx, types.NamespacedName{Name: parent}, &ancestor); err != nil {
+			return nil, fmt.Errorf("cannot retrieve the parent Tenant %s: %w", parent, err)
+		}
+
+		ancestors = append(ancestors, ancestor)
+		parent = ancestor.Spec.Parent
+	}
+
+	return ancestors, nil
+}
+
+// GetTenantChildren returns the Tenants having the given one as parent.
+func GetTenantChildren(ctx context.Context, c client.Reader, tnt *capsulev1beta2.Tenant) ([]capsulev1beta2.Tenant, error) {
+	children := &capsulev1beta2.TenantList{}
+	if err := c.List(ctx, children, client.MatchingFieldsSelector{Selector: fields.OneTermEqualSelector(".spec.parent", tnt.GetName())}); err != nil {
+		return nil, err
+	}
+
+	return children.Items, nil
+}
+
+// ResolveTenantHierarchy computes the effective specification of the given Tenant:
+// the policies inherited by its ancestors are applied, and the budget allocated to its children is carved out.
+// The Tenant is modified in place, thus it must not be persisted afterwards.
+func ResolveTenantHierarchy(ctx context.Context, c client.Reader, tnt *capsulev1beta2.Tenant) error {
+	if err := ResolveTenantInheritance(ctx, c, tnt); err != nil {
+		return err
+	}
+
+	children, err := GetTenantChildren(ctx, c, tnt)
+	if err != nil {
+		return err
+	}
+
+	for i := range children {
+		if _, err = ResolveTenantClass(ctx, c, &children[i]); err != nil {
+			return err
+		}
+	}
+
+	tnt.CarveOut(children...)
+
+	return nil
+}
+
+// ResolveTenantInheritance applies to the given Tenant the defaults of its TenantClass, and the policies inherited by its ancestors.
+// The Tenant is modified in place, thus it must not be persisted afterwards.
+func ResolveTenantInheritance(ctx context.Context, c client.Reader, tnt *capsulev1beta2.Tenant) error {
+	ancestors, err := GetTenantAncestors(ctx, c, tnt)
+	if err != nil {
+		return err
+	}
+
+	if _, err = ResolveTenantClass(ctx, c, tnt); err != nil {
+		return err
+	}
+
+	for i := range ancestors {
+		if _, err = ResolveTenantClass(ctx, c, &ancestors[i]); err != nil {
+			return err
+		}
+	}
+
+	for i := len(ancestors) - 1; i > 0; i-- {
+		ancestors[i-1].InheritFrom(&ancestors[i])
+	}
+
+	if len(ancestors) > 0 {
+		tnt.InheritFrom(&ancestors[0])
+	}
+
+	return nil
+}
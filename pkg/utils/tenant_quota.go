@@ -0,0 +1,23 @@
+// Copyright 2020-2023 Project Capsule Authors.
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/fields"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	capsulev1beta2 "github.com/projectcapsule/capsule/api/v1beta2"
+)
+
+// GetTenantQuotas returns the TenantQuota resources applied to the given Tenant.
+func GetTenantQuotas(ctx context.Context, c client.Reader, tenantName string) ([]capsulev1beta2.TenantQuota, error) {
+	list := &capsulev1beta2.TenantQuotaList{}
+	if err := c.List(ctx, list, client.MatchingFieldsSelector{Selector: fields.OneTermEqualSelector(".spec.tenantName", tenantName)}); err != nil {
+		return nil, err
+	}
+
+	return list.Items, nil
+}
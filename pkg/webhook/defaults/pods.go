@@ -7,7 +7,9 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"reflect"
 
+	admissionv1 "k8s.io/api/admission/v1"
 	corev1 "k8s.io/api/core/v1"
 	schedulev1 "k8s.io/api/scheduling/v1"
 	"k8s.io/client-go/tools/record"
@@ -15,6 +17,9 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	capsulev1beta2 "github.com/projectcapsule/capsule/api/v1beta2"
+	"github.com/projectcapsule/capsule/pkg/api"
+	capsuleutils "github.com/projectcapsule/capsule/pkg/utils"
+	"github.com/projectcapsule/capsule/pkg/webhook/pod"
 	"github.com/projectcapsule/capsule/pkg/webhook/utils"
 )
 
@@ -35,14 +40,255 @@ func mutatePodDefaults(ctx context.Context, req admission.Request, c client.Clie
 		return utils.ErroredResponse(err)
 	}
 
-	if tnt == nil {
+	if tnt == nil || len(tnt.GetName()) == 0 {
 		return nil
 	}
+	// Registry mirrors and node pool could be inherited by the parent Tenant
+	if err = capsuleutils.ResolveTenantInheritance(ctx, c, tnt); err != nil {
+		return utils.ErroredResponse(err)
+	}
+
+	mutated, err := mutatePodImages(req, recorder, pod, tnt)
+	if err != nil {
+		return utils.ErroredResponse(err)
+	}
+	// The ephemeral containers are added to existing Pods, with no further change allowed.
+	if req.Operation == admissionv1.Create {
+		pcMutated, response := mutatePodPriorityClass(ctx, c, recorder, pod, tnt)
+		if response != nil {
+			return response
+		}
+
+		mutated = mutated || pcMutated
+		mutated = mutatePodNodePool(recorder, pod, tnt) || mutated
+	}
+
+	if !mutated {
+		return nil
+	}
+	// Marshal Pod
+	marshaled, err := json.Marshal(pod)
+	if err != nil {
+		return utils.ErroredResponse(err)
+	}
+
+	response := admission.PatchResponseFromRaw(req.Object.Raw, marshaled)
+
+	return &response
+}
+
+// mutatePodImages rewrites the images hosted by the Tenant mirrored registries, recording the original ones
+// in the Pod annotation, keyed by container name.
+func mutatePodImages(req admission.Request, recorder record.EventRecorder, p *corev1.Pod, tnt *capsulev1beta2.Tenant) (bool, error) {
+	if len(tnt.Spec.RegistryMirrors) == 0 {
+		return false, nil
+	}
+
+	containers := make(map[string]*string)
+
+	for i := range p.Spec.EphemeralContainers {
+		containers[p.Spec.EphemeralContainers[i].Name] = &p.Spec.EphemeralContainers[i].Image
+	}
+	// Upon the ephemeral containers update, any other change is ignored by the API server.
+	if req.Operation == admissionv1.Create {
+		for i := range p.Spec.InitContainers {
+			containers[p.Spec.InitContainers[i].Name] = &p.Spec.InitContainers[i].Image
+		}
+
+		for i := range p.Spec.Containers {
+			containers[p.Spec.Containers[i].Name] = &p.Spec.Containers[i].Image
+		}
+	}
+
+	originals := make(map[string]string)
+
+	if value, ok := p.GetAnnotations()[api.OriginalImagesAnnotation]; ok {
+		// A malformed annotation is overwritten.
+		_ = json.Unmarshal([]byte(value), &originals)
+	}
+
+	var mutated bool
+
+	for name, image := range containers {
+		// The invalid references are denied by the validating webhooks.
+		rewritten, ok, err := pod.MirrorImage(*image, tnt.Spec.RegistryMirrors)
+		if err != nil || !ok {
+			continue
+		}
+
+		recorder.Eventf(tnt, corev1.EventTypeNormal, "TenantRegistryMirror", "Rewritten container %s image %s to %s for %s/%s", name, *image, rewritten, p.Namespace, p.Name)
+
+		originals[name] = *image
+		*image = rewritten
+		mutated = true
+	}
+
+	if !mutated {
+		return false, nil
+	}
+
+	value, err := json.Marshal(originals)
+	if err != nil {
+		return false, err
+	}
+
+	annotations := p.GetAnnotations()
+	if annotations == nil {
+		annotations = make(map[string]string)
+	}
+
+	annotations[api.OriginalImagesAnnotation] = string(value)
+
+	p.SetAnnotations(annotations)
+
+	return true, nil
+}
+
+// mutatePodNodePool schedules the Pod on the Tenant node pool, injecting the node selector, the required node affinity,
+// and the tolerations: the Pod ones are preserved, unless conflicting with the Tenant node selector.
+func mutatePodNodePool(recorder record.EventRecorder, pod *corev1.Pod, tnt *capsulev1beta2.Tenant) (mutated bool) {
+	pool := tnt.Spec.NodePool
+	if pool == nil {
+		return false
+	}
+
+	if pool.InjectNodeSelector && len(tnt.Spec.NodeSelector) > 0 {
+		if pod.Spec.NodeSelector == nil {
+			pod.Spec.NodeSelector = make(map[string]string, len(tnt.Spec.NodeSelector))
+		}
+
+		for k, v := range tnt.Spec.NodeSelector {
+			if value, ok := pod.Spec.NodeSelector[k]; ok && value == v {
+				continue
+			}
+
+			pod.Spec.NodeSelector[k] = v
+			mutated = true
+		}
+	}
+
+	if pool.RequiredNodeAffinity != nil && len(pool.RequiredNodeAffinity.NodeSelectorTerms) > 0 && !containsNodeSelector(podRequiredNodeAffinity(pod), pool.RequiredNodeAffinity) {
+		if pod.Spec.Affinity == nil {
+			pod.Spec.Affinity = &corev1.Affinity{}
+		}
+
+		if pod.Spec.Affinity.NodeAffinity == nil {
+			pod.Spec.Affinity.NodeAffinity = &corev1.NodeAffinity{}
+		}
 
+		nodeAffinity := pod.Spec.Affinity.NodeAffinity
+		nodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution = combineNodeSelectors(nodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution, pool.RequiredNodeAffinity)
+
+		mutated = true
+	}
+
+	for _, toleration := range pool.Tolerations {
+		if hasToleration(pod.Spec.Tolerations, toleration) {
+			continue
+		}
+
+		pod.Spec.Tolerations = append(pod.Spec.Tolerations, toleration)
+		mutated = true
+	}
+
+	if mutated {
+		recorder.Eventf(tnt, corev1.EventTypeNormal, "TenantNodePool", "Assigned Tenant node pool to %s/%s", pod.Namespace, pod.Name)
+	}
+
+	return mutated
+}
+
+// combineNodeSelectors returns the node selector requiring both the given ones: since the terms are ORed,
+// and the requirements of a term are ANDed, each term of the Pod is combined with each term of the Tenant.
+func combineNodeSelectors(pod, tenant *corev1.NodeSelector) *corev1.NodeSelector {
+	if pod == nil || len(pod.NodeSelectorTerms) == 0 {
+		return tenant.DeepCopy()
+	}
+
+	combined := &corev1.NodeSelector{}
+
+	for _, podTerm := range pod.NodeSelectorTerms {
+		for _, tenantTerm := range tenant.NodeSelectorTerms {
+			term := podTerm.DeepCopy()
+			term.MatchExpressions = append(term.MatchExpressions, tenantTerm.MatchExpressions...)
+			term.MatchFields = append(term.MatchFields, tenantTerm.MatchFields...)
+
+			combined.NodeSelectorTerms = append(combined.NodeSelectorTerms, *term)
+		}
+	}
+
+	return combined
+}
+
+func podRequiredNodeAffinity(pod *corev1.Pod) *corev1.NodeSelector {
+	if pod.Spec.Affinity == nil || pod.Spec.Affinity.NodeAffinity == nil {
+		return nil
+	}
+
+	return pod.Spec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution
+}
+
+// containsNodeSelector returns true if each term of the Pod node selector already contains at least one
+// of the Tenant terms, as it happens once they have been combined: the Pod cannot be scheduled out of the Tenant nodes.
+func containsNodeSelector(pod, tenant *corev1.NodeSelector) bool {
+	if pod == nil || len(pod.NodeSelectorTerms) == 0 {
+		return false
+	}
+
+	for _, podTerm := range pod.NodeSelectorTerms {
+		var found bool
+
+		for _, tenantTerm := range tenant.NodeSelectorTerms {
+			if containsRequirements(podTerm.MatchExpressions, tenantTerm.MatchExpressions) && containsRequirements(podTerm.MatchFields, tenantTerm.MatchFields) {
+				found = true
+
+				break
+			}
+		}
+
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
+
+func containsRequirements(requirements, expected []corev1.NodeSelectorRequirement) bool {
+	for _, e := range expected {
+		var found bool
+
+		for _, r := range requirements {
+			if reflect.DeepEqual(r, e) {
+				found = true
+
+				break
+			}
+		}
+
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
+
+func hasToleration(tolerations []corev1.Toleration, toleration corev1.Toleration) bool {
+	for _, t := range tolerations {
+		if t.MatchToleration(&toleration) && reflect.DeepEqual(t.TolerationSeconds, toleration.TolerationSeconds) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func mutatePodPriorityClass(ctx context.Context, c client.Client, recorder record.EventRecorder, pod *corev1.Pod, tnt *capsulev1beta2.Tenant) (bool, *admission.Response) {
 	allowed := tnt.Spec.PriorityClasses
 
 	if allowed == nil || allowed.Default == "" {
-		return nil
+		return false, nil
 	}
 
 	priorityClassPod := pod.Spec.PriorityClassName
@@ -50,6 +296,8 @@ func mutatePodDefaults(ctx context.Context, req admission.Request, c client.Clie
 	var mutate bool
 
 	var cpc *schedulev1.PriorityClass
+
+	var err error
 	// PriorityClass name is empty, if no GlobalDefault is set and no PriorityClass was given on pod
 	if len(priorityClassPod) > 0 && priorityClassPod != allowed.Default {
 		cpc, err = utils.GetPriorityClassByName(ctx, c, priorityClassPod)
@@ -57,33 +305,26 @@ func mutatePodDefaults(ctx context.Context, req admission.Request, c client.Clie
 		if err != nil {
 			response := admission.Denied(NewPriorityClassError(priorityClassPod, err).Error())
 
-			return &response
+			return false, &response
 		}
 	} else {
 		mutate = true
 	}
 
 	if mutate = mutate || (utils.IsDefaultPriorityClass(cpc) && cpc.GetName() != allowed.Default); !mutate {
-		return nil
+		return false, nil
 	}
 
 	pc, err := utils.GetPriorityClassByName(ctx, c, allowed.Default)
 	if err != nil {
-		return utils.ErroredResponse(fmt.Errorf("failed to assign tenant default Priority Class: %w", err))
+		return false, utils.ErroredResponse(fmt.Errorf("failed to assign tenant default Priority Class: %w", err))
 	}
 
 	pod.Spec.PreemptionPolicy = pc.PreemptionPolicy
 	pod.Spec.Priority = &pc.Value
 	pod.Spec.PriorityClassName = pc.Name
-	// Marshal Pod
-	marshaled, err := json.Marshal(pod)
-	if err != nil {
-		return utils.ErroredResponse(err)
-	}
 
 	recorder.Eventf(tnt, corev1.EventTypeNormal, "TenantDefault", "Assigned Tenant default Priority Class %s to %s/%s", allowed.Default, pod.Namespace, pod.Name)
 
-	response := admission.PatchResponseFromRaw(req.Object.Raw, marshaled)
-
-	return &response
+	return true, nil
 }
@@ -0,0 +1,63 @@
+// Copyright 2020-2023 Project Capsule Authors.
+// SPDX-License-Identifier: Apache-2.0
+
+package defaults
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+
+	capsulev1beta2 "github.com/projectcapsule/capsule/api/v1beta2"
+	"github.com/projectcapsule/capsule/pkg/api"
+)
+
+func TestMutatePodNodePool(t *testing.T) {
+	requirement := func(key string, values ...string) corev1.NodeSelectorRequirement {
+		return corev1.NodeSelectorRequirement{Key: key, Operator: corev1.NodeSelectorOpIn, Values: values}
+	}
+
+	tnt := &capsulev1beta2.Tenant{
+		ObjectMeta: metav1.ObjectMeta{Name: "oil"},
+		Spec: capsulev1beta2.TenantSpec{
+			NodeSelector: map[string]string{"pool": "oil"},
+			NodePool: &api.NodePoolSpec{
+				InjectNodeSelector: true,
+				RequiredNodeAffinity: &corev1.NodeSelector{NodeSelectorTerms: []corev1.NodeSelectorTerm{
+					{MatchExpressions: []corev1.NodeSelectorRequirement{requirement("tier", "dedicated")}},
+				}},
+				Tolerations: []corev1.Toleration{{Key: "pool", Operator: corev1.TolerationOpEqual, Value: "oil", Effect: corev1.TaintEffectNoSchedule}},
+			},
+		},
+	}
+
+	pod := &corev1.Pod{
+		Spec: corev1.PodSpec{
+			NodeSelector: map[string]string{"pool": "gas", "disk": "ssd"},
+			Affinity: &corev1.Affinity{NodeAffinity: &corev1.NodeAffinity{RequiredDuringSchedulingIgnoredDuringExecution: &corev1.NodeSelector{
+				NodeSelectorTerms: []corev1.NodeSelectorTerm{
+					{MatchExpressions: []corev1.NodeSelectorRequirement{requirement("zone", "a")}},
+					{MatchExpressions: []corev1.NodeSelectorRequirement{requirement("zone", "b")}},
+				},
+			}}},
+			Tolerations: []corev1.Toleration{{Key: "pool", Operator: corev1.TolerationOpEqual, Value: "oil", Effect: corev1.TaintEffectNoSchedule}},
+		},
+	}
+
+	assert.True(t, mutatePodNodePool(record.NewFakeRecorder(1), pod, tnt))
+
+	assert.Equal(t, map[string]string{"pool": "oil", "disk": "ssd"}, pod.Spec.NodeSelector)
+	assert.Equal(t, []corev1.NodeSelectorTerm{
+		{MatchExpressions: []corev1.NodeSelectorRequirement{requirement("zone", "a"), requirement("tier", "dedicated")}},
+		{MatchExpressions: []corev1.NodeSelectorRequirement{requirement("zone", "b"), requirement("tier", "dedicated")}},
+	}, pod.Spec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms)
+	assert.Len(t, pod.Spec.Tolerations, 1)
+	// The Tenant terms are already combined with the Pod ones
+	assert.False(t, mutatePodNodePool(record.NewFakeRecorder(1), pod, tnt))
+	assert.Len(t, pod.Spec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms, 2)
+
+	assert.False(t, mutatePodNodePool(record.NewFakeRecorder(1), &corev1.Pod{}, &capsulev1beta2.Tenant{}))
+}
@@ -0,0 +1,74 @@
+// Copyright 2020-2023 Project Capsule Authors.
+// SPDX-License-Identifier: Apache-2.0
+
+package defaults
+
+import (
+	"context"
+	"encoding/json"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	capsulev1beta2 "github.com/projectcapsule/capsule/api/v1beta2"
+	"github.com/projectcapsule/capsule/pkg/webhook/utils"
+)
+
+func mutateServiceDefaults(ctx context.Context, req admission.Request, c client.Client, decoder *admission.Decoder, recorder record.EventRecorder, namespace string) *admission.Response {
+	var err error
+
+	svc := &corev1.Service{}
+	if err = decoder.Decode(req, svc); err != nil {
+		return utils.ErroredResponse(err)
+	}
+
+	svc.SetNamespace(namespace)
+	// The LoadBalancer class is immutable, thus it can be assigned just when the Service becomes of type LoadBalancer
+	if svc.Spec.Type != corev1.ServiceTypeLoadBalancer || svc.Spec.LoadBalancerClass != nil {
+		return nil
+	}
+
+	if req.Operation == admissionv1.Update {
+		oldSvc := &corev1.Service{}
+		if err = decoder.DecodeRaw(req.OldObject, oldSvc); err != nil {
+			return utils.ErroredResponse(err)
+		}
+
+		if oldSvc.Spec.Type == corev1.ServiceTypeLoadBalancer {
+			return nil
+		}
+	}
+
+	var tnt *capsulev1beta2.Tenant
+
+	tnt, err = utils.TenantByStatusNamespace(ctx, c, svc.Namespace)
+	if err != nil {
+		return utils.ErroredResponse(err)
+	}
+
+	if tnt == nil || tnt.Spec.ServiceOptions == nil || tnt.Spec.ServiceOptions.LoadBalancer == nil {
+		return nil
+	}
+
+	allowed := tnt.Spec.ServiceOptions.LoadBalancer.AllowedClasses
+
+	if allowed == nil || allowed.Default == "" {
+		return nil
+	}
+
+	svc.Spec.LoadBalancerClass = &allowed.Default
+	// Marshal Manifest
+	marshaled, err := json.Marshal(svc)
+	if err != nil {
+		return utils.ErroredResponse(err)
+	}
+
+	recorder.Eventf(tnt, corev1.EventTypeNormal, "TenantDefault", "Assigned Tenant default LoadBalancer Class %s to %s/%s", allowed.Default, svc.Namespace, svc.Name)
+
+	response := admission.PatchResponseFromRaw(req.Object.Raw, marshaled)
+
+	return &response
+}
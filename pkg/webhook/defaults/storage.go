@@ -15,6 +15,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	capsulev1beta2 "github.com/projectcapsule/capsule/api/v1beta2"
+	capsuleutils "github.com/projectcapsule/capsule/pkg/utils"
 	"github.com/projectcapsule/capsule/pkg/webhook/utils"
 )
 
@@ -38,6 +39,10 @@ func mutatePVCDefaults(ctx context.Context, req admission.Request, c client.Clie
 	if tnt == nil {
 		return nil
 	}
+	// StorageClasses could be inherited by the parent Tenant
+	if err = capsuleutils.ResolveTenantInheritance(ctx, c, tnt); err != nil {
+		return utils.ErroredResponse(err)
+	}
 
 	allowed := tnt.Spec.StorageClasses
 
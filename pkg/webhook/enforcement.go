@@ -0,0 +1,75 @@
+// Copyright 2020-2023 Project Capsule Authors.
+// SPDX-License-Identifier: Apache-2.0
+
+package webhook
+
+import (
+	capsulev1beta2 "github.com/projectcapsule/capsule/api/v1beta2"
+	"github.com/projectcapsule/capsule/pkg/api"
+)
+
+// softenablePolicies are the reasons of the Tenant policies which enforcement can be softened with the Warn and Audit modes:
+// any other denial, such as the quotas, the cordoning, and the protection of the Capsule managed resources,
+// is always enforced.
+var softenablePolicies = map[string]struct{}{
+	// Container registries and images
+	"ForbiddenContainerRegistry": {},
+	"MissingFQCI":                {},
+	"ForbiddenPullPolicy":        {},
+	"ForbiddenImage":             {},
+	"ForbiddenImageRepository":   {},
+	"ForbiddenImageLatestTag":    {},
+	"MissingImageDigest":         {},
+	// Pod classes, security, and volumes
+	"ForbiddenPriorityClass":       {},
+	"ForbiddenRuntimeClass":        {},
+	"ForbiddenPrivilegedContainer": {},
+	"ForbiddenHostNetwork":         {},
+	"ForbiddenCapability":          {},
+	"ForbiddenHostPathVolume":      {},
+	"ForbiddenVolumeType":          {},
+	"ForbiddenHostPath":            {},
+	"ForbiddenWritableHostPath":    {},
+	"ForbiddenNFSServer":           {},
+	"ForbiddenCSIDriver":           {},
+	// Storage classes
+	"ForbiddenStorageClass": {},
+	"MissingStorageClass":   {},
+	// Ingresses and Gateways
+	"ForbiddenIngressClass":   {},
+	"MissingIngressClass":     {},
+	"IngressHostnameEmpty":    {},
+	"IngressHostnameNotValid": {},
+	"Wildcard denied":         {},
+	"ForbiddenGateway":        {},
+	// Services
+	"ForbiddenExternalName":            {},
+	"ForbiddenLoadBalancer":            {},
+	"ForbiddenNodePort":                {},
+	"ForbiddenExternalServiceIP":       {},
+	"ForbiddenNodePortRange":           {},
+	"ForbiddenLoadBalancerClass":       {},
+	"MissingLoadBalancerClass":         {},
+	"ForbiddenLoadBalancerIP":          {},
+	"ForbiddenLoadBalancerSourceRange": {},
+	// Labels and annotations
+	api.ForbiddenLabelReason:      {},
+	api.ForbiddenAnnotationReason: {},
+}
+
+// IsSoftenablePolicy returns true if the enforcement of the policy identified by the given reason can be softened.
+func IsSoftenablePolicy(reason string) bool {
+	_, ok := softenablePolicies[reason]
+
+	return ok
+}
+
+// EnforcementModeFor returns the enforcement mode of the Tenant for the policy identified by the given reason:
+// the policies which cannot be softened, as well as the ones of the objects not belonging to any Tenant, are enforced.
+func EnforcementModeFor(tnt *capsulev1beta2.Tenant, reason string) api.EnforcementMode {
+	if tnt == nil || !IsSoftenablePolicy(reason) {
+		return api.EnforcementModeEnforce
+	}
+
+	return tnt.Spec.Enforcement.ModeFor(reason)
+}
@@ -114,3 +114,45 @@ func appendHostnameError(spec api.AllowedListSpec) (append string) {
 
 	return
 }
+
+type gatewayForbiddenError struct {
+	gateway string
+	spec    api.AllowedListSpec
+}
+
+func NewGatewayForbidden(gateway string, spec api.AllowedListSpec) error {
+	return &gatewayForbiddenError{
+		gateway: gateway,
+		spec:    spec,
+	}
+}
+
+func (g gatewayForbiddenError) Error() string {
+	return fmt.Sprintf("Gateway %s is forbidden for the current Tenant%s", g.gateway, appendHostnameError(g.spec))
+}
+
+type ingressPathOverlapError struct {
+	hostname    string
+	path        string
+	overlapping string
+}
+
+func NewIngressPathOverlap(hostname, path, overlapping string) error {
+	return &ingressPathOverlapError{hostname: hostname, path: path, overlapping: overlapping}
+}
+
+func (i ingressPathOverlapError) Error() string {
+	return fmt.Sprintf("path %s of hostname %s is overlapping with the path %s used by another Tenant: please, reach out to the system administrators", i.path, i.hostname, i.overlapping)
+}
+
+type ingressHostnameReservedError struct {
+	hostname string
+}
+
+func NewIngressHostnameReserved(hostname string) error {
+	return &ingressHostnameReservedError{hostname: hostname}
+}
+
+func (i ingressHostnameReservedError) Error() string {
+	return fmt.Sprintf("hostname %s is reserved by another Tenant: please, reach out to the system administrators", i.hostname)
+}
@@ -0,0 +1,54 @@
+// Copyright 2020-2023 Project Capsule Authors.
+// SPDX-License-Identifier: Apache-2.0
+
+package ingress
+
+import (
+	"strings"
+)
+
+type PathType string
+
+const (
+	PathTypeExact                  PathType = "Exact"
+	PathTypePrefix                 PathType = "Prefix"
+	PathTypeImplementationSpecific PathType = "ImplementationSpecific"
+)
+
+// PathMatch is a path matched by a rule, along with the type of the match.
+type PathMatch struct {
+	Path string
+	Type PathType
+}
+
+// Overlaps returns true when a request path exists which is matched by both the paths.
+// The ImplementationSpecific paths are considered as prefixes, the most conservative choice
+// since their semantics depend on the Ingress Controller.
+func (p PathMatch) Overlaps(other PathMatch) bool {
+	switch {
+	case p.Type == PathTypeExact && other.Type == PathTypeExact:
+		return normalizePath(p.Path) == normalizePath(other.Path)
+	case p.Type == PathTypeExact:
+		return pathHasPrefix(p.Path, other.Path)
+	case other.Type == PathTypeExact:
+		return pathHasPrefix(other.Path, p.Path)
+	default:
+		return pathHasPrefix(p.Path, other.Path) || pathHasPrefix(other.Path, p.Path)
+	}
+}
+
+// pathHasPrefix checks if the path is matched by the prefix, element-wise split by the slashes:
+// the /foo prefix matches the /foo/bar path, but not the /foobar one.
+func pathHasPrefix(path, prefix string) bool {
+	path, prefix = normalizePath(path), strings.TrimSuffix(normalizePath(prefix), "/")
+
+	return path == prefix || strings.HasPrefix(path, prefix+"/")
+}
+
+func normalizePath(path string) string {
+	if len(path) == 0 {
+		return "/"
+	}
+
+	return path
+}
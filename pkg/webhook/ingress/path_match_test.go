@@ -0,0 +1,42 @@
+// Copyright 2020-2023 Project Capsule Authors.
+// SPDX-License-Identifier: Apache-2.0
+
+package ingress
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPathMatchOverlaps(t *testing.T) {
+	exact := func(path string) PathMatch {
+		return PathMatch{Path: path, Type: PathTypeExact}
+	}
+
+	prefix := func(path string) PathMatch {
+		return PathMatch{Path: path, Type: PathTypePrefix}
+	}
+
+	for _, tc := range []struct {
+		a, b     PathMatch
+		expected bool
+	}{
+		{exact("/api"), exact("/api"), true},
+		{exact("/api"), exact("/api/v1"), false},
+		{exact("/api/v1"), prefix("/api"), true},
+		{exact("/api/v1"), prefix("/api/"), true},
+		{exact("/apis"), prefix("/api"), false},
+		{exact("/api"), prefix("/api/v1"), false},
+		{prefix("/api"), prefix("/api/v1"), true},
+		{prefix("/api/v1"), prefix("/api"), true},
+		{prefix("/api"), prefix("/apis"), false},
+		{prefix("/"), prefix("/api"), true},
+		{prefix(""), exact("/api"), true},
+		{PathMatch{Path: "/api", Type: PathTypeImplementationSpecific}, exact("/api/v1"), true},
+	} {
+		assert.Equal(t, tc.expected, tc.a.Overlaps(tc.b), fmt.Sprintf("%v %v", tc.a, tc.b))
+		assert.Equal(t, tc.expected, tc.b.Overlaps(tc.a), fmt.Sprintf("%v %v", tc.b, tc.a))
+	}
+}
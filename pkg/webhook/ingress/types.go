@@ -4,27 +4,46 @@
 package ingress
 
 import (
+	"fmt"
 	"sort"
+	"strings"
 
 	extensionsv1beta1 "k8s.io/api/extensions/v1beta1"
 	networkingv1 "k8s.io/api/networking/v1"
 	networkingv1beta1 "k8s.io/api/networking/v1beta1"
 	"k8s.io/apimachinery/pkg/util/sets"
+	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+	gatewayv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
 )
 
 const (
 	annotationName = "kubernetes.io/ingress.class"
 )
 
-type Ingress interface {
-	IngressClass() *string
+// Routable is implemented by the resources routing the traffic by hostname,
+// such as the Ingress and the Gateway API routes.
+type Routable interface {
 	Namespace() string
 	Name() string
 	HostnamePathsPairs() map[string]sets.Set[string]
+	// HostnamePathMatches returns the paths matched for each hostname, along with their type.
+	HostnamePathMatches() map[string][]PathMatch
+}
+
+type Ingress interface {
+	Routable
+	IngressClass() *string
 	SetIngressClass(string)
 	SetNamespace(string)
 }
 
+// Route is a Gateway API route, attached to its parent Gateways.
+type Route interface {
+	Routable
+	// ParentGateways returns the Gateways referenced by the route, in the namespace/name form.
+	ParentGateways() []string
+}
+
 type NetworkingV1 struct {
 	*networkingv1.Ingress
 }
@@ -95,6 +114,25 @@ func (n NetworkingV1) HostnamePathsPairs() (pairs map[string]sets.Set[string]) {
 	return pairs
 }
 
+//nolint:dupl
+func (n NetworkingV1) HostnamePathMatches() (matches map[string][]PathMatch) {
+	matches = make(map[string][]PathMatch)
+
+	for _, rule := range n.Spec.Rules {
+		if _, ok := matches[rule.Host]; !ok {
+			matches[rule.Host] = nil
+		}
+
+		if http := rule.HTTP; http != nil {
+			for _, path := range http.Paths {
+				matches[rule.Host] = append(matches[rule.Host], ingressPathMatch(path.Path, (*string)(path.PathType)))
+			}
+		}
+	}
+
+	return matches
+}
+
 type NetworkingV1Beta1 struct {
 	*networkingv1beta1.Ingress
 }
@@ -165,6 +203,25 @@ func (n NetworkingV1Beta1) HostnamePathsPairs() (pairs map[string]sets.Set[strin
 	return pairs
 }
 
+//nolint:dupl
+func (n NetworkingV1Beta1) HostnamePathMatches() (matches map[string][]PathMatch) {
+	matches = make(map[string][]PathMatch)
+
+	for _, rule := range n.Spec.Rules {
+		if _, ok := matches[rule.Host]; !ok {
+			matches[rule.Host] = nil
+		}
+
+		if http := rule.HTTP; http != nil {
+			for _, path := range http.Paths {
+				matches[rule.Host] = append(matches[rule.Host], ingressPathMatch(path.Path, (*string)(path.PathType)))
+			}
+		}
+	}
+
+	return matches
+}
+
 type Extension struct {
 	*extensionsv1beta1.Ingress
 }
@@ -233,6 +290,300 @@ func (e Extension) HostnamePathsPairs() (pairs map[string]sets.Set[string]) {
 	return pairs
 }
 
+//nolint:dupl
+func (e Extension) HostnamePathMatches() (matches map[string][]PathMatch) {
+	matches = make(map[string][]PathMatch)
+
+	for _, rule := range e.Spec.Rules {
+		if _, ok := matches[rule.Host]; !ok {
+			matches[rule.Host] = nil
+		}
+
+		if http := rule.HTTP; http != nil {
+			for _, path := range http.Paths {
+				matches[rule.Host] = append(matches[rule.Host], ingressPathMatch(path.Path, (*string)(path.PathType)))
+			}
+		}
+	}
+
+	return matches
+}
+
+type HTTPRoute struct {
+	*gatewayv1beta1.HTTPRoute
+}
+
+func (r HTTPRoute) Name() string {
+	return r.GetName()
+}
+
+func (r HTTPRoute) Namespace() string {
+	return r.GetNamespace()
+}
+
+func (r HTTPRoute) ParentGateways() []string {
+	return parentGateways(r.GetNamespace(), r.Spec.ParentRefs)
+}
+
+// HostnamePathsPairs returns the path values of the matches for each hostname:
+// a rule with no path match matches any path, as the "/" prefix.
+func (r HTTPRoute) HostnamePathsPairs() map[string]sets.Set[string] {
+	paths := sets.New[string]()
+
+	for _, rule := range r.Spec.Rules {
+		if len(rule.Matches) == 0 {
+			paths.Insert("/")
+		}
+
+		for _, match := range rule.Matches {
+			if match.Path == nil || match.Path.Value == nil {
+				paths.Insert("/")
+
+				continue
+			}
+
+			paths.Insert(*match.Path.Value)
+		}
+	}
+
+	if paths.Len() == 0 {
+		paths.Insert("/")
+	}
+
+	return routeHostnamePathsPairs(r.Spec.Hostnames, paths)
+}
+
+// HostnamePathMatches returns the path matches for each hostname:
+// the regular expressions are considered as implementation specific.
+func (r HTTPRoute) HostnamePathMatches() map[string][]PathMatch {
+	var matches []PathMatch
+
+	for _, rule := range r.Spec.Rules {
+		if len(rule.Matches) == 0 {
+			matches = append(matches, PathMatch{Path: "/", Type: PathTypePrefix})
+		}
+
+		for _, match := range rule.Matches {
+			if match.Path == nil {
+				matches = append(matches, PathMatch{Path: "/", Type: PathTypePrefix})
+
+				continue
+			}
+
+			pathMatch := PathMatch{Path: "/", Type: PathTypePrefix}
+
+			if match.Path.Value != nil {
+				pathMatch.Path = *match.Path.Value
+			}
+
+			if match.Path.Type != nil {
+				//nolint:exhaustive
+				switch *match.Path.Type {
+				case gatewayv1beta1.PathMatchExact:
+					pathMatch.Type = PathTypeExact
+				case gatewayv1beta1.PathMatchRegularExpression:
+					pathMatch.Type = PathTypeImplementationSpecific
+				}
+			}
+
+			matches = append(matches, pathMatch)
+		}
+	}
+
+	if len(matches) == 0 {
+		matches = append(matches, PathMatch{Path: "/", Type: PathTypePrefix})
+	}
+
+	return routeHostnamePathMatches(r.Spec.Hostnames, matches)
+}
+
+type GRPCRoute struct {
+	*gatewayv1alpha2.GRPCRoute
+}
+
+func (r GRPCRoute) Name() string {
+	return r.GetName()
+}
+
+func (r GRPCRoute) Namespace() string {
+	return r.GetNamespace()
+}
+
+func (r GRPCRoute) ParentGateways() []string {
+	return parentGateways(r.GetNamespace(), r.Spec.ParentRefs)
+}
+
+// HostnamePathsPairs returns the gRPC methods matched for each hostname, in the /service/method form:
+// a rule with no method match matches any method, as the "/" path.
+func (r GRPCRoute) HostnamePathsPairs() map[string]sets.Set[string] {
+	paths := sets.New[string]()
+
+	for _, rule := range r.Spec.Rules {
+		if len(rule.Matches) == 0 {
+			paths.Insert("/")
+		}
+
+		for _, match := range rule.Matches {
+			paths.Insert(grpcMethodPath(match.Method))
+		}
+	}
+
+	if paths.Len() == 0 {
+		paths.Insert("/")
+	}
+
+	return routeHostnamePathsPairs(r.Spec.Hostnames, paths)
+}
+
+// HostnamePathMatches returns the gRPC methods matched for each hostname:
+// a method is matched exactly, while a service matches all its methods, as a prefix.
+func (r GRPCRoute) HostnamePathMatches() map[string][]PathMatch {
+	var matches []PathMatch
+
+	for _, rule := range r.Spec.Rules {
+		if len(rule.Matches) == 0 {
+			matches = append(matches, PathMatch{Path: "/", Type: PathTypePrefix})
+		}
+
+		for _, match := range rule.Matches {
+			pathMatch := PathMatch{Path: grpcMethodPath(match.Method), Type: PathTypePrefix}
+
+			if method := match.Method; method != nil && method.Method != nil && len(*method.Method) > 0 {
+				pathMatch.Type = PathTypeExact
+			}
+
+			if method := match.Method; method != nil && method.Type != nil && *method.Type == gatewayv1alpha2.GRPCMethodMatchRegularExpression {
+				pathMatch.Type = PathTypeImplementationSpecific
+			}
+
+			matches = append(matches, pathMatch)
+		}
+	}
+
+	if len(matches) == 0 {
+		matches = append(matches, PathMatch{Path: "/", Type: PathTypePrefix})
+	}
+
+	return routeHostnamePathMatches(r.Spec.Hostnames, matches)
+}
+
+type TLSRoute struct {
+	*gatewayv1alpha2.TLSRoute
+}
+
+func (r TLSRoute) Name() string {
+	return r.GetName()
+}
+
+func (r TLSRoute) Namespace() string {
+	return r.GetNamespace()
+}
+
+func (r TLSRoute) ParentGateways() []string {
+	return parentGateways(r.GetNamespace(), r.Spec.ParentRefs)
+}
+
+// HostnamePathsPairs returns the hostnames along with an empty path,
+// since the TLS traffic is routed by the SNI only.
+func (r TLSRoute) HostnamePathsPairs() map[string]sets.Set[string] {
+	return routeHostnamePathsPairs(r.Spec.Hostnames, sets.New[string](""))
+}
+
+// HostnamePathMatches returns the hostnames along with the root prefix,
+// since the TLS traffic for the whole hostname is routed.
+func (r TLSRoute) HostnamePathMatches() map[string][]PathMatch {
+	return routeHostnamePathMatches(r.Spec.Hostnames, []PathMatch{{Path: "/", Type: PathTypePrefix}})
+}
+
+// routeHostnamePathsPairs maps the paths to the hostnames of the route:
+// a route with no hostnames is reported with an empty one, as for an Ingress rule with no host.
+func routeHostnamePathsPairs(hostnames []gatewayv1beta1.Hostname, paths sets.Set[string]) map[string]sets.Set[string] {
+	pairs := make(map[string]sets.Set[string])
+
+	if len(hostnames) == 0 {
+		pairs[""] = paths
+
+		return pairs
+	}
+
+	for _, hostname := range hostnames {
+		pairs[string(hostname)] = paths.Clone()
+	}
+
+	return pairs
+}
+
+func routeHostnamePathMatches(hostnames []gatewayv1beta1.Hostname, matches []PathMatch) map[string][]PathMatch {
+	pathMatches := make(map[string][]PathMatch)
+
+	if len(hostnames) == 0 {
+		pathMatches[""] = matches
+
+		return pathMatches
+	}
+
+	for _, hostname := range hostnames {
+		pathMatches[string(hostname)] = matches
+	}
+
+	return pathMatches
+}
+
+// ingressPathMatch returns the match of an Ingress path:
+// the path type is implementation specific if not specified.
+func ingressPathMatch(path string, pathType *string) PathMatch {
+	match := PathMatch{Path: path, Type: PathTypeImplementationSpecific}
+
+	if pathType != nil {
+		match.Type = PathType(*pathType)
+	}
+
+	return match
+}
+
+func grpcMethodPath(method *gatewayv1alpha2.GRPCMethodMatch) string {
+	if method == nil {
+		return "/"
+	}
+
+	elems := []string{""}
+
+	if method.Service != nil && len(*method.Service) > 0 {
+		elems = append(elems, *method.Service)
+	}
+
+	if method.Method != nil && len(*method.Method) > 0 {
+		elems = append(elems, *method.Method)
+	}
+
+	if len(elems) == 1 {
+		return "/"
+	}
+
+	return strings.Join(elems, "/")
+}
+
+func parentGateways(namespace string, refs []gatewayv1beta1.ParentReference) (gateways []string) {
+	for _, ref := range refs {
+		if ref.Group != nil && string(*ref.Group) != gatewayv1beta1.GroupName {
+			continue
+		}
+
+		if ref.Kind != nil && string(*ref.Kind) != "Gateway" {
+			continue
+		}
+
+		ns := namespace
+		if ref.Namespace != nil && len(*ref.Namespace) > 0 {
+			ns = string(*ref.Namespace)
+		}
+
+		gateways = append(gateways, fmt.Sprintf("%s/%s", ns, ref.Name))
+	}
+
+	return gateways
+}
+
 type HostnamesList []string
 
 func (h HostnamesList) Len() int {
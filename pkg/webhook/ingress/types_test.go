@@ -0,0 +1,149 @@
+// Copyright 2020-2023 Project Capsule Authors.
+// SPDX-License-Identifier: Apache-2.0
+
+package ingress
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/pointer"
+	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+	gatewayv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+)
+
+func TestHTTPRouteHostnamePathsPairs(t *testing.T) {
+	route := HTTPRoute{HTTPRoute: &gatewayv1beta1.HTTPRoute{
+		Spec: gatewayv1beta1.HTTPRouteSpec{
+			Hostnames: []gatewayv1beta1.Hostname{"www.acme.com", "*.acme.com"},
+			Rules: []gatewayv1beta1.HTTPRouteRule{
+				{
+					Matches: []gatewayv1beta1.HTTPRouteMatch{
+						{Path: &gatewayv1beta1.HTTPPathMatch{Value: pointer.String("/api")}},
+						{Headers: []gatewayv1beta1.HTTPHeaderMatch{{Name: "version", Value: "v2"}}},
+					},
+				},
+			},
+		},
+	}}
+
+	expected := map[string]sets.Set[string]{
+		"www.acme.com": sets.New[string]("/api", "/"),
+		"*.acme.com":   sets.New[string]("/api", "/"),
+	}
+	assert.Equal(t, expected, route.HostnamePathsPairs())
+
+	route.Spec = gatewayv1beta1.HTTPRouteSpec{}
+	assert.Equal(t, map[string]sets.Set[string]{"": sets.New[string]("/")}, route.HostnamePathsPairs())
+}
+
+func TestGRPCRouteHostnamePathsPairs(t *testing.T) {
+	route := GRPCRoute{GRPCRoute: &gatewayv1alpha2.GRPCRoute{
+		Spec: gatewayv1alpha2.GRPCRouteSpec{
+			Hostnames: []gatewayv1alpha2.Hostname{"grpc.acme.com"},
+			Rules: []gatewayv1alpha2.GRPCRouteRule{
+				{
+					Matches: []gatewayv1alpha2.GRPCRouteMatch{
+						{Method: &gatewayv1alpha2.GRPCMethodMatch{Service: pointer.String("acme.Echo"), Method: pointer.String("Ping")}},
+						{Method: &gatewayv1alpha2.GRPCMethodMatch{Service: pointer.String("acme.Health")}},
+						{},
+					},
+				},
+			},
+		},
+	}}
+
+	expected := map[string]sets.Set[string]{
+		"grpc.acme.com": sets.New[string]("/acme.Echo/Ping", "/acme.Health", "/"),
+	}
+	assert.Equal(t, expected, route.HostnamePathsPairs())
+}
+
+func TestTLSRouteHostnamePathsPairs(t *testing.T) {
+	route := TLSRoute{TLSRoute: &gatewayv1alpha2.TLSRoute{
+		Spec: gatewayv1alpha2.TLSRouteSpec{
+			Hostnames: []gatewayv1alpha2.Hostname{"tls.acme.com"},
+		},
+	}}
+
+	assert.Equal(t, map[string]sets.Set[string]{"tls.acme.com": sets.New[string]("")}, route.HostnamePathsPairs())
+}
+
+func TestParentGateways(t *testing.T) {
+	namespace, group, kind := gatewayv1beta1.Namespace("gateway-system"), gatewayv1beta1.Group("acme.com"), gatewayv1beta1.Kind("Service")
+
+	route := HTTPRoute{HTTPRoute: &gatewayv1beta1.HTTPRoute{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "oil-production"},
+		Spec: gatewayv1beta1.HTTPRouteSpec{
+			CommonRouteSpec: gatewayv1beta1.CommonRouteSpec{
+				ParentRefs: []gatewayv1beta1.ParentReference{
+					{Name: "internal"},
+					{Namespace: &namespace, Name: "public"},
+					{Group: &group, Name: "custom"},
+					{Kind: &kind, Name: "mesh"},
+				},
+			},
+		},
+	}}
+
+	assert.Equal(t, []string{"oil-production/internal", "gateway-system/public"}, route.ParentGateways())
+}
+
+func TestHostnamePathMatches(t *testing.T) {
+	prefix, exact := networkingv1.PathTypePrefix, networkingv1.PathTypeExact
+
+	ing := NetworkingV1{Ingress: &networkingv1.Ingress{
+		Spec: networkingv1.IngressSpec{
+			Rules: []networkingv1.IngressRule{
+				{
+					Host: "www.acme.com",
+					IngressRuleValue: networkingv1.IngressRuleValue{
+						HTTP: &networkingv1.HTTPIngressRuleValue{
+							Paths: []networkingv1.HTTPIngressPath{
+								{Path: "/api", PathType: &prefix},
+								{Path: "/healthz", PathType: &exact},
+								{Path: "/legacy"},
+							},
+						},
+					},
+				},
+			},
+		},
+	}}
+
+	assert.Equal(t, map[string][]PathMatch{
+		"www.acme.com": {
+			{Path: "/api", Type: PathTypePrefix},
+			{Path: "/healthz", Type: PathTypeExact},
+			{Path: "/legacy", Type: PathTypeImplementationSpecific},
+		},
+	}, ing.HostnamePathMatches())
+
+	pathExact, pathRegex := gatewayv1beta1.PathMatchExact, gatewayv1beta1.PathMatchRegularExpression
+
+	route := HTTPRoute{HTTPRoute: &gatewayv1beta1.HTTPRoute{
+		Spec: gatewayv1beta1.HTTPRouteSpec{
+			Hostnames: []gatewayv1beta1.Hostname{"www.acme.com"},
+			Rules: []gatewayv1beta1.HTTPRouteRule{
+				{
+					Matches: []gatewayv1beta1.HTTPRouteMatch{
+						{Path: &gatewayv1beta1.HTTPPathMatch{Type: &pathExact, Value: pointer.String("/healthz")}},
+						{Path: &gatewayv1beta1.HTTPPathMatch{Type: &pathRegex, Value: pointer.String("/v[0-9]+")}},
+					},
+				},
+				{},
+			},
+		},
+	}}
+
+	assert.Equal(t, map[string][]PathMatch{
+		"www.acme.com": {
+			{Path: "/healthz", Type: PathTypeExact},
+			{Path: "/v[0-9]+", Type: PathTypeImplementationSpecific},
+			{Path: "/", Type: PathTypePrefix},
+		},
+	}, route.HostnamePathMatches())
+}
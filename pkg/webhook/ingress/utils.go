@@ -13,11 +13,14 @@ import (
 	"k8s.io/apimachinery/pkg/fields"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+	gatewayv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
 
 	capsulev1beta2 "github.com/projectcapsule/capsule/api/v1beta2"
+	capsuleutils "github.com/projectcapsule/capsule/pkg/utils"
 )
 
-func TenantFromIngress(ctx context.Context, c client.Client, ingress Ingress) (*capsulev1beta2.Tenant, error) {
+func TenantFromIngress(ctx context.Context, c client.Client, ingress Routable) (*capsulev1beta2.Tenant, error) {
 	tenantList := &capsulev1beta2.TenantList{}
 	if err := c.List(ctx, tenantList, client.MatchingFieldsSelector{
 		Selector: fields.OneTermEqualSelector(".status.namespaces", ingress.Namespace()),
@@ -29,7 +32,13 @@ func TenantFromIngress(ctx context.Context, c client.Client, ingress Ingress) (*
 		return nil, nil //nolint:nilnil
 	}
 
-	return &tenantList.Items[0], nil
+	tnt := &tenantList.Items[0]
+	// Ingress options could be inherited by the parent Tenant
+	if err := capsuleutils.ResolveTenantInheritance(ctx, c, tnt); err != nil {
+		return nil, err
+	}
+
+	return tnt, nil
 }
 
 //nolint:nakedret
@@ -66,3 +75,80 @@ func FromRequest(req admission.Request, decoder *admission.Decoder) (ingress Ing
 
 	return
 }
+
+// RoutableFromRequest decodes either an Ingress, or a Gateway API route.
+func RoutableFromRequest(req admission.Request, decoder *admission.Decoder) (Routable, error) {
+	if req.Kind.Group == gatewayv1beta1.GroupName {
+		return RouteFromRequest(req, decoder)
+	}
+
+	return FromRequest(req, decoder)
+}
+
+//nolint:nakedret
+func RouteFromRequest(req admission.Request, decoder *admission.Decoder) (route Route, err error) {
+	if req.Kind.Group != gatewayv1beta1.GroupName {
+		err = fmt.Errorf("cannot recognize type %s", req.Kind.Group)
+
+		return
+	}
+
+	switch req.Kind.Kind {
+	case "HTTPRoute":
+		if req.Kind.Version == gatewayv1alpha2.GroupVersion.Version {
+			routeObj := &gatewayv1alpha2.HTTPRoute{}
+			if err = decoder.Decode(req, routeObj); err != nil {
+				return
+			}
+			// The v1alpha2 HTTPRoute shares the same schema of the v1beta1 one
+			route = HTTPRoute{HTTPRoute: (*gatewayv1beta1.HTTPRoute)(routeObj)}
+
+			break
+		}
+
+		routeObj := &gatewayv1beta1.HTTPRoute{}
+		if err = decoder.Decode(req, routeObj); err != nil {
+			return
+		}
+
+		route = HTTPRoute{HTTPRoute: routeObj}
+	case "GRPCRoute":
+		routeObj := &gatewayv1alpha2.GRPCRoute{}
+		if err = decoder.Decode(req, routeObj); err != nil {
+			return
+		}
+
+		route = GRPCRoute{GRPCRoute: routeObj}
+	case "TLSRoute":
+		routeObj := &gatewayv1alpha2.TLSRoute{}
+		if err = decoder.Decode(req, routeObj); err != nil {
+			return
+		}
+
+		route = TLSRoute{TLSRoute: routeObj}
+	default:
+		err = fmt.Errorf("cannot recognize kind %s", req.Kind.Kind)
+	}
+
+	return
+}
+
+// RoutableFromObject wraps the Ingress, or the Gateway API route, retrieved from the API Server.
+func RoutableFromObject(obj client.Object) Routable {
+	switch o := obj.(type) {
+	case *networkingv1.Ingress:
+		return NetworkingV1{Ingress: o}
+	case *networkingv1beta1.Ingress:
+		return NetworkingV1Beta1{Ingress: o}
+	case *extensionsv1beta1.Ingress:
+		return Extension{Ingress: o}
+	case *gatewayv1beta1.HTTPRoute:
+		return HTTPRoute{HTTPRoute: o}
+	case *gatewayv1alpha2.GRPCRoute:
+		return GRPCRoute{GRPCRoute: o}
+	case *gatewayv1alpha2.TLSRoute:
+		return TLSRoute{TLSRoute: o}
+	default:
+		return nil
+	}
+}
@@ -12,11 +12,13 @@ import (
 	extensionsv1beta1 "k8s.io/api/extensions/v1beta1"
 	networkingv1 "k8s.io/api/networking/v1"
 	networkingv1beta1 "k8s.io/api/networking/v1beta1"
+	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/fields"
-	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+	gatewayv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
 
 	capsulev1beta2 "github.com/projectcapsule/capsule/api/v1beta2"
 	"github.com/projectcapsule/capsule/pkg/api"
@@ -53,7 +55,7 @@ func (r *collision) OnDelete(client.Client, *admission.Decoder, record.EventReco
 }
 
 func (r *collision) validate(ctx context.Context, client client.Client, req admission.Request, decoder *admission.Decoder, recorder record.EventRecorder) *admission.Response {
-	ing, err := FromRequest(req, decoder)
+	ing, err := RoutableFromRequest(req, decoder)
 	if err != nil {
 		return utils.ErroredResponse(err)
 	}
@@ -69,14 +71,16 @@ func (r *collision) validate(ctx context.Context, client client.Client, req admi
 		return nil
 	}
 
-	if err = r.validateCollision(ctx, client, ing, tenant.Spec.IngressOptions.HostnameCollisionScope); err == nil {
+	if err = r.validateCollision(ctx, client, ing, tenant); err == nil {
 		return nil
 	}
 
 	var collisionErr *ingressHostnameCollisionError
 
-	if errors.As(err, &collisionErr) {
-		recorder.Eventf(tenant, corev1.EventTypeWarning, "IngressHostnameCollision", "Ingress %s/%s hostname is colliding", ing.Namespace(), ing.Name())
+	var overlapErr *ingressPathOverlapError
+
+	if errors.As(err, &collisionErr) || errors.As(err, &overlapErr) {
+		recorder.Eventf(tenant, corev1.EventTypeWarning, "IngressHostnameCollision", "%s %s/%s hostname is colliding", req.Kind.Kind, ing.Namespace(), ing.Name())
 	}
 
 	response := admission.Denied(err.Error())
@@ -84,113 +88,97 @@ func (r *collision) validate(ctx context.Context, client client.Client, req admi
 	return &response
 }
 
-//nolint:gocognit,gocyclo,cyclop
-func (r *collision) validateCollision(ctx context.Context, clt client.Client, ing Ingress, scope api.HostnameCollisionScope) error {
+func (r *collision) validateCollision(ctx context.Context, clt client.Client, ing Routable, tnt *capsulev1beta2.Tenant) error {
+	// Namespaces in the collision scope, along with the name of their Tenant
+	namespaces := make(map[string]string)
+	//nolint:exhaustive
+	switch tnt.Spec.IngressOptions.HostnameCollisionScope {
+	case api.HostnameCollisionScopeCluster:
+		tenantList := &capsulev1beta2.TenantList{}
+		if err := clt.List(ctx, tenantList); err != nil {
+			return err
+		}
+
+		for _, tenant := range tenantList.Items {
+			for _, ns := range tenant.Status.Namespaces {
+				namespaces[ns] = tenant.GetName()
+			}
+		}
+	case api.HostnameCollisionScopeTenant:
+		for _, ns := range tnt.Status.Namespaces {
+			namespaces[ns] = tnt.GetName()
+		}
+	case api.HostnameCollisionScopeNamespace:
+		namespaces[ing.Namespace()] = tnt.GetName()
+	}
+
 	for hostname, paths := range ing.HostnamePathsPairs() {
 		for path := range paths {
-			var ingressObjList client.ObjectList
-
-			switch ing.(type) {
-			case Extension:
-				ingressObjList = &extensionsv1beta1.IngressList{}
-			case NetworkingV1:
-				ingressObjList = &networkingv1.IngressList{}
-			case NetworkingV1Beta1:
-				ingressObjList = &networkingv1beta1.IngressList{}
+			objList := newObjectList(ing)
+
+			fieldSelector := fields.OneTermEqualSelector(ingress.HostPathPair, fmt.Sprintf("%s;%s", hostname, path))
+
+			if err := clt.List(ctx, objList, client.MatchingFieldsSelector{Selector: fieldSelector}); err != nil {
+				return err
 			}
 
-			namespaces := sets.NewString()
-			//nolint:exhaustive
-			switch scope {
-			case api.HostnameCollisionScopeCluster:
-				tenantList := &capsulev1beta2.TenantList{}
-				if err := clt.List(ctx, tenantList); err != nil {
-					return err
-				}
+			items, err := meta.ExtractList(objList)
+			if err != nil {
+				return err
+			}
 
-				for _, tenant := range tenantList.Items {
-					namespaces.Insert(tenant.Status.Namespaces...)
+			for _, item := range items {
+				obj, ok := item.(client.Object)
+				if !ok {
+					continue
 				}
-			case api.HostnameCollisionScopeTenant:
-				selector := client.MatchingFieldsSelector{Selector: fields.OneTermEqualSelector(".status.namespaces", ing.Namespace())}
 
-				tenantList := &capsulev1beta2.TenantList{}
-				if err := clt.List(ctx, tenantList, selector); err != nil {
-					return err
+				if _, ok = namespaces[obj.GetNamespace()]; !ok {
+					continue
 				}
 
-				for _, tenant := range tenantList.Items {
-					namespaces.Insert(tenant.Status.Namespaces...)
+				if obj.GetName() == ing.Name() && obj.GetNamespace() == ing.Namespace() {
+					continue
 				}
-			case api.HostnameCollisionScopeNamespace:
-				namespaces.Insert(ing.Namespace())
-			}
 
-			fieldSelector := fields.OneTermEqualSelector(ingress.HostPathPair, fmt.Sprintf("%s;%s", hostname, path))
-
-			if err := clt.List(ctx, ingressObjList, client.MatchingFieldsSelector{Selector: fieldSelector}); err != nil {
-				return err
+				return NewIngressHostnameCollision(hostname)
 			}
+		}
+	}
+	// The overlapping paths, such as the /api prefix and the /api/v1 path,
+	// are colliding only when used by different Tenants.
+	for hostname, matches := range ing.HostnamePathMatches() {
+		objList := newObjectList(ing)
 
-			ingressList := sets.NewInt()
-
-			switch list := ingressObjList.(type) {
-			case *extensionsv1beta1.IngressList:
-				for index, item := range list.Items {
-					if namespaces.Has(item.GetNamespace()) {
-						ingressList.Insert(index)
-					}
-				}
+		if err := clt.List(ctx, objList, client.MatchingFieldsSelector{Selector: fields.OneTermEqualSelector(ingress.Host, hostname)}); err != nil {
+			return err
+		}
 
-				switch len(ingressList) {
-				case 0:
-					break
-				case 1:
-					if index := ingressList.List()[0]; list.Items[index].GetName() == ing.Name() && list.Items[index].GetNamespace() == ing.Namespace() {
-						break
-					}
+		items, err := meta.ExtractList(objList)
+		if err != nil {
+			return err
+		}
 
-					fallthrough
-				default:
-					return NewIngressHostnameCollision(hostname)
-				}
-			case *networkingv1.IngressList:
-				for index, item := range list.Items {
-					if namespaces.Has(item.GetNamespace()) {
-						ingressList.Insert(index)
-					}
-				}
+		for _, item := range items {
+			obj, ok := item.(client.Object)
+			if !ok {
+				continue
+			}
 
-				switch len(ingressList) {
-				case 0:
-					break
-				case 1:
-					if index := ingressList.List()[0]; list.Items[index].GetName() == ing.Name() && list.Items[index].GetNamespace() == ing.Namespace() {
-						break
-					}
+			if tenantName, ok := namespaces[obj.GetNamespace()]; !ok || tenantName == tnt.GetName() {
+				continue
+			}
 
-					fallthrough
-				default:
-					return NewIngressHostnameCollision(hostname)
-				}
-			case *networkingv1beta1.IngressList:
-				for index, item := range list.Items {
-					if namespaces.Has(item.GetNamespace()) {
-						ingressList.Insert(index)
-					}
-				}
+			other := RoutableFromObject(obj)
+			if other == nil {
+				continue
+			}
 
-				switch len(ingressList) {
-				case 0:
-					break
-				case 1:
-					if index := ingressList.List()[0]; list.Items[index].GetName() == ing.Name() && list.Items[index].GetNamespace() == ing.Namespace() {
-						break
+			for _, match := range matches {
+				for _, otherMatch := range other.HostnamePathMatches()[hostname] {
+					if match.Overlaps(otherMatch) {
+						return NewIngressPathOverlap(hostname, match.Path, otherMatch.Path)
 					}
-
-					fallthrough
-				default:
-					return NewIngressHostnameCollision(hostname)
 				}
 			}
 		}
@@ -198,3 +186,22 @@ func (r *collision) validateCollision(ctx context.Context, clt client.Client, in
 
 	return nil
 }
+
+// newObjectList returns the list of the resources of the same kind:
+// the hostnames are colliding only with these.
+func newObjectList(ing Routable) client.ObjectList {
+	switch ing.(type) {
+	case Extension:
+		return &extensionsv1beta1.IngressList{}
+	case NetworkingV1Beta1:
+		return &networkingv1beta1.IngressList{}
+	case HTTPRoute:
+		return &gatewayv1beta1.HTTPRouteList{}
+	case GRPCRoute:
+		return &gatewayv1alpha2.GRPCRouteList{}
+	case TLSRoute:
+		return &gatewayv1alpha2.TLSRouteList{}
+	default:
+		return &networkingv1.IngressList{}
+	}
+}
@@ -0,0 +1,72 @@
+// Copyright 2020-2023 Project Capsule Authors.
+// SPDX-License-Identifier: Apache-2.0
+
+package ingress
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	capsulewebhook "github.com/projectcapsule/capsule/pkg/webhook"
+	"github.com/projectcapsule/capsule/pkg/webhook/utils"
+)
+
+type gateway struct{}
+
+func Gateway() capsulewebhook.Handler {
+	return &gateway{}
+}
+
+func (h *gateway) OnCreate(client client.Client, decoder *admission.Decoder, recorder record.EventRecorder) capsulewebhook.Func {
+	return func(ctx context.Context, req admission.Request) *admission.Response {
+		return h.validate(ctx, client, req, decoder, recorder)
+	}
+}
+
+func (h *gateway) OnDelete(client.Client, *admission.Decoder, record.EventRecorder) capsulewebhook.Func {
+	return func(ctx context.Context, req admission.Request) *admission.Response {
+		return nil
+	}
+}
+
+func (h *gateway) OnUpdate(client client.Client, decoder *admission.Decoder, recorder record.EventRecorder) capsulewebhook.Func {
+	return func(ctx context.Context, req admission.Request) *admission.Response {
+		return h.validate(ctx, client, req, decoder, recorder)
+	}
+}
+
+func (h *gateway) validate(ctx context.Context, client client.Client, req admission.Request, decoder *admission.Decoder, recorder record.EventRecorder) *admission.Response {
+	route, err := RouteFromRequest(req, decoder)
+	if err != nil {
+		return utils.ErroredResponse(err)
+	}
+
+	tenant, err := TenantFromIngress(ctx, client, route)
+	if err != nil {
+		return utils.ErroredResponse(err)
+	}
+
+	if tenant == nil || tenant.Spec.IngressOptions.AllowedGateways == nil {
+		return nil
+	}
+
+	allowed := tenant.Spec.IngressOptions.AllowedGateways
+
+	for _, gateway := range route.ParentGateways() {
+		if allowed.Match(gateway) {
+			continue
+		}
+
+		recorder.Eventf(tenant, corev1.EventTypeWarning, "ForbiddenGateway", "%s %s/%s is attached to the forbidden Gateway %s", req.Kind.Kind, route.Namespace(), route.Name(), gateway)
+
+		response := admission.Denied(NewGatewayForbidden(gateway, *allowed).Error())
+
+		return &response
+	}
+
+	return nil
+}
@@ -47,7 +47,7 @@ func (r *hostnames) OnDelete(client.Client, *admission.Decoder, record.EventReco
 }
 
 func (r *hostnames) validate(ctx context.Context, client client.Client, req admission.Request, decoder *admission.Decoder, recorder record.EventRecorder) *admission.Response {
-	ingress, err := FromRequest(req, decoder)
+	ingress, err := RoutableFromRequest(req, decoder)
 	if err != nil {
 		return utils.ErroredResponse(err)
 	}
@@ -67,7 +67,7 @@ func (r *hostnames) validate(ctx context.Context, client client.Client, req admi
 
 	for hostname := range ingress.HostnamePathsPairs() {
 		if len(hostname) == 0 {
-			recorder.Eventf(tenant, corev1.EventTypeWarning, "IngressHostnameEmpty", "Ingress %s/%s hostname is empty", ingress.Namespace(), ingress.Name())
+			recorder.Eventf(tenant, corev1.EventTypeWarning, "IngressHostnameEmpty", "%s %s/%s hostname is empty", req.Kind.Kind, ingress.Namespace(), ingress.Name())
 
 			return utils.ErroredResponse(NewEmptyIngressHostname(*tenant.Spec.IngressOptions.AllowedHostnames))
 		}
@@ -82,7 +82,7 @@ func (r *hostnames) validate(ctx context.Context, client client.Client, req admi
 	var hostnameNotValidErr *ingressHostnameNotValidError
 
 	if errors.As(err, &hostnameNotValidErr) {
-		recorder.Eventf(tenant, corev1.EventTypeWarning, "IngressHostnameNotValid", "Ingress %s/%s hostname is not valid", ingress.Namespace(), ingress.Name())
+		recorder.Eventf(tenant, corev1.EventTypeWarning, "IngressHostnameNotValid", "%s %s/%s hostname is not valid", req.Kind.Kind, ingress.Namespace(), ingress.Name())
 
 		response := admission.Denied(err.Error())
 
@@ -0,0 +1,204 @@
+// Copyright 2020-2023 Project Capsule Authors.
+// SPDX-License-Identifier: Apache-2.0
+
+package ingress
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	extensionsv1beta1 "k8s.io/api/extensions/v1beta1"
+	networkingv1 "k8s.io/api/networking/v1"
+	networkingv1beta1 "k8s.io/api/networking/v1beta1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+	gatewayv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+
+	capsulev1beta2 "github.com/projectcapsule/capsule/api/v1beta2"
+	"github.com/projectcapsule/capsule/pkg/api"
+	"github.com/projectcapsule/capsule/pkg/indexer/ingress"
+	capsuleutils "github.com/projectcapsule/capsule/pkg/utils"
+	capsulewebhook "github.com/projectcapsule/capsule/pkg/webhook"
+	"github.com/projectcapsule/capsule/pkg/webhook/utils"
+)
+
+type ownership struct{}
+
+// Ownership denies the hostnames reserved by the Tenants claiming them first,
+// across the Ingress resources and the Gateway API routes.
+func Ownership() capsulewebhook.Handler {
+	return &ownership{}
+}
+
+func (h *ownership) OnCreate(client client.Client, decoder *admission.Decoder, recorder record.EventRecorder) capsulewebhook.Func {
+	return func(ctx context.Context, req admission.Request) *admission.Response {
+		return h.validate(ctx, client, req, decoder, recorder)
+	}
+}
+
+func (h *ownership) OnDelete(client.Client, *admission.Decoder, record.EventRecorder) capsulewebhook.Func {
+	return func(ctx context.Context, req admission.Request) *admission.Response {
+		return nil
+	}
+}
+
+func (h *ownership) OnUpdate(client client.Client, decoder *admission.Decoder, recorder record.EventRecorder) capsulewebhook.Func {
+	return func(ctx context.Context, req admission.Request) *admission.Response {
+		return h.validate(ctx, client, req, decoder, recorder)
+	}
+}
+
+func (h *ownership) validate(ctx context.Context, clt client.Client, req admission.Request, decoder *admission.Decoder, recorder record.EventRecorder) *admission.Response {
+	ing, err := RoutableFromRequest(req, decoder)
+	if err != nil {
+		return utils.ErroredResponse(err)
+	}
+
+	tnt, err := TenantFromIngress(ctx, clt, ing)
+	if err != nil {
+		return utils.ErroredResponse(err)
+	}
+
+	if tnt == nil {
+		return nil
+	}
+
+	tenantList := &capsulev1beta2.TenantList{}
+	if err = clt.List(ctx, tenantList); err != nil {
+		return utils.ErroredResponse(err)
+	}
+	// Ownership of the other Tenants, keyed by their Namespaces
+	owners := make(map[string]api.HostnameOwnership)
+
+	var enabled bool
+
+	for _, tenant := range tenantList.Items {
+		if tenant.GetName() == tnt.GetName() {
+			continue
+		}
+
+		for _, ns := range tenant.Status.Namespaces {
+			owners[ns] = tenant.Spec.IngressOptions.HostnameOwnership
+		}
+
+		enabled = enabled || isHostnameOwnershipEnabled(tenant.Spec.IngressOptions.HostnameOwnership)
+	}
+
+	if !enabled && !isHostnameOwnershipEnabled(tnt.Spec.IngressOptions.HostnameOwnership) {
+		return nil
+	}
+
+	if err = h.validateOwnership(ctx, clt, ing, tnt.Spec.IngressOptions.HostnameOwnership, owners); err == nil {
+		return nil
+	}
+
+	var reservedErr *ingressHostnameReservedError
+
+	if errors.As(err, &reservedErr) {
+		recorder.Eventf(tnt, corev1.EventTypeWarning, "IngressHostnameReserved", "%s %s/%s hostname is reserved by another Tenant", req.Kind.Kind, ing.Namespace(), ing.Name())
+
+		response := admission.Denied(err.Error())
+
+		return &response
+	}
+
+	return utils.ErroredResponse(err)
+}
+
+//nolint:gocognit
+func (h *ownership) validateOwnership(ctx context.Context, clt client.Client, ing Routable, ownership api.HostnameOwnership, owners map[string]api.HostnameOwnership) error {
+	for hostname, matches := range ing.HostnamePathMatches() {
+		// The resources with no hostname cannot reserve, nor use, a reserved one
+		if len(hostname) == 0 {
+			continue
+		}
+
+		for _, objList := range newOwnershipObjectLists(ing) {
+			if err := clt.List(ctx, objList, client.MatchingFieldsSelector{Selector: fields.OneTermEqualSelector(ingress.Host, hostname)}); err != nil {
+				if capsuleutils.IsUnsupportedAPI(err) {
+					continue
+				}
+
+				return err
+			}
+
+			items, err := meta.ExtractList(objList)
+			if err != nil {
+				return err
+			}
+
+			for _, item := range items {
+				obj, ok := item.(client.Object)
+				if !ok {
+					continue
+				}
+
+				owner, ok := owners[obj.GetNamespace()]
+				if !ok {
+					continue
+				}
+				// The strictest ownership between the Tenants applies
+				switch strictestHostnameOwnership(ownership, owner) {
+				case api.HostnameOwnershipHostname:
+					return NewIngressHostnameReserved(hostname)
+				case api.HostnameOwnershipPath:
+					other := RoutableFromObject(obj)
+					if other == nil {
+						continue
+					}
+
+					for _, match := range matches {
+						for _, otherMatch := range other.HostnamePathMatches()[hostname] {
+							if match.Overlaps(otherMatch) {
+								return NewIngressHostnameReserved(hostname)
+							}
+						}
+					}
+				case api.HostnameOwnershipDisabled:
+					continue
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// newOwnershipObjectLists returns the lists of the resources sharing the hostnames:
+// the Ingress one matches the version of the given resource, if any.
+func newOwnershipObjectLists(ing Routable) []client.ObjectList {
+	lists := []client.ObjectList{
+		&gatewayv1beta1.HTTPRouteList{},
+		&gatewayv1alpha2.GRPCRouteList{},
+		&gatewayv1alpha2.TLSRouteList{},
+	}
+
+	switch ing.(type) {
+	case Extension:
+		return append(lists, &extensionsv1beta1.IngressList{})
+	case NetworkingV1Beta1:
+		return append(lists, &networkingv1beta1.IngressList{})
+	default:
+		return append(lists, &networkingv1.IngressList{})
+	}
+}
+
+func isHostnameOwnershipEnabled(ownership api.HostnameOwnership) bool {
+	return ownership == api.HostnameOwnershipHostname || ownership == api.HostnameOwnershipPath
+}
+
+func strictestHostnameOwnership(a, b api.HostnameOwnership) api.HostnameOwnership {
+	switch {
+	case a == api.HostnameOwnershipHostname || b == api.HostnameOwnershipHostname:
+		return api.HostnameOwnershipHostname
+	case a == api.HostnameOwnershipPath || b == api.HostnameOwnershipPath:
+		return api.HostnameOwnershipPath
+	default:
+		return api.HostnameOwnershipDisabled
+	}
+}
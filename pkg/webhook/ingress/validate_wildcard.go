@@ -15,6 +15,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	capsulev1beta2 "github.com/projectcapsule/capsule/api/v1beta2"
+	capsuleutils "github.com/projectcapsule/capsule/pkg/utils"
 	capsulewebhook "github.com/projectcapsule/capsule/pkg/webhook"
 	"github.com/projectcapsule/capsule/pkg/webhook/utils"
 )
@@ -58,14 +59,18 @@ func (h *wildcard) validate(ctx context.Context, clt client.Client, req admissio
 	}
 
 	tnt := tntList.Items[0]
+	// Wildcard hostnames could be forbidden by the parent Tenant
+	if err := capsuleutils.ResolveTenantInheritance(ctx, clt, &tnt); err != nil {
+		return utils.ErroredResponse(err)
+	}
 
 	if !tnt.Spec.IngressOptions.AllowWildcardHostnames {
-		// Retrieve ingress resource from request.
-		ingress, err := FromRequest(req, decoder)
+		// Retrieve ingress or route resource from request.
+		ingress, err := RoutableFromRequest(req, decoder)
 		if err != nil {
 			return utils.ErroredResponse(err)
 		}
-		// Loop over all the hosts present on the ingress.
+		// Loop over all the hosts present on the ingress or route.
 		for host := range ingress.HostnamePathsPairs() {
 			// Check if one of the host has wildcard.
 			if strings.HasPrefix(host, "*") {
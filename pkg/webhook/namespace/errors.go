@@ -3,6 +3,13 @@
 
 package namespace
 
+import (
+	"fmt"
+	"time"
+
+	"github.com/projectcapsule/capsule/pkg/api"
+)
+
 type namespaceQuotaExceededError struct{}
 
 func NewNamespaceQuotaExceededError() error {
@@ -10,5 +17,64 @@ func NewNamespaceQuotaExceededError() error {
 }
 
 func (namespaceQuotaExceededError) Error() string {
-	return "Cannot exceed Namespace quota: please, reach out to the system administrators"
+	return "Cannot exceed Namespace quota: please, reach out to the system administrators, or create a NamespaceRequest"
+}
+
+type podSecurityLabelLoweredError struct {
+	label string
+	value string
+}
+
+func NewPodSecurityLabelLoweredError(label, value string) error {
+	return &podSecurityLabelLoweredError{label: label, value: value}
+}
+
+func (p podSecurityLabelLoweredError) Error() string {
+	if len(p.value) == 0 {
+		return fmt.Sprintf("the Pod Security label %s is enforced by the Tenant, cannot be removed", p.label)
+	}
+
+	return fmt.Sprintf("the Pod Security label %s=%s is lowering the Tenant Pod Security Standards", p.label, p.value)
+}
+
+type networkPolicyOptOutError struct {
+	label string
+}
+
+func NewNetworkPolicyOptOutError(label string) error {
+	return &networkPolicyOptOutError{label: label}
+}
+
+func (n networkPolicyOptOutError) Error() string {
+	return fmt.Sprintf("the label %s opting out of the Tenant network policies can be managed by the cluster administrators only", n.label)
+}
+
+type namespacePendingDeletionError struct {
+	namespace   string
+	gracePeriod time.Duration
+}
+
+func NewNamespacePendingDeletionError(namespace string, gracePeriod time.Duration) error {
+	return &namespacePendingDeletionError{namespace: namespace, gracePeriod: gracePeriod}
+}
+
+func (n namespacePendingDeletionError) Error() string {
+	return fmt.Sprintf("the Namespace %s is pending deletion and will be deleted in %s: remove the %s label to restore it", n.namespace, n.gracePeriod, api.NamespacePendingDeletionLabel)
+}
+
+type namespaceAlreadyPendingDeletionError struct {
+	namespace string
+	schedule  string
+}
+
+func NewNamespaceAlreadyPendingDeletionError(namespace, schedule string) error {
+	return &namespaceAlreadyPendingDeletionError{namespace: namespace, schedule: schedule}
+}
+
+func (n namespaceAlreadyPendingDeletionError) Error() string {
+	if len(n.schedule) == 0 {
+		return fmt.Sprintf("the Namespace %s is already pending deletion", n.namespace)
+	}
+
+	return fmt.Sprintf("the Namespace %s is already pending deletion, scheduled at %s", n.namespace, n.schedule)
 }
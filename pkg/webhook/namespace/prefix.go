@@ -5,8 +5,8 @@ package namespace
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"strings"
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/types"
@@ -16,6 +16,7 @@ import (
 
 	capsulev1beta2 "github.com/projectcapsule/capsule/api/v1beta2"
 	"github.com/projectcapsule/capsule/pkg/configuration"
+	capsuleutils "github.com/projectcapsule/capsule/pkg/utils"
 	capsulewebhook "github.com/projectcapsule/capsule/pkg/webhook"
 	"github.com/projectcapsule/capsule/pkg/webhook/utils"
 )
@@ -45,23 +46,37 @@ func (r *prefixHandler) OnCreate(clt client.Client, decoder *admission.Decoder,
 			}
 		}
 
-		if r.configuration.ForceTenantPrefix() {
+		for _, or := range ns.ObjectMeta.OwnerReferences {
+			if or.Kind != "Tenant" {
+				continue
+			}
+			// retrieving the selected Tenant
 			tnt := &capsulev1beta2.Tenant{}
+			if err := clt.Get(ctx, types.NamespacedName{Name: or.Name}, tnt); err != nil {
+				return utils.ErroredResponse(err)
+			}
 
-			for _, or := range ns.ObjectMeta.OwnerReferences {
-				// retrieving the selected Tenant
-				if err := clt.Get(ctx, types.NamespacedName{Name: or.Name}, tnt); err != nil {
-					return utils.ErroredResponse(err)
-				}
-
-				if e := fmt.Sprintf("%s-%s", tnt.GetName(), ns.GetName()); !strings.HasPrefix(ns.GetName(), fmt.Sprintf("%s-", tnt.GetName())) {
-					recorder.Eventf(tnt, corev1.EventTypeWarning, "InvalidTenantPrefix", "Namespace %s does not match the expected prefix for the current Tenant", ns.GetName())
+			err := capsuleutils.ValidateNamespaceName(tnt, ns.GetName(), r.configuration.ForceTenantPrefix())
+
+			var (
+				namingErr *capsuleutils.NamespaceNamingPolicyError
+				prefixErr *capsuleutils.TenantPrefixError
+			)
+
+			switch {
+			case err == nil:
+				continue
+			case errors.As(err, &namingErr):
+				recorder.Eventf(tnt, corev1.EventTypeWarning, "InvalidNamespaceName", "Namespace %s does not match the naming policy of the current Tenant", ns.GetName())
+			case errors.As(err, &prefixErr):
+				recorder.Eventf(tnt, corev1.EventTypeWarning, "InvalidTenantPrefix", "Namespace %s does not match the expected prefix for the current Tenant", ns.GetName())
+			default:
+				return utils.ErroredResponse(err)
+			}
 
-					response := admission.Denied(fmt.Sprintf("The namespace doesn't match the tenant prefix, expected %s", e))
+			response := admission.Denied(err.Error())
 
-					return &response
-				}
-			}
+			return &response
 		}
 
 		return nil
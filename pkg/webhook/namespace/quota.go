@@ -13,6 +13,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	capsulev1beta2 "github.com/projectcapsule/capsule/api/v1beta2"
+	capsuleutils "github.com/projectcapsule/capsule/pkg/utils"
 	capsulewebhook "github.com/projectcapsule/capsule/pkg/webhook"
 	"github.com/projectcapsule/capsule/pkg/webhook/utils"
 )
@@ -37,6 +38,11 @@ func (r *quotaHandler) OnCreate(client client.Client, decoder *admission.Decoder
 				return utils.ErroredResponse(err)
 			}
 
+			// The Namespace quota could be carved out by the children Tenants
+			if err := capsuleutils.ResolveTenantHierarchy(ctx, client, tnt); err != nil {
+				return utils.ErroredResponse(err)
+			}
+
 			if tnt.IsFull() {
 				// Checking if the Namespace already exists.
 				// If this is the case, no need to return the quota exceeded error:
@@ -0,0 +1,94 @@
+// Copyright 2020-2023 Project Capsule Authors.
+// SPDX-License-Identifier: Apache-2.0
+
+package namespace
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	capsulev1beta2 "github.com/projectcapsule/capsule/api/v1beta2"
+	"github.com/projectcapsule/capsule/pkg/api"
+	capsulewebhook "github.com/projectcapsule/capsule/pkg/webhook"
+	"github.com/projectcapsule/capsule/pkg/webhook/utils"
+)
+
+type softDeleteHandler struct{}
+
+// SoftDeleteHandler turns the deletion of a Tenant Namespace into a pending one, when enabled by the Tenant:
+// the Namespace is labelled as pending deletion, leaving the actual deletion to the controller once the grace period expires.
+func SoftDeleteHandler() capsulewebhook.Handler {
+	return &softDeleteHandler{}
+}
+
+func (r *softDeleteHandler) OnCreate(client.Client, *admission.Decoder, record.EventRecorder) capsulewebhook.Func {
+	return func(context.Context, admission.Request) *admission.Response {
+		return nil
+	}
+}
+
+func (r *softDeleteHandler) OnDelete(c client.Client, _ *admission.Decoder, recorder record.EventRecorder) capsulewebhook.Func {
+	return func(ctx context.Context, req admission.Request) *admission.Response {
+		tntList := &capsulev1beta2.TenantList{}
+		if err := c.List(ctx, tntList, client.MatchingFieldsSelector{
+			Selector: fields.OneTermEqualSelector(".status.namespaces", req.Name),
+		}); err != nil {
+			return utils.ErroredResponse(err)
+		}
+
+		if len(tntList.Items) == 0 {
+			return nil
+		}
+
+		tnt := tntList.Items[0]
+
+		if tnt.Spec.NamespaceOptions == nil || tnt.Spec.NamespaceOptions.SoftDelete == nil {
+			return nil
+		}
+
+		ns := &corev1.Namespace{}
+		if err := c.Get(ctx, types.NamespacedName{Name: req.Name}, ns); err != nil {
+			return utils.ErroredResponse(err)
+		}
+
+		if _, ok := ns.GetLabels()[api.NamespacePendingDeletionLabel]; ok {
+			response := admission.Denied(NewNamespaceAlreadyPendingDeletionError(ns.GetName(), ns.GetAnnotations()[api.DeletionScheduleAnnotation]).Error())
+
+			return &response
+		}
+		// the dry-run requests must not have side effects
+		if req.DryRun == nil || !*req.DryRun {
+			patch := client.MergeFrom(ns.DeepCopy())
+
+			labels := ns.GetLabels()
+			if labels == nil {
+				labels = make(map[string]string)
+			}
+
+			labels[api.NamespacePendingDeletionLabel] = "true"
+			ns.SetLabels(labels)
+
+			if err := c.Patch(ctx, ns, patch); err != nil {
+				return utils.ErroredResponse(err)
+			}
+
+			recorder.Eventf(&tnt, corev1.EventTypeNormal, "NamespacePendingDeletion", "Namespace %s deletion has been requested by %s, pending for %s", ns.GetName(), req.UserInfo.Username, tnt.Spec.NamespaceOptions.SoftDelete.GracePeriod.Duration)
+		}
+
+		response := admission.Denied(NewNamespacePendingDeletionError(ns.GetName(), tnt.Spec.NamespaceOptions.SoftDelete.GracePeriod.Duration).Error())
+
+		return &response
+	}
+}
+
+func (r *softDeleteHandler) OnUpdate(client.Client, *admission.Decoder, record.EventRecorder) capsulewebhook.Func {
+	return func(context.Context, admission.Request) *admission.Response {
+		return nil
+	}
+}
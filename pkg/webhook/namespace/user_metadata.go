@@ -5,6 +5,7 @@ package namespace
 
 import (
 	"context"
+	"strings"
 
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
@@ -15,6 +16,7 @@ import (
 
 	capsulev1beta2 "github.com/projectcapsule/capsule/api/v1beta2"
 	"github.com/projectcapsule/capsule/pkg/api"
+	capsuleutils "github.com/projectcapsule/capsule/pkg/utils"
 	capsulewebhook "github.com/projectcapsule/capsule/pkg/webhook"
 	"github.com/projectcapsule/capsule/pkg/webhook/utils"
 )
@@ -40,6 +42,14 @@ func (r *userMetadataHandler) OnCreate(client client.Client, decoder *admission.
 			}
 		}
 
+		if response := r.validatePodSecurity(ctx, client, recorder, tnt, true, nil, ns.GetLabels()); response != nil {
+			return response
+		}
+
+		if response := r.validateNetworkPolicyOptOut(recorder, tnt, ns.GetLabels()); response != nil {
+			return response
+		}
+
 		if tnt.Spec.NamespaceOptions != nil {
 			err := api.ValidateForbidden(ns.ObjectMeta.Annotations, tnt.Spec.NamespaceOptions.ForbiddenAnnotations)
 			if err != nil {
@@ -90,7 +100,8 @@ func (r *userMetadataHandler) OnUpdate(client client.Client, decoder *admission.
 			}
 		}
 
-		if len(tnt.Spec.NodeSelector) > 0 {
+		// the node selector of the Tenant the Namespace is transferred to is put in place by the Tenant controller
+		if len(tnt.Spec.NodeSelector) > 0 && tenantOwner(oldNs) == tenantOwner(newNs) {
 			v, ok := newNs.GetAnnotations()["scheduler.alpha.kubernetes.io/node-selector"]
 			if !ok {
 				response := admission.Denied("the node-selector annotation is enforced, cannot be removed")
@@ -109,6 +120,10 @@ func (r *userMetadataHandler) OnUpdate(client client.Client, decoder *admission.
 			}
 		}
 
+		if response := r.validatePodSecurity(ctx, client, recorder, tnt, false, oldNs.GetLabels(), newNs.GetLabels()); response != nil {
+			return response
+		}
+
 		labels, annotations := oldNs.GetLabels(), oldNs.GetAnnotations()
 
 		if labels == nil {
@@ -149,6 +164,10 @@ func (r *userMetadataHandler) OnUpdate(client client.Client, decoder *admission.
 			delete(annotations, key)
 		}
 
+		if response := r.validateNetworkPolicyOptOut(recorder, tnt, labels); response != nil {
+			return response
+		}
+
 		if tnt.Spec.NamespaceOptions != nil {
 			err := api.ValidateForbidden(annotations, tnt.Spec.NamespaceOptions.ForbiddenAnnotations)
 			if err != nil {
@@ -172,3 +191,65 @@ func (r *userMetadataHandler) OnUpdate(client client.Client, decoder *admission.
 		return nil
 	}
 }
+
+// validatePodSecurity denies the changes of the Pod Security Admission labels lowering the Tenant Pod Security Standards:
+// upon creation, the missing labels are not considered, since these are put in place by the Tenant controller.
+func (r *userMetadataHandler) validatePodSecurity(ctx context.Context, c client.Client, recorder record.EventRecorder, tnt *capsulev1beta2.Tenant, create bool, oldLabels, newLabels map[string]string) *admission.Response {
+	if len(tnt.GetName()) == 0 {
+		return nil
+	}
+	// Pod Security Standards could be inherited by the parent Tenant
+	effective := tnt.DeepCopy()
+	if err := capsuleutils.ResolveTenantInheritance(ctx, c, effective); err != nil {
+		return utils.ErroredResponse(err)
+	}
+
+	for _, label := range api.PodSecurityLoweredLabels(effective.Spec.PodSecurity, newLabels) {
+		value, ok := newLabels[label]
+
+		if create && !ok {
+			continue
+		}
+
+		if oldValue, oldOk := oldLabels[label]; !create && oldOk == ok && oldValue == value {
+			continue
+		}
+
+		err := NewPodSecurityLabelLoweredError(label, value)
+		recorder.Eventf(tnt, corev1.EventTypeWarning, "ForbiddenPodSecurityLabel", err.Error())
+		response := admission.Denied(err.Error())
+
+		return &response
+	}
+
+	return nil
+}
+
+// validateNetworkPolicyOptOut denies the changes of the labels opting out of the Tenant network policy presets,
+// which are reserved to the cluster administrators.
+func (r *userMetadataHandler) validateNetworkPolicyOptOut(recorder record.EventRecorder, tnt *capsulev1beta2.Tenant, labels map[string]string) *admission.Response {
+	for label := range labels {
+		if !strings.HasPrefix(label, api.NetworkPolicyPresetOptOutLabelPrefix) {
+			continue
+		}
+
+		err := NewNetworkPolicyOptOutError(label)
+		recorder.Eventf(tnt, corev1.EventTypeWarning, "ForbiddenNetworkPolicyOptOut", err.Error())
+		response := admission.Denied(err.Error())
+
+		return &response
+	}
+
+	return nil
+}
+
+// tenantOwner returns the name of the Tenant controlling the Namespace, if any.
+func tenantOwner(ns *corev1.Namespace) string {
+	for _, reference := range ns.GetOwnerReferences() {
+		if reference.Kind == "Tenant" && reference.Controller != nil && *reference.Controller {
+			return reference.Name
+		}
+	}
+
+	return ""
+}
@@ -20,19 +20,23 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	capsulev1beta2 "github.com/projectcapsule/capsule/api/v1beta2"
+	"github.com/projectcapsule/capsule/pkg/api"
 	"github.com/projectcapsule/capsule/pkg/configuration"
+	"github.com/projectcapsule/capsule/pkg/quota"
 	capsuleutils "github.com/projectcapsule/capsule/pkg/utils"
 	capsulewebhook "github.com/projectcapsule/capsule/pkg/webhook"
 	"github.com/projectcapsule/capsule/pkg/webhook/utils"
 )
 
 type handler struct {
-	cfg configuration.Configuration
+	cfg    configuration.Configuration
+	ledger *quota.Ledger
 }
 
-func Handler(cfg configuration.Configuration) capsulewebhook.Handler {
+func Handler(cfg configuration.Configuration, ledger *quota.Ledger) capsulewebhook.Handler {
 	return &handler{
-		cfg: cfg,
+		cfg:    cfg,
+		ledger: ledger,
 	}
 }
 
@@ -64,6 +68,21 @@ func (h *handler) OnUpdate(client client.Client, decoder *admission.Decoder, rec
 			return utils.ErroredResponse(err)
 		}
 
+		ln, err := capsuleutils.GetTypeLabel(&capsulev1beta2.Tenant{})
+		if err != nil {
+			return utils.ErroredResponse(err)
+		}
+		// Changing the Tenant label to another Tenant is requesting the transfer of the Namespace
+		for _, reference := range oldNs.OwnerReferences {
+			if reference.Kind != "Tenant" || reference.Controller == nil || !*reference.Controller {
+				continue
+			}
+
+			if tenant, ok := newNs.GetLabels()[ln]; ok && tenant != reference.Name && tenant != oldNs.GetLabels()[ln] {
+				return h.transferNamespace(ctx, req, client, reference.Name, newNs, recorder)
+			}
+		}
+
 		o, err := json.Marshal(newNs.DeepCopy())
 		if err != nil {
 			response := admission.Errored(http.StatusInternalServerError, err)
@@ -218,6 +237,10 @@ func (h *handler) patchResponseForOwnerRef(tenant *capsulev1beta2.Tenant, ns *co
 		return admission.Errored(http.StatusInternalServerError, err)
 	}
 
+	if response := h.renameNamespace(tenant, ns, recorder); response != nil {
+		return *response
+	}
+
 	recorder.Eventf(tenant, corev1.EventTypeNormal, "NamespaceCreationWebhook", "Namespace %s has been assigned to the desired Tenant", ns.GetName())
 
 	c, err := json.Marshal(ns)
@@ -228,6 +251,41 @@ func (h *handler) patchResponseForOwnerRef(tenant *capsulev1beta2.Tenant, ns *co
 	return admission.PatchResponseFromRaw(o, c)
 }
 
+// renameNamespace renames the Namespace according to the naming policy of the Tenant, when the mutating mode is enabled:
+// the Namespace is left untouched when the suggested name doesn't match the policy either, leaving the denial to the validating webhook.
+func (h *handler) renameNamespace(tenant *capsulev1beta2.Tenant, ns *corev1.Namespace, recorder record.EventRecorder) *admission.Response {
+	if tenant.Spec.NamespaceOptions == nil || tenant.Spec.NamespaceOptions.NamingPolicy == nil || tenant.Spec.NamespaceOptions.NamingPolicy.Mode != api.NamespaceNamingModeMutate {
+		return nil
+	}
+
+	policy := tenant.Spec.NamespaceOptions.NamingPolicy
+
+	matched, err := policy.Match(tenant.GetName(), ns.GetName())
+	if err != nil {
+		return utils.ErroredResponse(err)
+	}
+
+	if matched {
+		return nil
+	}
+
+	name := policy.Suggest(tenant.GetName(), ns.GetName())
+
+	if matched, err = policy.Match(tenant.GetName(), name); err != nil {
+		return utils.ErroredResponse(err)
+	}
+
+	if !matched {
+		return nil
+	}
+
+	recorder.Eventf(tenant, corev1.EventTypeNormal, "NamespaceRenamed", "Namespace %s has been renamed to %s according to the Tenant naming policy", ns.GetName(), name)
+
+	ns.SetName(name)
+
+	return nil
+}
+
 func (h *handler) listTenantsForOwnerKind(ctx context.Context, ownerKind string, ownerName string, clt client.Client) (*capsulev1beta2.TenantList, error) {
 	tntList := &capsulev1beta2.TenantList{}
 	fields := client.MatchingFields{
@@ -0,0 +1,194 @@
+// Copyright 2020-2023 Project Capsule Authors.
+// SPDX-License-Identifier: Apache-2.0
+
+package ownerreference
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	capsulev1beta2 "github.com/projectcapsule/capsule/api/v1beta2"
+	"github.com/projectcapsule/capsule/pkg/api"
+	"github.com/projectcapsule/capsule/pkg/quota"
+	capsuleutils "github.com/projectcapsule/capsule/pkg/utils"
+	"github.com/projectcapsule/capsule/pkg/webhook/utils"
+)
+
+// transferNamespace moves the Namespace to the Tenant selected with the Tenant label, rewriting the owner reference
+// in the same update: the requesting user must own both the Tenants, and the destination one must be able to host the Namespace.
+// The resources of the source Tenant are pruned, and the ones of the destination Tenant are put in place by the Tenant controller.
+func (h *handler) transferNamespace(ctx context.Context, req admission.Request, clt client.Client, source string, ns *corev1.Namespace, recorder record.EventRecorder) *admission.Response {
+	ln, err := capsuleutils.GetTypeLabel(&capsulev1beta2.Tenant{})
+	if err != nil {
+		return utils.ErroredResponse(err)
+	}
+
+	src := &capsulev1beta2.Tenant{}
+	if err = clt.Get(ctx, types.NamespacedName{Name: source}, src); err != nil {
+		return utils.ErroredResponse(err)
+	}
+
+	dst := &capsulev1beta2.Tenant{}
+	if err = clt.Get(ctx, types.NamespacedName{Name: ns.GetLabels()[ln]}, dst); err != nil {
+		if apierrors.IsNotFound(err) {
+			response := admission.Denied(fmt.Sprintf("Cannot transfer the Namespace %s to the Tenant %s, since it doesn't exist", ns.GetName(), ns.GetLabels()[ln]))
+
+			return &response
+		}
+
+		return utils.ErroredResponse(err)
+	}
+
+	if !utils.IsTenantOwner(src.Spec.Owners, req.UserInfo) || !utils.IsTenantOwner(dst.Spec.Owners, req.UserInfo) {
+		recorder.Eventf(dst, corev1.EventTypeWarning, "NonOwnedTenant", "Namespace %s cannot be transferred to the current Tenant", ns.GetName())
+
+		response := admission.Denied("Cannot transfer the Namespace between non-owned Tenants")
+
+		return &response
+	}
+	// The Namespace quota could be carved out by the children Tenants
+	resolved := dst.DeepCopy()
+	if err = capsuleutils.ResolveTenantHierarchy(ctx, clt, resolved); err != nil {
+		return utils.ErroredResponse(err)
+	}
+
+	if resolved.IsFull() {
+		recorder.Eventf(dst, corev1.EventTypeWarning, "NamespaceQuotaExceded", "Namespace %s cannot be transferred, quota exceeded for the current Tenant", ns.GetName())
+
+		response := admission.Denied(fmt.Sprintf("Cannot transfer the Namespace %s to the Tenant %s, since it exceeds the Namespace quota", ns.GetName(), dst.GetName()))
+
+		return &response
+	}
+
+	if err = h.transferCompatibility(dst, ns); err != nil {
+		recorder.Eventf(dst, corev1.EventTypeWarning, "IncompatibleNamespaceTransfer", "Namespace %s cannot be transferred to the current Tenant: %s", ns.GetName(), err.Error())
+
+		response := admission.Denied(fmt.Sprintf("Cannot transfer the Namespace %s to the Tenant %s: %s", ns.GetName(), dst.GetName(), err.Error()))
+
+		return &response
+	}
+
+	if err = h.transferQuota(ctx, clt, resolved, ns, pointer.BoolDeref(req.DryRun, false)); err != nil {
+		if !quota.IsTenantQuotaExceeded(err) {
+			return utils.ErroredResponse(err)
+		}
+
+		recorder.Eventf(dst, corev1.EventTypeWarning, "TenantQuotaExceeded", "Namespace %s cannot be transferred, the Tenant quota would be exceeded: %s", ns.GetName(), err.Error())
+
+		response := admission.Denied(fmt.Sprintf("Cannot transfer the Namespace %s to the Tenant %s: %s", ns.GetName(), dst.GetName(), err.Error()))
+
+		return &response
+	}
+
+	o, err := json.Marshal(ns.DeepCopy())
+	if err != nil {
+		response := admission.Errored(http.StatusInternalServerError, err)
+
+		return &response
+	}
+	// Replacing the owner reference of the source Tenant, keeping the other ones
+	references := make([]metav1.OwnerReference, 0, len(ns.GetOwnerReferences()))
+
+	for _, reference := range ns.GetOwnerReferences() {
+		if reference.Kind == "Tenant" && strings.HasPrefix(reference.APIVersion, capsulev1beta2.GroupVersion.Group+"/") {
+			continue
+		}
+
+		references = append(references, reference)
+	}
+
+	ns.SetOwnerReferences(references)
+	// The node selector of the source Tenant must not be enforced anymore: the one of the destination Tenant,
+	// if any, is put in place by the Tenant controller.
+	if len(src.Spec.NodeSelector) > 0 {
+		delete(ns.Annotations, "scheduler.alpha.kubernetes.io/node-selector")
+	}
+
+	scheme := runtime.NewScheme()
+	_ = capsulev1beta2.AddToScheme(scheme)
+	_ = corev1.AddToScheme(scheme)
+
+	if err = controllerutil.SetControllerReference(dst, ns, scheme); err != nil {
+		response := admission.Errored(http.StatusInternalServerError, err)
+
+		return &response
+	}
+
+	c, err := json.Marshal(ns)
+	if err != nil {
+		response := admission.Errored(http.StatusInternalServerError, err)
+
+		return &response
+	}
+
+	if req.DryRun == nil || !*req.DryRun {
+		recorder.Eventf(src, corev1.EventTypeNormal, "NamespaceTransferred", "Namespace %s has been transferred to the Tenant %s", ns.GetName(), dst.GetName())
+		recorder.Eventf(dst, corev1.EventTypeNormal, "NamespaceTransferred", "Namespace %s has been transferred from the Tenant %s", ns.GetName(), src.GetName())
+	}
+
+	response := admission.PatchResponseFromRaw(o, c)
+
+	return &response
+}
+
+// transferQuota reserves the resources already used in the Namespace against the Tenant-scoped ResourceQuota items
+// of the destination Tenant, which must be already resolved: these are tracked until the Namespace ResourceQuota resources
+// of the destination Tenant are put in place.
+func (h *handler) transferQuota(ctx context.Context, clt client.Client, dst *capsulev1beta2.Tenant, ns *corev1.Namespace, dryRun bool) error {
+	if dst.Spec.ResourceQuota.Scope != api.ResourceQuotaScopeTenant {
+		return nil
+	}
+
+	requests := make(map[int]corev1.ResourceList, len(dst.Spec.ResourceQuota.Items))
+
+	for index, item := range dst.Spec.ResourceQuota.Items {
+		usage, err := quota.NamespaceUsage(ctx, clt, ns.GetName(), item)
+		if err != nil {
+			return err
+		}
+
+		requests[index] = usage
+	}
+
+	return h.ledger.Reserve(ctx, clt, dst, ns.GetName(), requests, dryRun)
+}
+
+// transferCompatibility returns an error if the Namespace doesn't comply with the policies of the destination Tenant
+// enforced upon the Namespace creation, as well as if it cannot be transferred at all.
+// The objects in the Namespace are not evaluated: these are scanned by the compliance controller
+// once the Namespace is listed in the status of the destination Tenant.
+func (h *handler) transferCompatibility(dst *capsulev1beta2.Tenant, ns *corev1.Namespace) error {
+	if _, pending := ns.GetLabels()[api.NamespacePendingDeletionLabel]; pending {
+		return fmt.Errorf("the Namespace is pending deletion")
+	}
+
+	if dst.Spec.Cordoned {
+		return fmt.Errorf("the Tenant is cordoned")
+	}
+
+	if dst.Spec.NamespaceOptions != nil {
+		if err := api.ValidateForbidden(ns.GetLabels(), dst.Spec.NamespaceOptions.ForbiddenLabels); err != nil {
+			return err
+		}
+
+		if err := api.ValidateForbidden(ns.GetAnnotations(), dst.Spec.NamespaceOptions.ForbiddenAnnotations); err != nil {
+			return err
+		}
+	}
+
+	return capsuleutils.ValidateNamespaceName(dst, ns.GetName(), h.cfg.ForceTenantPrefix())
+}
@@ -13,6 +13,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	capsulev1beta2 "github.com/projectcapsule/capsule/api/v1beta2"
+	capsuleutils "github.com/projectcapsule/capsule/pkg/utils"
 	capsulewebhook "github.com/projectcapsule/capsule/pkg/webhook"
 	"github.com/projectcapsule/capsule/pkg/webhook/utils"
 )
@@ -60,18 +61,14 @@ func (h *containerRegistryHandler) validate(ctx context.Context, c client.Client
 	}
 
 	tnt := tntList.Items[0]
+	// Container registries could be inherited by the parent Tenant
+	if err := capsuleutils.ResolveTenantInheritance(ctx, c, &tnt); err != nil {
+		return utils.ErroredResponse(err)
+	}
 
 	if tnt.Spec.ContainerRegistries != nil {
-		// Evaluate init containers
-		for _, container := range pod.Spec.InitContainers {
-			if response := h.VerifyContainerRegistry(recorder, req, container, tnt); response != nil {
-				return response
-			}
-		}
-
-		// Evaluate containers
-		for _, container := range pod.Spec.Containers {
-			if response := h.VerifyContainerRegistry(recorder, req, container, tnt); response != nil {
+		for _, image := range podImages(pod) {
+			if response := h.VerifyContainerRegistry(recorder, req, image, tnt); response != nil {
 				return response
 			}
 		}
@@ -80,15 +77,22 @@ func (h *containerRegistryHandler) validate(ctx context.Context, c client.Client
 	return nil
 }
 
-func (h *containerRegistryHandler) VerifyContainerRegistry(recorder record.EventRecorder, req admission.Request, container corev1.Container, tnt capsulev1beta2.Tenant) *admission.Response {
+func (h *containerRegistryHandler) VerifyContainerRegistry(recorder record.EventRecorder, req admission.Request, image string, tnt capsulev1beta2.Tenant) *admission.Response {
 	var valid, matched bool
 
-	reg := NewRegistry(container.Image)
+	reg, err := NewRegistry(image)
+	if err != nil {
+		recorder.Eventf(&tnt, corev1.EventTypeWarning, "InvalidImageReference", "Pod %s/%s is using the container image %s which is not a valid reference", req.Namespace, req.Name, image)
+
+		response := admission.Denied(err.Error())
+
+		return &response
+	}
 
-	if len(reg.Registry()) == 0 {
-		recorder.Eventf(&tnt, corev1.EventTypeWarning, "MissingFQCI", "Pod %s/%s is not using a fully qualified container image, cannot enforce registry the current Tenant", req.Namespace, req.Name, reg.Registry())
+	if !reg.FullyQualified() {
+		recorder.Eventf(&tnt, corev1.EventTypeWarning, "MissingFQCI", "Pod %s/%s is not using a fully qualified container image, cannot enforce registry the current Tenant", req.Namespace, req.Name)
 
-		response := admission.Denied(NewContainerRegistryForbidden(container.Image, *tnt.Spec.ContainerRegistries).Error())
+		response := admission.Denied(NewContainerRegistryForbidden(image, *tnt.Spec.ContainerRegistries).Error())
 
 		return &response
 	}
@@ -100,10 +104,29 @@ func (h *containerRegistryHandler) VerifyContainerRegistry(recorder record.Event
 	if !valid && !matched {
 		recorder.Eventf(&tnt, corev1.EventTypeWarning, "ForbiddenContainerRegistry", "Pod %s/%s is using a container hosted on registry %s that is forbidden for the current Tenant", req.Namespace, req.Name, reg.Registry())
 
-		response := admission.Denied(NewContainerRegistryForbidden(container.Image, *tnt.Spec.ContainerRegistries).Error())
+		response := admission.Denied(NewContainerRegistryForbidden(image, *tnt.Spec.ContainerRegistries).Error())
 
 		return &response
 	}
 
 	return nil
 }
+
+// podImages returns the images of the init, regular, and ephemeral containers of the Pod.
+func podImages(pod *corev1.Pod) []string {
+	images := make([]string, 0, len(pod.Spec.InitContainers)+len(pod.Spec.Containers)+len(pod.Spec.EphemeralContainers))
+
+	for _, container := range pod.Spec.InitContainers {
+		images = append(images, container.Image)
+	}
+
+	for _, container := range pod.Spec.Containers {
+		images = append(images, container.Image)
+	}
+
+	for _, container := range pod.Spec.EphemeralContainers {
+		images = append(images, container.Image)
+	}
+
+	return images
+}
@@ -22,6 +22,19 @@ func NewMissingContainerRegistryError(image string) error {
 	return &missingContainerRegistryError{fqci: image}
 }
 
+type invalidImageReferenceError struct {
+	image  string
+	reason string
+}
+
+func NewInvalidImageReferenceError(image, reason string) error {
+	return &invalidImageReferenceError{image: image, reason: reason}
+}
+
+func (i invalidImageReferenceError) Error() string {
+	return fmt.Sprintf("container image %s is not a valid reference: %s", i.image, i.reason)
+}
+
 type registryClassForbiddenError struct {
 	fqci string
 	spec api.AllowedListSpec
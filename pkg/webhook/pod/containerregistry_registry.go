@@ -5,67 +5,150 @@ package pod
 
 import (
 	"regexp"
+	"strings"
 )
 
-type registry map[string]string
+const (
+	// dockerHubRegistry is the registry of the images not specifying it.
+	dockerHubRegistry = "docker.io"
+	// dockerHubLibrary is the namespace of the Docker Hub official images, such as nginx.
+	dockerHubLibrary = "library"
+	// maxNameLength is the maximum length of the registry and repository, as for the OCI distribution specification.
+	maxNameLength = 255
+)
 
-func (r registry) Registry() string {
-	res, ok := r["registry"]
-	if !ok {
-		return ""
-	}
+var (
+	domainRegexp    = regexp.MustCompile(`^(?:[a-zA-Z0-9]|[a-zA-Z0-9][a-zA-Z0-9-]*[a-zA-Z0-9])(?:\.(?:[a-zA-Z0-9]|[a-zA-Z0-9][a-zA-Z0-9-]*[a-zA-Z0-9]))*(?::[0-9]+)?$`)
+	componentRegexp = regexp.MustCompile(`^[a-z0-9]+(?:(?:[._]|__|-+)[a-z0-9]+)*$`)
+	tagRegexp       = regexp.MustCompile(`^\w[\w.-]{0,127}$`)
+	digestRegexp    = regexp.MustCompile(`^[a-z0-9]+(?:[.+_-][a-z0-9]+)*:[a-zA-Z0-9=_-]{32,}$`)
+)
 
-	return res
+type registry struct {
+	registry       string
+	repository     string
+	tag            string
+	digest         string
+	fullyQualified bool
 }
 
-func (r registry) Repository() string {
-	res, ok := r["repository"]
-	if !ok {
-		return ""
-	}
+func (r registry) Registry() string {
+	return r.registry
+}
 
-	return res
+func (r registry) Repository() string {
+	return r.repository
 }
 
 func (r registry) Image() string {
-	res, ok := r["image"]
-	if !ok {
-		return ""
-	}
-
-	return res
+	return r.repository[strings.LastIndex(r.repository, "/")+1:]
 }
 
 func (r registry) Tag() string {
-	res, ok := r["tag"]
-	if !ok {
-		return ""
+	return r.tag
+}
+
+func (r registry) Digest() string {
+	return r.digest
+}
+
+func (r registry) Name() string {
+	return r.registry + "/" + r.repository
+}
+
+func (r registry) FullyQualified() bool {
+	return r.fullyQualified
+}
+
+func (r registry) String() (reference string) {
+	reference = r.Name()
+
+	if len(r.tag) > 0 {
+		reference += ":" + r.tag
 	}
 
-	if len(res) == 0 {
-		res = "latest"
+	if len(r.digest) > 0 {
+		reference += "@" + r.digest
 	}
 
-	return res
+	return reference
 }
 
-func NewRegistry(value string) Registry {
-	reg := make(registry)
-	r := regexp.MustCompile(`((?P<registry>[a-zA-Z0-9-._]+(:\d+)?)\/)?(?P<repository>.*\/)?(?P<image>[a-zA-Z0-9-._]+:(?P<tag>[a-zA-Z0-9-._]+))?`)
-	match := r.FindStringSubmatch(value)
+// NewRegistry parses the given container image reference, following the OCI distribution grammar:
+// the images not specifying the registry are normalized to Docker Hub, along with its library namespace for the official ones.
+func NewRegistry(value string) (Registry, error) {
+	var reg registry
+
+	name := value
 
-	for i, name := range r.SubexpNames() {
-		if i > 0 && i <= len(match) {
-			reg[name] = match[i]
+	if i := strings.Index(name, "@"); i >= 0 {
+		name, reg.digest = name[:i], name[i+1:]
+
+		if !digestRegexp.MatchString(reg.digest) {
+			return nil, NewInvalidImageReferenceError(value, "invalid digest")
+		}
+	}
+	// The tag separator must follow the last path separator, since the registry could specify a port
+	if i := strings.LastIndex(name, ":"); i > strings.LastIndex(name, "/") {
+		name, reg.tag = name[:i], name[i+1:]
+
+		if !tagRegexp.MatchString(reg.tag) {
+			return nil, NewInvalidImageReferenceError(value, "invalid tag")
+		}
+	}
+
+	if len(name) == 0 {
+		return nil, NewInvalidImageReferenceError(value, "missing repository")
+	}
+
+	reg.repository = name
+
+	if i := strings.Index(name, "/"); i >= 0 {
+		if domain := name[:i]; strings.ContainsAny(domain, ".:") || domain == "localhost" {
+			if !domainRegexp.MatchString(domain) {
+				return nil, NewInvalidImageReferenceError(value, "invalid registry")
+			}
+
+			reg.registry, reg.repository, reg.fullyQualified = domain, name[i+1:], true
 		}
 	}
 
-	return reg
+	for _, component := range strings.Split(reg.repository, "/") {
+		if !componentRegexp.MatchString(component) {
+			return nil, NewInvalidImageReferenceError(value, "invalid repository")
+		}
+	}
+
+	if len(reg.registry) == 0 {
+		reg.registry = dockerHubRegistry
+	}
+
+	if reg.registry == dockerHubRegistry && !strings.Contains(reg.repository, "/") {
+		reg.repository = dockerHubLibrary + "/" + reg.repository
+	}
+
+	if len(reg.Name()) > maxNameLength {
+		return nil, NewInvalidImageReferenceError(value, "name too long")
+	}
+
+	return reg, nil
 }
 
 type Registry interface {
+	// Registry returns the registry host, along with its port if any.
 	Registry() string
+	// Repository returns the repository path within the registry, such as library/nginx.
 	Repository() string
+	// Image returns the last component of the repository path.
 	Image() string
+	// Tag returns the image tag, empty if not specified.
 	Tag() string
+	// Digest returns the image digest, empty if not specified.
+	Digest() string
+	// Name returns the registry along with the repository path, such as docker.io/library/nginx.
+	Name() string
+	// FullyQualified returns true if the registry is specified by the reference, rather than normalized.
+	FullyQualified() bool
+	// String returns the normalized reference.
+	String() string
 }
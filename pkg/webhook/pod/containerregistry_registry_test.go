@@ -0,0 +1,68 @@
+// Copyright 2020-2023 Project Capsule Authors.
+// SPDX-License-Identifier: Apache-2.0
+
+package pod
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewRegistry(t *testing.T) {
+	digest := "sha256:9b7b8a3ba0ee4a4e2b3b1d6ce8c6e6b7f0e6ee4a7b1e4a2d8c2f5d3e1a0b9c8d"
+
+	for _, tc := range []struct {
+		image          string
+		registry       string
+		repository     string
+		tag            string
+		digest         string
+		fullyQualified bool
+	}{
+		{image: "nginx", registry: "docker.io", repository: "library/nginx"},
+		{image: "nginx:1.25", registry: "docker.io", repository: "library/nginx", tag: "1.25"},
+		{image: "bitnami/redis:7.2", registry: "docker.io", repository: "bitnami/redis", tag: "7.2"},
+		{image: "docker.io/nginx", registry: "docker.io", repository: "library/nginx", fullyQualified: true},
+		{image: "gcr.io/google_containers/pause-amd64:3.0", registry: "gcr.io", repository: "google_containers/pause-amd64", tag: "3.0", fullyQualified: true},
+		{image: "localhost/app", registry: "localhost", repository: "app", fullyQualified: true},
+		{image: "registry.internal:5000/team/app", registry: "registry.internal:5000", repository: "team/app", fullyQualified: true},
+		{image: "registry.internal:5000/team/app:v1@" + digest, registry: "registry.internal:5000", repository: "team/app", tag: "v1", digest: digest, fullyQualified: true},
+		{image: "ghcr.io/projectcapsule/capsule@" + digest, registry: "ghcr.io", repository: "projectcapsule/capsule", digest: digest, fullyQualified: true},
+	} {
+		reg, err := NewRegistry(tc.image)
+		if !assert.NoError(t, err, tc.image) {
+			continue
+		}
+
+		assert.Equal(t, tc.registry, reg.Registry(), tc.image)
+		assert.Equal(t, tc.repository, reg.Repository(), tc.image)
+		assert.Equal(t, tc.tag, reg.Tag(), tc.image)
+		assert.Equal(t, tc.digest, reg.Digest(), tc.image)
+		assert.Equal(t, tc.fullyQualified, reg.FullyQualified(), tc.image)
+	}
+
+	for _, image := range []string{"", ":latest", "Nginx", "docker.io/nginx:", "nginx@sha256:short", "registry:port/app", "docker.io//nginx"} {
+		_, err := NewRegistry(image)
+		assert.Error(t, err, image)
+	}
+}
+
+func TestImagePolicyMatching(t *testing.T) {
+	reg, err := NewRegistry("ghcr.io/projectcapsule/capsule:v0.4.0")
+	assert.NoError(t, err)
+
+	assert.True(t, isRepositoryAllowed(reg, []string{"docker.io", "ghcr.io/projectcapsule/"}))
+	assert.True(t, isRepositoryAllowed(reg, []string{"ghcr.io"}))
+	assert.False(t, isRepositoryAllowed(reg, []string{"ghcr.io/projectcapsule/caps"}))
+
+	assert.True(t, isImageDenied(reg, []string{"ghcr.io/projectcapsule/capsule"}))
+	assert.True(t, isImageDenied(reg, []string{"ghcr.io/projectcapsule/capsule:v0.4.0"}))
+	assert.False(t, isImageDenied(reg, []string{"ghcr.io/projectcapsule/capsule:v0.3.0", "ghcr.io/projectcapsule/capsule-proxy"}))
+
+	hub, err := NewRegistry("nginx:latest")
+	assert.NoError(t, err)
+
+	assert.True(t, isImageDenied(hub, []string{"docker.io/library/nginx"}))
+	assert.True(t, isRepositoryAllowed(hub, []string{"docker.io/library"}))
+}
@@ -0,0 +1,173 @@
+// Copyright 2020-2023 Project Capsule Authors.
+// SPDX-License-Identifier: Apache-2.0
+
+package pod
+
+import (
+	"context"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	capsulev1beta2 "github.com/projectcapsule/capsule/api/v1beta2"
+	"github.com/projectcapsule/capsule/pkg/api"
+	capsuleutils "github.com/projectcapsule/capsule/pkg/utils"
+	capsulewebhook "github.com/projectcapsule/capsule/pkg/webhook"
+	"github.com/projectcapsule/capsule/pkg/webhook/utils"
+)
+
+type imagePolicyHandler struct{}
+
+func ImagePolicy() capsulewebhook.Handler {
+	return &imagePolicyHandler{}
+}
+
+func (h *imagePolicyHandler) OnCreate(c client.Client, decoder *admission.Decoder, recorder record.EventRecorder) capsulewebhook.Func {
+	return func(ctx context.Context, req admission.Request) *admission.Response {
+		return h.validate(ctx, c, decoder, recorder, req)
+	}
+}
+
+func (h *imagePolicyHandler) OnDelete(client.Client, *admission.Decoder, record.EventRecorder) capsulewebhook.Func {
+	return func(context.Context, admission.Request) *admission.Response {
+		return nil
+	}
+}
+
+// Images can be changed upon updates, as well as ephemeral containers can be added.
+func (h *imagePolicyHandler) OnUpdate(c client.Client, decoder *admission.Decoder, recorder record.EventRecorder) capsulewebhook.Func {
+	return func(ctx context.Context, req admission.Request) *admission.Response {
+		return h.validate(ctx, c, decoder, recorder, req)
+	}
+}
+
+func (h *imagePolicyHandler) validate(ctx context.Context, c client.Client, decoder *admission.Decoder, recorder record.EventRecorder, req admission.Request) *admission.Response {
+	pod := &corev1.Pod{}
+	if err := decoder.Decode(req, pod); err != nil {
+		return utils.ErroredResponse(err)
+	}
+
+	tntList := &capsulev1beta2.TenantList{}
+	if err := c.List(ctx, tntList, client.MatchingFieldsSelector{
+		Selector: fields.OneTermEqualSelector(".status.namespaces", pod.Namespace),
+	}); err != nil {
+		return utils.ErroredResponse(err)
+	}
+
+	if len(tntList.Items) == 0 {
+		return nil
+	}
+
+	tnt := tntList.Items[0]
+	// Image policy could be inherited by the parent Tenant
+	if err := capsuleutils.ResolveTenantInheritance(ctx, c, &tnt); err != nil {
+		return utils.ErroredResponse(err)
+	}
+
+	if tnt.Spec.ImagePolicy == nil {
+		return nil
+	}
+
+	for _, image := range podImages(pod) {
+		if response := h.verifyImage(recorder, req, image, tnt); response != nil {
+			return response
+		}
+	}
+
+	return nil
+}
+
+func (h *imagePolicyHandler) verifyImage(recorder record.EventRecorder, req admission.Request, image string, tnt capsulev1beta2.Tenant) *admission.Response {
+	policy := tnt.Spec.ImagePolicy
+
+	deny := func(reason string, err error) *admission.Response {
+		recorder.Eventf(&tnt, corev1.EventTypeWarning, reason, "Pod %s/%s is using the container image %s that is forbidden for the current Tenant", req.Namespace, req.Name, image)
+
+		response := admission.Denied(err.Error())
+
+		return &response
+	}
+
+	reg, err := NewRegistry(image)
+	if err != nil {
+		return deny("InvalidImageReference", err)
+	}
+
+	if isImageDenied(reg, policy.DeniedImages) {
+		return deny("ForbiddenImage", NewImageForbiddenError(image))
+	}
+
+	if len(policy.AllowedRepositories) > 0 && !isRepositoryAllowed(reg, policy.AllowedRepositories) {
+		return deny("ForbiddenImageRepository", NewImageRepositoryForbiddenError(image, policy.AllowedRepositories))
+	}
+
+	if policy.ForbidLatestTag && (reg.Tag() == "latest" || (len(reg.Tag()) == 0 && len(reg.Digest()) == 0)) {
+		return deny("ForbiddenImageLatestTag", NewImageLatestTagForbiddenError(image))
+	}
+
+	if policy.RequireDigest && len(reg.Digest()) == 0 {
+		return deny("MissingImageDigest", NewImageDigestRequiredError(image))
+	}
+
+	return nil
+}
+
+// isImageDenied returns true if the image matches one of the denied ones:
+// when a tag or a digest is specified, only the given one is denied.
+func isImageDenied(reg Registry, denied []string) bool {
+	for _, value := range denied {
+		entry, err := NewRegistry(value)
+		if err != nil {
+			continue
+		}
+
+		if entry.Name() != reg.Name() {
+			continue
+		}
+
+		if len(entry.Tag()) > 0 && entry.Tag() != reg.Tag() {
+			continue
+		}
+
+		if len(entry.Digest()) > 0 && entry.Digest() != reg.Digest() {
+			continue
+		}
+
+		return true
+	}
+
+	return false
+}
+
+// isRepositoryAllowed returns true if the image is hosted by one of the allowed repositories,
+// matching the registry along with the path prefix by whole components.
+func isRepositoryAllowed(reg Registry, allowed []string) bool {
+	for _, repository := range allowed {
+		repository = strings.TrimSuffix(repository, "/")
+
+		if hasPathPrefix(reg.Name(), repository) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ValidateImagePolicy returns an error if the denied images of the given policy are not valid references.
+func ValidateImagePolicy(policy *api.ImagePolicySpec) error {
+	if policy == nil {
+		return nil
+	}
+
+	for _, image := range policy.DeniedImages {
+		if _, err := NewRegistry(image); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
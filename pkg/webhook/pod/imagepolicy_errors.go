@@ -0,0 +1,58 @@
+// Copyright 2020-2023 Project Capsule Authors.
+// SPDX-License-Identifier: Apache-2.0
+
+package pod
+
+import (
+	"fmt"
+	"strings"
+)
+
+type imageForbiddenError struct {
+	image string
+}
+
+func NewImageForbiddenError(image string) error {
+	return &imageForbiddenError{image: image}
+}
+
+func (i imageForbiddenError) Error() string {
+	return fmt.Sprintf("Container image %s is forbidden for the current Tenant", i.image)
+}
+
+type imageRepositoryForbiddenError struct {
+	image   string
+	allowed []string
+}
+
+func NewImageRepositoryForbiddenError(image string, allowed []string) error {
+	return &imageRepositoryForbiddenError{image: image, allowed: allowed}
+}
+
+func (i imageRepositoryForbiddenError) Error() string {
+	return fmt.Sprintf("Container image %s repository is forbidden for the current Tenant: use one from the following list (%s)", i.image, strings.Join(i.allowed, ", "))
+}
+
+type imageLatestTagForbiddenError struct {
+	image string
+}
+
+func NewImageLatestTagForbiddenError(image string) error {
+	return &imageLatestTagForbiddenError{image: image}
+}
+
+func (i imageLatestTagForbiddenError) Error() string {
+	return fmt.Sprintf("Container image %s is forbidden for the current Tenant: specify a tag other than latest, or a digest", i.image)
+}
+
+type imageDigestRequiredError struct {
+	image string
+}
+
+func NewImageDigestRequiredError(image string) error {
+	return &imageDigestRequiredError{image: image}
+}
+
+func (i imageDigestRequiredError) Error() string {
+	return fmt.Sprintf("Container image %s is forbidden for the current Tenant: the image must be pinned with a digest", i.image)
+}
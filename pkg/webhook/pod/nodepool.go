@@ -0,0 +1,149 @@
+// Copyright 2020-2023 Project Capsule Authors.
+// SPDX-License-Identifier: Apache-2.0
+
+package pod
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	capsulev1beta2 "github.com/projectcapsule/capsule/api/v1beta2"
+	"github.com/projectcapsule/capsule/pkg/api"
+	capsuleutils "github.com/projectcapsule/capsule/pkg/utils"
+	capsulewebhook "github.com/projectcapsule/capsule/pkg/webhook"
+	"github.com/projectcapsule/capsule/pkg/webhook/utils"
+)
+
+type nodePoolHandler struct{}
+
+func NodePool() capsulewebhook.Handler {
+	return &nodePoolHandler{}
+}
+
+func (h *nodePoolHandler) OnCreate(c client.Client, decoder *admission.Decoder, recorder record.EventRecorder) capsulewebhook.Func {
+	return func(ctx context.Context, req admission.Request) *admission.Response {
+		pod := &corev1.Pod{}
+		if err := decoder.Decode(req, pod); err != nil {
+			return utils.ErroredResponse(err)
+		}
+
+		return h.validate(ctx, c, recorder, req, pod.Spec.Tolerations)
+	}
+}
+
+func (h *nodePoolHandler) OnDelete(client.Client, *admission.Decoder, record.EventRecorder) capsulewebhook.Func {
+	return func(context.Context, admission.Request) *admission.Response {
+		return nil
+	}
+}
+
+// Tolerations can be added upon updates: the existing ones are not validated again.
+func (h *nodePoolHandler) OnUpdate(c client.Client, decoder *admission.Decoder, recorder record.EventRecorder) capsulewebhook.Func {
+	return func(ctx context.Context, req admission.Request) *admission.Response {
+		oldPod, newPod := &corev1.Pod{}, &corev1.Pod{}
+
+		if err := decoder.DecodeRaw(req.OldObject, oldPod); err != nil {
+			return utils.ErroredResponse(err)
+		}
+
+		if err := decoder.Decode(req, newPod); err != nil {
+			return utils.ErroredResponse(err)
+		}
+
+		var added []corev1.Toleration
+
+		for _, toleration := range newPod.Spec.Tolerations {
+			var found bool
+
+			for _, old := range oldPod.Spec.Tolerations {
+				if old.MatchToleration(&toleration) {
+					found = true
+
+					break
+				}
+			}
+
+			if !found {
+				added = append(added, toleration)
+			}
+		}
+
+		return h.validate(ctx, c, recorder, req, added)
+	}
+}
+
+func (h *nodePoolHandler) validate(ctx context.Context, c client.Client, recorder record.EventRecorder, req admission.Request, tolerations []corev1.Toleration) *admission.Response {
+	if len(tolerations) == 0 {
+		return nil
+	}
+
+	tntList := &capsulev1beta2.TenantList{}
+	if err := c.List(ctx, tntList, client.MatchingFieldsSelector{
+		Selector: fields.OneTermEqualSelector(".status.namespaces", req.Namespace),
+	}); err != nil {
+		return utils.ErroredResponse(err)
+	}
+
+	if len(tntList.Items) == 0 {
+		return nil
+	}
+
+	tnt := tntList.Items[0]
+	// Node pool could be inherited by the parent Tenant
+	if err := capsuleutils.ResolveTenantInheritance(ctx, c, &tnt); err != nil {
+		return utils.ErroredResponse(err)
+	}
+
+	var owned []api.ReservedTaintSpec
+
+	if tnt.Spec.NodePool != nil {
+		owned = tnt.Spec.NodePool.ReservedTaints
+	}
+
+	tenants := &capsulev1beta2.TenantList{}
+	if err := c.List(ctx, tenants); err != nil {
+		return utils.ErroredResponse(err)
+	}
+	// The inherited taints are reserved by the parent Tenants as well, thus it's enough to consider the declared ones.
+	for _, other := range tenants.Items {
+		if other.GetName() == tnt.GetName() || other.Spec.NodePool == nil {
+			continue
+		}
+
+		for _, taint := range other.Spec.NodePool.ReservedTaints {
+			if isReservedTaintOwned(taint, owned) {
+				continue
+			}
+
+			for _, toleration := range tolerations {
+				if !taint.IsToleratedBy(toleration) {
+					continue
+				}
+
+				recorder.Eventf(&tnt, corev1.EventTypeWarning, "ForbiddenToleration", "Pod %s/%s is tolerating the taint %s reserved to another Tenant", req.Namespace, req.Name, taint.Key)
+
+				response := admission.Denied(NewTolerationForbiddenError(toleration, taint).Error())
+
+				return &response
+			}
+		}
+	}
+
+	return nil
+}
+
+// isReservedTaintOwned returns true if the taint is reserved to the Tenant as well, such as a taint inherited by the parent.
+func isReservedTaintOwned(taint api.ReservedTaintSpec, owned []api.ReservedTaintSpec) bool {
+	for _, t := range owned {
+		if t == taint {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,30 @@
+// Copyright 2020-2023 Project Capsule Authors.
+// SPDX-License-Identifier: Apache-2.0
+
+package pod
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/projectcapsule/capsule/pkg/api"
+)
+
+type tolerationForbiddenError struct {
+	toleration corev1.Toleration
+	taint      api.ReservedTaintSpec
+}
+
+func NewTolerationForbiddenError(toleration corev1.Toleration, taint api.ReservedTaintSpec) error {
+	return &tolerationForbiddenError{toleration: toleration, taint: taint}
+}
+
+func (t tolerationForbiddenError) Error() string {
+	key := t.toleration.Key
+	if len(key) == 0 {
+		key = "*"
+	}
+
+	return fmt.Sprintf("Toleration %s is forbidden for the current Tenant: the taint %s is reserved to the node pool of another Tenant", key, t.taint.Key)
+}
@@ -0,0 +1,198 @@
+// Copyright 2020-2023 Project Capsule Authors.
+// SPDX-License-Identifier: Apache-2.0
+
+package pod
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	capsulev1beta2 "github.com/projectcapsule/capsule/api/v1beta2"
+	"github.com/projectcapsule/capsule/pkg/api"
+	capsuleutils "github.com/projectcapsule/capsule/pkg/utils"
+	capsulewebhook "github.com/projectcapsule/capsule/pkg/webhook"
+	"github.com/projectcapsule/capsule/pkg/webhook/utils"
+)
+
+type podSecurityHandler struct{}
+
+func PodSecurity() capsulewebhook.Handler {
+	return &podSecurityHandler{}
+}
+
+func (h *podSecurityHandler) OnCreate(c client.Client, decoder *admission.Decoder, recorder record.EventRecorder) capsulewebhook.Func {
+	return func(ctx context.Context, req admission.Request) *admission.Response {
+		return h.validate(ctx, c, decoder, recorder, req)
+	}
+}
+
+func (h *podSecurityHandler) OnDelete(client.Client, *admission.Decoder, record.EventRecorder) capsulewebhook.Func {
+	return func(context.Context, admission.Request) *admission.Response {
+		return nil
+	}
+}
+
+// The Pod security settings are immutable, although ephemeral containers can be added.
+func (h *podSecurityHandler) OnUpdate(c client.Client, decoder *admission.Decoder, recorder record.EventRecorder) capsulewebhook.Func {
+	return func(ctx context.Context, req admission.Request) *admission.Response {
+		if req.SubResource != "ephemeralcontainers" {
+			return nil
+		}
+
+		return h.validate(ctx, c, decoder, recorder, req)
+	}
+}
+
+func (h *podSecurityHandler) validate(ctx context.Context, c client.Client, decoder *admission.Decoder, recorder record.EventRecorder, req admission.Request) *admission.Response {
+	pod := &corev1.Pod{}
+	if err := decoder.Decode(req, pod); err != nil {
+		return utils.ErroredResponse(err)
+	}
+
+	tntList := &capsulev1beta2.TenantList{}
+	if err := c.List(ctx, tntList, client.MatchingFieldsSelector{
+		Selector: fields.OneTermEqualSelector(".status.namespaces", pod.Namespace),
+	}); err != nil {
+		return utils.ErroredResponse(err)
+	}
+
+	if len(tntList.Items) == 0 {
+		return nil
+	}
+
+	tnt := tntList.Items[0]
+	// Pod security could be inherited by the parent Tenant
+	if err := capsuleutils.ResolveTenantInheritance(ctx, c, &tnt); err != nil {
+		return utils.ErroredResponse(err)
+	}
+
+	if tnt.Spec.PodSecurity == nil || tnt.Spec.PodSecurity.Restrictions == nil {
+		return nil
+	}
+
+	allowances, err := newPodSecurityAllowances(tnt.Spec.PodSecurity.Restrictions, req.Namespace, pod)
+	if err != nil {
+		return utils.ErroredResponse(err)
+	}
+
+	deny := func(reason string, err error) *admission.Response {
+		recorder.Eventf(&tnt, corev1.EventTypeWarning, reason, "Pod %s/%s is violating the Pod security restrictions of the current Tenant: %s", req.Namespace, req.Name, err.Error())
+
+		response := admission.Denied(err.Error())
+
+		return &response
+	}
+
+	if pod.Spec.HostNetwork && !allowances.hostNetwork {
+		return deny("ForbiddenHostNetwork", NewHostNetworkForbiddenError())
+	}
+
+	if !allowances.hostPath {
+		for _, volume := range pod.Spec.Volumes {
+			if volume.HostPath != nil {
+				return deny("ForbiddenHostPathVolume", NewHostPathVolumeForbiddenError(volume.Name))
+			}
+		}
+	}
+
+	for _, container := range podSecurityContexts(pod) {
+		if container.securityContext == nil {
+			continue
+		}
+
+		if container.securityContext.Privileged != nil && *container.securityContext.Privileged && !allowances.privileged {
+			return deny("ForbiddenPrivilegedContainer", NewPrivilegedContainerForbiddenError(container.name))
+		}
+
+		if allowances.capabilities == nil || container.securityContext.Capabilities == nil {
+			continue
+		}
+
+		for _, capability := range container.securityContext.Capabilities.Add {
+			if !allowances.capabilities.Has(capability) {
+				return deny("ForbiddenCapability", NewCapabilityForbiddenError(container.name, capability, sets.List(allowances.capabilities)))
+			}
+		}
+	}
+
+	return nil
+}
+
+type podSecurityAllowances struct {
+	hostNetwork  bool
+	hostPath     bool
+	privileged   bool
+	capabilities sets.Set[corev1.Capability]
+}
+
+// newPodSecurityAllowances returns what is allowed to the given Pod, according to the Tenant restrictions
+// along with the exceptions matching its Namespace and labels: a nil capabilities set allows any capability.
+func newPodSecurityAllowances(restrictions *api.PodSecurityRestrictionsSpec, namespace string, pod *corev1.Pod) (allowances podSecurityAllowances, err error) {
+	allowances.hostNetwork = !restrictions.ForbidHostNetwork
+	allowances.hostPath = !restrictions.ForbidHostPath
+	allowances.privileged = !restrictions.ForbidPrivileged
+
+	if restrictions.AllowedCapabilities != nil {
+		allowances.capabilities = sets.New[corev1.Capability](*restrictions.AllowedCapabilities...)
+	}
+
+	for _, exception := range restrictions.Exceptions {
+		if len(exception.Namespaces) > 0 && !sets.New[string](exception.Namespaces...).Has(namespace) {
+			continue
+		}
+
+		if exception.PodSelector != nil {
+			var selector labels.Selector
+
+			if selector, err = metav1.LabelSelectorAsSelector(exception.PodSelector); err != nil {
+				return allowances, err
+			}
+
+			if !selector.Matches(labels.Set(pod.GetLabels())) {
+				continue
+			}
+		}
+
+		allowances.hostNetwork = allowances.hostNetwork || exception.AllowHostNetwork
+		allowances.hostPath = allowances.hostPath || exception.AllowHostPath
+		allowances.privileged = allowances.privileged || exception.AllowPrivileged
+
+		if allowances.capabilities != nil {
+			allowances.capabilities.Insert(exception.AllowedCapabilities...)
+		}
+	}
+
+	return allowances, nil
+}
+
+type containerSecurityContext struct {
+	name            string
+	securityContext *corev1.SecurityContext
+}
+
+// podSecurityContexts returns the security contexts of the init, regular, and ephemeral containers of the Pod.
+func podSecurityContexts(pod *corev1.Pod) []containerSecurityContext {
+	contexts := make([]containerSecurityContext, 0, len(pod.Spec.InitContainers)+len(pod.Spec.Containers)+len(pod.Spec.EphemeralContainers))
+
+	for _, container := range pod.Spec.InitContainers {
+		contexts = append(contexts, containerSecurityContext{name: container.Name, securityContext: container.SecurityContext})
+	}
+
+	for _, container := range pod.Spec.Containers {
+		contexts = append(contexts, containerSecurityContext{name: container.Name, securityContext: container.SecurityContext})
+	}
+
+	for _, container := range pod.Spec.EphemeralContainers {
+		contexts = append(contexts, containerSecurityContext{name: container.Name, securityContext: container.SecurityContext})
+	}
+
+	return contexts
+}
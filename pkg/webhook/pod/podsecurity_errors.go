@@ -0,0 +1,69 @@
+// Copyright 2020-2023 Project Capsule Authors.
+// SPDX-License-Identifier: Apache-2.0
+
+package pod
+
+import (
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+type hostNetworkForbiddenError struct{}
+
+func NewHostNetworkForbiddenError() error {
+	return &hostNetworkForbiddenError{}
+}
+
+func (hostNetworkForbiddenError) Error() string {
+	return "Pod using the host network is forbidden for the current Tenant"
+}
+
+type hostPathVolumeForbiddenError struct {
+	volume string
+}
+
+func NewHostPathVolumeForbiddenError(volume string) error {
+	return &hostPathVolumeForbiddenError{volume: volume}
+}
+
+func (h hostPathVolumeForbiddenError) Error() string {
+	return fmt.Sprintf("Pod volume %s of type hostPath is forbidden for the current Tenant", h.volume)
+}
+
+type privilegedContainerForbiddenError struct {
+	container string
+}
+
+func NewPrivilegedContainerForbiddenError(container string) error {
+	return &privilegedContainerForbiddenError{container: container}
+}
+
+func (p privilegedContainerForbiddenError) Error() string {
+	return fmt.Sprintf("Privileged container %s is forbidden for the current Tenant", p.container)
+}
+
+type capabilityForbiddenError struct {
+	container  string
+	capability corev1.Capability
+	allowed    []corev1.Capability
+}
+
+func NewCapabilityForbiddenError(container string, capability corev1.Capability, allowed []corev1.Capability) error {
+	return &capabilityForbiddenError{container: container, capability: capability, allowed: allowed}
+}
+
+func (c capabilityForbiddenError) Error() string {
+	if len(c.allowed) == 0 {
+		return fmt.Sprintf("Container %s adding the capability %s is forbidden for the current Tenant: no capability can be added", c.container, c.capability)
+	}
+
+	allowed := make([]string, 0, len(c.allowed))
+
+	for _, capability := range c.allowed {
+		allowed = append(allowed, string(capability))
+	}
+
+	return fmt.Sprintf("Container %s adding the capability %s is forbidden for the current Tenant: use one from the following list (%s)", c.container, c.capability, strings.Join(allowed, ", "))
+}
@@ -0,0 +1,59 @@
+// Copyright 2020-2023 Project Capsule Authors.
+// SPDX-License-Identifier: Apache-2.0
+
+package pod
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/projectcapsule/capsule/pkg/api"
+)
+
+func TestPodSecurityAllowances(t *testing.T) {
+	restrictions := &api.PodSecurityRestrictionsSpec{
+		ForbidHostNetwork:   true,
+		ForbidHostPath:      true,
+		ForbidPrivileged:    true,
+		AllowedCapabilities: &[]corev1.Capability{"NET_BIND_SERVICE"},
+		Exceptions: []api.PodSecurityExceptionSpec{
+			{
+				Namespaces:       []string{"oil-monitoring"},
+				AllowHostNetwork: true,
+			},
+			{
+				PodSelector:         &metav1.LabelSelector{MatchLabels: map[string]string{"app": "csi"}},
+				AllowHostPath:       true,
+				AllowPrivileged:     true,
+				AllowedCapabilities: []corev1.Capability{"SYS_ADMIN"},
+			},
+		},
+	}
+
+	pod := &corev1.Pod{}
+
+	allowances, err := newPodSecurityAllowances(restrictions, "oil-production", pod)
+	assert.NoError(t, err)
+	assert.False(t, allowances.hostNetwork || allowances.hostPath || allowances.privileged)
+	assert.ElementsMatch(t, []corev1.Capability{"NET_BIND_SERVICE"}, allowances.capabilities.UnsortedList())
+
+	allowances, err = newPodSecurityAllowances(restrictions, "oil-monitoring", pod)
+	assert.NoError(t, err)
+	assert.True(t, allowances.hostNetwork)
+	assert.False(t, allowances.hostPath || allowances.privileged)
+
+	pod.SetLabels(map[string]string{"app": "csi"})
+
+	allowances, err = newPodSecurityAllowances(restrictions, "oil-production", pod)
+	assert.NoError(t, err)
+	assert.False(t, allowances.hostNetwork)
+	assert.True(t, allowances.hostPath && allowances.privileged)
+	assert.ElementsMatch(t, []corev1.Capability{"NET_BIND_SERVICE", "SYS_ADMIN"}, allowances.capabilities.UnsortedList())
+
+	allowances, err = newPodSecurityAllowances(&api.PodSecurityRestrictionsSpec{}, "oil-production", pod)
+	assert.NoError(t, err)
+	assert.Nil(t, allowances.capabilities)
+}
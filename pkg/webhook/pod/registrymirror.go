@@ -0,0 +1,94 @@
+// Copyright 2020-2023 Project Capsule Authors.
+// SPDX-License-Identifier: Apache-2.0
+
+package pod
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/projectcapsule/capsule/pkg/api"
+)
+
+// MirrorImage returns the given image rewritten to the mirror of the longest matching source,
+// preserving its tag and digest: the returned bool is false if no source is matching.
+func MirrorImage(image string, mirrors []api.RegistryMirrorSpec) (string, bool, error) {
+	reg, err := NewRegistry(image)
+	if err != nil {
+		return "", false, err
+	}
+
+	var match *api.RegistryMirrorSpec
+
+	for i := range mirrors {
+		source := strings.TrimSuffix(mirrors[i].Source, "/")
+
+		if !hasPathPrefix(reg.Name(), source) {
+			continue
+		}
+
+		if match == nil || len(source) > len(strings.TrimSuffix(match.Source, "/")) {
+			match = &mirrors[i]
+		}
+	}
+
+	if match == nil {
+		return "", false, nil
+	}
+
+	rewritten := strings.TrimSuffix(match.Mirror, "/") + strings.TrimPrefix(reg.Name(), strings.TrimSuffix(match.Source, "/"))
+
+	if len(reg.Tag()) > 0 {
+		rewritten += ":" + reg.Tag()
+	}
+
+	if len(reg.Digest()) > 0 {
+		rewritten += "@" + reg.Digest()
+	}
+
+	return rewritten, true, nil
+}
+
+// ValidateRegistryMirrors returns an error if the sources or the mirrors are not valid registries,
+// along with an optional path prefix, or if a mirror would be rewritten in turn by a source.
+func ValidateRegistryMirrors(mirrors []api.RegistryMirrorSpec) error {
+	for _, mirror := range mirrors {
+		for _, value := range []string{mirror.Source, mirror.Mirror} {
+			if err := validatePathPrefix(value); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, mirror := range mirrors {
+		for _, source := range mirrors {
+			if hasPathPrefix(strings.TrimSuffix(mirror.Mirror, "/"), strings.TrimSuffix(source.Source, "/")) {
+				return fmt.Errorf("mirror %s would be rewritten by the source %s", mirror.Mirror, source.Source)
+			}
+		}
+	}
+
+	return nil
+}
+
+// validatePathPrefix checks the given value is a registry along with an optional path prefix,
+// by parsing it along with a two components repository, which is not subject to the Docker Hub normalization.
+func validatePathPrefix(value string) error {
+	value = strings.TrimSuffix(value, "/")
+
+	reg, err := NewRegistry(value + "/mirror/image")
+	if err != nil {
+		return fmt.Errorf("%s is not a valid registry: %w", value, err)
+	}
+
+	if !reg.FullyQualified() || reg.Name() != value+"/mirror/image" {
+		return fmt.Errorf("%s is not a valid registry, along with an optional path prefix", value)
+	}
+
+	return nil
+}
+
+// hasPathPrefix returns true if the given name starts with the prefix by whole path components.
+func hasPathPrefix(name, prefix string) bool {
+	return name == prefix || strings.HasPrefix(name, prefix+"/")
+}
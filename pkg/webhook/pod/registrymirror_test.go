@@ -0,0 +1,60 @@
+// Copyright 2020-2023 Project Capsule Authors.
+// SPDX-License-Identifier: Apache-2.0
+
+package pod
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/projectcapsule/capsule/pkg/api"
+)
+
+func TestMirrorImage(t *testing.T) {
+	digest := "sha256:9b7b8a3ba0ee4a4e2b3b1d6ce8c6e6b7f0e6ee4a7b1e4a2d8c2f5d3e1a0b9c8d"
+
+	mirrors := []api.RegistryMirrorSpec{
+		{Source: "docker.io", Mirror: "mirror.internal/dockerhub"},
+		{Source: "docker.io/bitnami", Mirror: "mirror.internal/bitnami/"},
+		{Source: "quay.io/", Mirror: "mirror.internal:5000"},
+	}
+
+	for image, expected := range map[string]string{
+		"nginx":                              "mirror.internal/dockerhub/library/nginx",
+		"nginx:1.25":                         "mirror.internal/dockerhub/library/nginx:1.25",
+		"docker.io/library/nginx@" + digest:  "mirror.internal/dockerhub/library/nginx@" + digest,
+		"bitnami/redis:7.2":                  "mirror.internal/bitnami/redis:7.2",
+		"docker.io/bitnamilegacy/redis":      "mirror.internal/dockerhub/bitnamilegacy/redis",
+		"quay.io/prometheus/prometheus:v2.0": "mirror.internal:5000/prometheus/prometheus:v2.0",
+	} {
+		rewritten, ok, err := MirrorImage(image, mirrors)
+		assert.NoError(t, err, image)
+		assert.True(t, ok, image)
+		assert.Equal(t, expected, rewritten, image)
+	}
+
+	_, ok, err := MirrorImage("ghcr.io/projectcapsule/capsule:v0.4.0", mirrors)
+	assert.NoError(t, err)
+	assert.False(t, ok)
+
+	_, _, err = MirrorImage("Nginx", mirrors)
+	assert.Error(t, err)
+}
+
+func TestValidateRegistryMirrors(t *testing.T) {
+	assert.NoError(t, ValidateRegistryMirrors([]api.RegistryMirrorSpec{
+		{Source: "docker.io", Mirror: "mirror.internal/dockerhub"},
+		{Source: "registry.k8s.io", Mirror: "localhost:5000"},
+	}))
+
+	for _, mirrors := range [][]api.RegistryMirrorSpec{
+		{{Source: "docker", Mirror: "mirror.internal/dockerhub"}},
+		{{Source: "docker.io", Mirror: "mirror.internal/DockerHub"}},
+		{{Source: "docker.io", Mirror: "mirror.internal/dockerhub:latest"}},
+		{{Source: "docker.io", Mirror: "docker.io/mirror"}},
+		{{Source: "quay.io", Mirror: "mirror.internal/quay"}, {Source: "mirror.internal", Mirror: "mirror.local"}},
+	} {
+		assert.Error(t, ValidateRegistryMirrors(mirrors), mirrors)
+	}
+}
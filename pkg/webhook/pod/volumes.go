@@ -0,0 +1,262 @@
+// Copyright 2020-2023 Project Capsule Authors.
+// SPDX-License-Identifier: Apache-2.0
+
+package pod
+
+import (
+	"context"
+	"path"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	capsulev1beta2 "github.com/projectcapsule/capsule/api/v1beta2"
+	"github.com/projectcapsule/capsule/pkg/api"
+	capsuleutils "github.com/projectcapsule/capsule/pkg/utils"
+	capsulewebhook "github.com/projectcapsule/capsule/pkg/webhook"
+	"github.com/projectcapsule/capsule/pkg/webhook/utils"
+)
+
+type volumesHandler struct{}
+
+func Volumes() capsulewebhook.Handler {
+	return &volumesHandler{}
+}
+
+func (h *volumesHandler) OnCreate(c client.Client, decoder *admission.Decoder, recorder record.EventRecorder) capsulewebhook.Func {
+	return func(ctx context.Context, req admission.Request) *admission.Response {
+		return h.validate(ctx, c, decoder, recorder, req)
+	}
+}
+
+func (h *volumesHandler) OnDelete(client.Client, *admission.Decoder, record.EventRecorder) capsulewebhook.Func {
+	return func(context.Context, admission.Request) *admission.Response {
+		return nil
+	}
+}
+
+// The Pod volumes are immutable, although ephemeral containers can be added, mounting them.
+func (h *volumesHandler) OnUpdate(c client.Client, decoder *admission.Decoder, recorder record.EventRecorder) capsulewebhook.Func {
+	return func(ctx context.Context, req admission.Request) *admission.Response {
+		if req.SubResource != "ephemeralcontainers" {
+			return nil
+		}
+
+		return h.validate(ctx, c, decoder, recorder, req)
+	}
+}
+
+func (h *volumesHandler) validate(ctx context.Context, c client.Client, decoder *admission.Decoder, recorder record.EventRecorder, req admission.Request) *admission.Response {
+	pod := &corev1.Pod{}
+	if err := decoder.Decode(req, pod); err != nil {
+		return utils.ErroredResponse(err)
+	}
+
+	tntList := &capsulev1beta2.TenantList{}
+	if err := c.List(ctx, tntList, client.MatchingFieldsSelector{
+		Selector: fields.OneTermEqualSelector(".status.namespaces", pod.Namespace),
+	}); err != nil {
+		return utils.ErroredResponse(err)
+	}
+
+	if len(tntList.Items) == 0 {
+		return nil
+	}
+
+	tnt := tntList.Items[0]
+	// Volume options could be inherited by the parent Tenant
+	if err := capsuleutils.ResolveTenantInheritance(ctx, c, &tnt); err != nil {
+		return utils.ErroredResponse(err)
+	}
+
+	if tnt.Spec.VolumeOptions == nil {
+		return nil
+	}
+
+	for _, volume := range pod.Spec.Volumes {
+		if response := h.verifyVolume(recorder, req, pod, volume, tnt); response != nil {
+			return response
+		}
+	}
+
+	return nil
+}
+
+func (h *volumesHandler) verifyVolume(recorder record.EventRecorder, req admission.Request, pod *corev1.Pod, volume corev1.Volume, tnt capsulev1beta2.Tenant) *admission.Response {
+	options := tnt.Spec.VolumeOptions
+
+	deny := func(reason string, err error) *admission.Response {
+		recorder.Eventf(&tnt, corev1.EventTypeWarning, reason, "Pod %s/%s is using the volume %s that is forbidden for the current Tenant", req.Namespace, req.Name, volume.Name)
+
+		response := admission.Denied(err.Error())
+
+		return &response
+	}
+
+	volumeType := getVolumeType(volume.VolumeSource)
+
+	if len(options.AllowedTypes) > 0 && !isVolumeTypeAllowed(volumeType, options.AllowedTypes) {
+		return deny("ForbiddenVolumeType", NewVolumeTypeForbiddenError(volume.Name, volumeType, options.AllowedTypes))
+	}
+
+	switch {
+	case volume.HostPath != nil && len(options.AllowedHostPaths) > 0:
+		allowed, readOnly := matchHostPath(volume.HostPath.Path, options.AllowedHostPaths)
+		if !allowed {
+			return deny("ForbiddenHostPath", NewHostPathForbiddenError(volume.Name, volume.HostPath.Path, options.AllowedHostPaths))
+		}
+
+		if !readOnly {
+			break
+		}
+
+		for _, container := range podVolumeMounts(pod, volume.Name) {
+			if !container.mount.ReadOnly {
+				return deny("ForbiddenWritableHostPath", NewWritableHostPathForbiddenError(volume.Name, volume.HostPath.Path, container.name))
+			}
+		}
+	case volume.CSI != nil && options.AllowedCSIDrivers != nil:
+		if !options.AllowedCSIDrivers.Match(volume.CSI.Driver) {
+			return deny("ForbiddenCSIDriver", NewCSIDriverForbiddenError(volume.Name, volume.CSI.Driver, *options.AllowedCSIDrivers))
+		}
+	case volume.NFS != nil && options.AllowedNFSServers != nil:
+		if !options.AllowedNFSServers.Match(volume.NFS.Server) {
+			return deny("ForbiddenNFSServer", NewNFSServerForbiddenError(volume.Name, volume.NFS.Server, *options.AllowedNFSServers))
+		}
+	}
+
+	return nil
+}
+
+func isVolumeTypeAllowed(volumeType api.VolumeType, allowed []api.VolumeType) bool {
+	for _, t := range allowed {
+		if t == volumeType {
+			return true
+		}
+	}
+
+	return false
+}
+
+// matchHostPath returns whether the host path is allowed, matching a prefix by whole path components,
+// and whether it must be mounted as read-only, since all the matching prefixes require it.
+func matchHostPath(hostPath string, allowed []api.AllowedHostPath) (ok bool, readOnly bool) {
+	hostPath = path.Clean(hostPath)
+
+	readOnly = true
+
+	for _, entry := range allowed {
+		prefix := path.Clean(entry.PathPrefix)
+
+		if hostPath != prefix && !strings.HasPrefix(hostPath, strings.TrimSuffix(prefix, "/")+"/") {
+			continue
+		}
+
+		ok = true
+		readOnly = readOnly && entry.ReadOnly
+	}
+
+	return ok, ok && readOnly
+}
+
+type containerVolumeMount struct {
+	name  string
+	mount corev1.VolumeMount
+}
+
+// podVolumeMounts returns the mounts of the given volume by the init, regular, and ephemeral containers of the Pod.
+func podVolumeMounts(pod *corev1.Pod, volume string) (mounts []containerVolumeMount) {
+	collect := func(container string, volumeMounts []corev1.VolumeMount) {
+		for _, mount := range volumeMounts {
+			if mount.Name == volume {
+				mounts = append(mounts, containerVolumeMount{name: container, mount: mount})
+			}
+		}
+	}
+
+	for _, container := range pod.Spec.InitContainers {
+		collect(container.Name, container.VolumeMounts)
+	}
+
+	for _, container := range pod.Spec.Containers {
+		collect(container.Name, container.VolumeMounts)
+	}
+
+	for _, container := range pod.Spec.EphemeralContainers {
+		collect(container.Name, container.VolumeMounts)
+	}
+
+	return mounts
+}
+
+// getVolumeType returns the type of the volume, named after the field of the volume source.
+//
+//nolint:gocyclo,cyclop
+func getVolumeType(source corev1.VolumeSource) api.VolumeType {
+	switch {
+	case source.HostPath != nil:
+		return "hostPath"
+	case source.EmptyDir != nil:
+		return "emptyDir"
+	case source.GCEPersistentDisk != nil:
+		return "gcePersistentDisk"
+	case source.AWSElasticBlockStore != nil:
+		return "awsElasticBlockStore"
+	case source.GitRepo != nil:
+		return "gitRepo"
+	case source.Secret != nil:
+		return "secret"
+	case source.NFS != nil:
+		return "nfs"
+	case source.ISCSI != nil:
+		return "iscsi"
+	case source.Glusterfs != nil:
+		return "glusterfs"
+	case source.PersistentVolumeClaim != nil:
+		return "persistentVolumeClaim"
+	case source.RBD != nil:
+		return "rbd"
+	case source.FlexVolume != nil:
+		return "flexVolume"
+	case source.Cinder != nil:
+		return "cinder"
+	case source.CephFS != nil:
+		return "cephfs"
+	case source.Flocker != nil:
+		return "flocker"
+	case source.DownwardAPI != nil:
+		return "downwardAPI"
+	case source.FC != nil:
+		return "fc"
+	case source.AzureFile != nil:
+		return "azureFile"
+	case source.ConfigMap != nil:
+		return "configMap"
+	case source.VsphereVolume != nil:
+		return "vsphereVolume"
+	case source.Quobyte != nil:
+		return "quobyte"
+	case source.AzureDisk != nil:
+		return "azureDisk"
+	case source.PhotonPersistentDisk != nil:
+		return "photonPersistentDisk"
+	case source.Projected != nil:
+		return "projected"
+	case source.PortworxVolume != nil:
+		return "portworxVolume"
+	case source.ScaleIO != nil:
+		return "scaleIO"
+	case source.StorageOS != nil:
+		return "storageos"
+	case source.CSI != nil:
+		return "csi"
+	case source.Ephemeral != nil:
+		return "ephemeral"
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,98 @@
+// Copyright 2020-2023 Project Capsule Authors.
+// SPDX-License-Identifier: Apache-2.0
+
+package pod
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/projectcapsule/capsule/pkg/api"
+	"github.com/projectcapsule/capsule/pkg/webhook/utils"
+)
+
+type volumeTypeForbiddenError struct {
+	volume     string
+	volumeType api.VolumeType
+	allowed    []api.VolumeType
+}
+
+func NewVolumeTypeForbiddenError(volume string, volumeType api.VolumeType, allowed []api.VolumeType) error {
+	return &volumeTypeForbiddenError{volume: volume, volumeType: volumeType, allowed: allowed}
+}
+
+func (v volumeTypeForbiddenError) Error() string {
+	allowed := make([]string, 0, len(v.allowed))
+
+	for _, t := range v.allowed {
+		allowed = append(allowed, string(t))
+	}
+
+	return fmt.Sprintf("Volume %s of type %s is forbidden for the current Tenant: use one from the following list (%s)", v.volume, v.volumeType, strings.Join(allowed, ", "))
+}
+
+type hostPathForbiddenError struct {
+	volume  string
+	path    string
+	allowed []api.AllowedHostPath
+}
+
+func NewHostPathForbiddenError(volume, path string, allowed []api.AllowedHostPath) error {
+	return &hostPathForbiddenError{volume: volume, path: path, allowed: allowed}
+}
+
+func (h hostPathForbiddenError) Error() string {
+	allowed := make([]string, 0, len(h.allowed))
+
+	for _, entry := range h.allowed {
+		allowed = append(allowed, entry.PathPrefix)
+	}
+
+	return fmt.Sprintf("Volume %s mounting the host path %s is forbidden for the current Tenant: use one from the following prefixes (%s)", h.volume, h.path, strings.Join(allowed, ", "))
+}
+
+type writableHostPathForbiddenError struct {
+	volume    string
+	path      string
+	container string
+}
+
+func NewWritableHostPathForbiddenError(volume, path, container string) error {
+	return &writableHostPathForbiddenError{volume: volume, path: path, container: container}
+}
+
+func (w writableHostPathForbiddenError) Error() string {
+	return fmt.Sprintf("Volume %s mounting the host path %s must be mounted as read-only by the container %s", w.volume, w.path, w.container)
+}
+
+type csiDriverForbiddenError struct {
+	volume  string
+	driver  string
+	allowed api.AllowedListSpec
+}
+
+func NewCSIDriverForbiddenError(volume, driver string, allowed api.AllowedListSpec) error {
+	return &csiDriverForbiddenError{volume: volume, driver: driver, allowed: allowed}
+}
+
+func (c csiDriverForbiddenError) Error() string {
+	err := fmt.Sprintf("Volume %s using the CSI driver %s is forbidden for the current Tenant: ", c.volume, c.driver)
+
+	return utils.AllowedValuesErrorMessage(api.SelectorAllowedListSpec{AllowedListSpec: c.allowed}, err)
+}
+
+type nfsServerForbiddenError struct {
+	volume  string
+	server  string
+	allowed api.AllowedListSpec
+}
+
+func NewNFSServerForbiddenError(volume, server string, allowed api.AllowedListSpec) error {
+	return &nfsServerForbiddenError{volume: volume, server: server, allowed: allowed}
+}
+
+func (n nfsServerForbiddenError) Error() string {
+	err := fmt.Sprintf("Volume %s mounting from the NFS server %s is forbidden for the current Tenant: ", n.volume, n.server)
+
+	return utils.AllowedValuesErrorMessage(api.SelectorAllowedListSpec{AllowedListSpec: n.allowed}, err)
+}
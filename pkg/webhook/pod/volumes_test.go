@@ -0,0 +1,51 @@
+// Copyright 2020-2023 Project Capsule Authors.
+// SPDX-License-Identifier: Apache-2.0
+
+package pod
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/projectcapsule/capsule/pkg/api"
+)
+
+func TestMatchHostPath(t *testing.T) {
+	allowed := []api.AllowedHostPath{
+		{PathPrefix: "/var/log", ReadOnly: true},
+		{PathPrefix: "/var/log/app/"},
+		{PathPrefix: "/"},
+	}
+
+	for hostPath, expected := range map[string][2]bool{
+		"/var/log":            {true, false},
+		"/var/log/pods":       {true, false},
+		"/var/log/app/cache":  {true, false},
+		"/var/logs":           {true, false},
+		"/var/log/../../etc/": {true, false},
+	} {
+		ok, readOnly := matchHostPath(hostPath, allowed)
+		assert.Equal(t, expected, [2]bool{ok, readOnly}, hostPath)
+	}
+
+	allowed = allowed[:2]
+
+	for hostPath, expected := range map[string][2]bool{
+		"/var/logs":          {false, false},
+		"/var/log/../../etc": {false, false},
+		"/var/log/app":       {true, false},
+		"/var/log/./pods":    {true, true},
+	} {
+		ok, readOnly := matchHostPath(hostPath, allowed)
+		assert.Equal(t, expected, [2]bool{ok, readOnly}, hostPath)
+	}
+}
+
+func TestGetVolumeType(t *testing.T) {
+	assert.Equal(t, api.VolumeType("hostPath"), getVolumeType(corev1.VolumeSource{HostPath: &corev1.HostPathVolumeSource{}}))
+	assert.Equal(t, api.VolumeType("persistentVolumeClaim"), getVolumeType(corev1.VolumeSource{PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{}}))
+	assert.Equal(t, api.VolumeType("csi"), getVolumeType(corev1.VolumeSource{CSI: &corev1.CSIVolumeSource{}}))
+	assert.Equal(t, api.VolumeType(""), getVolumeType(corev1.VolumeSource{}))
+}
@@ -0,0 +1,47 @@
+// Copyright 2020-2023 Project Capsule Authors.
+// SPDX-License-Identifier: Apache-2.0
+
+package webhook
+
+import (
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	capsulev1beta2 "github.com/projectcapsule/capsule/api/v1beta2"
+	"github.com/projectcapsule/capsule/pkg/api"
+)
+
+// admissionResult returns the TenantPolicyReport result for the given request violating a policy:
+// the policy is named after the requested resource, and the rule after the reason of the event emitted by the handler.
+func admissionResult(req admission.Request, reason, message string, mode api.EnforcementMode, now time.Time) capsulev1beta2.PolicyReportResult {
+	result := capsulev1beta2.PolicyResultFail
+	if mode != api.EnforcementModeEnforce {
+		result = capsulev1beta2.PolicyResultWarn
+	}
+
+	return capsulev1beta2.PolicyReportResult{
+		Source:    capsulev1beta2.PolicyReportSource,
+		Policy:    req.Resource.Resource,
+		Rule:      reason,
+		Timestamp: metav1.Timestamp{Seconds: now.Unix(), Nanos: int32(now.Nanosecond())},
+		Result:    result,
+		Resources: []corev1.ObjectReference{
+			{
+				APIVersion: schema.GroupVersion{Group: req.Kind.Group, Version: req.Kind.Version}.String(),
+				Kind:       req.Kind.Kind,
+				Namespace:  req.Namespace,
+				Name:       req.Name,
+			},
+		},
+		Message: message,
+		Properties: map[string]string{
+			capsulev1beta2.PolicyReportPropertyUser:      req.UserInfo.Username,
+			capsulev1beta2.PolicyReportPropertyOperation: string(req.Operation),
+			capsulev1beta2.PolicyReportPropertyMode:      mode.String(),
+		},
+	}
+}
@@ -0,0 +1,114 @@
+// Copyright 2020-2023 Project Capsule Authors.
+// SPDX-License-Identifier: Apache-2.0
+
+//nolint:dupl
+package pvc
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/projectcapsule/capsule/pkg/api"
+	"github.com/projectcapsule/capsule/pkg/quota"
+	capsuleutils "github.com/projectcapsule/capsule/pkg/utils"
+	capsulewebhook "github.com/projectcapsule/capsule/pkg/webhook"
+	"github.com/projectcapsule/capsule/pkg/webhook/utils"
+)
+
+type resourceQuota struct {
+	ledger *quota.Ledger
+}
+
+// ResourceQuota enforces the Tenant-scoped ResourceQuota items at admission time,
+// checking the PersistentVolumeClaim requests against the resources used by the whole Tenant.
+func ResourceQuota(ledger *quota.Ledger) capsulewebhook.Handler {
+	return &resourceQuota{
+		ledger: ledger,
+	}
+}
+
+func (h *resourceQuota) OnCreate(c client.Client, decoder *admission.Decoder, recorder record.EventRecorder) capsulewebhook.Func {
+	return func(ctx context.Context, req admission.Request) *admission.Response {
+		pvc := &corev1.PersistentVolumeClaim{}
+		if err := decoder.Decode(req, pvc); err != nil {
+			return utils.ErroredResponse(err)
+		}
+
+		return h.reserve(ctx, c, req, recorder, pvc, quota.PersistentVolumeClaimUsage(pvc))
+	}
+}
+
+func (h *resourceQuota) OnDelete(client.Client, *admission.Decoder, record.EventRecorder) capsulewebhook.Func {
+	return func(context.Context, admission.Request) *admission.Response {
+		return nil
+	}
+}
+
+// OnUpdate reserves the resources additionally requested by the PersistentVolumeClaim, such as upon its expansion.
+func (h *resourceQuota) OnUpdate(c client.Client, decoder *admission.Decoder, recorder record.EventRecorder) capsulewebhook.Func {
+	return func(ctx context.Context, req admission.Request) *admission.Response {
+		oldPVC, pvc := &corev1.PersistentVolumeClaim{}, &corev1.PersistentVolumeClaim{}
+
+		if err := decoder.DecodeRaw(req.OldObject, oldPVC); err != nil {
+			return utils.ErroredResponse(err)
+		}
+
+		if err := decoder.Decode(req, pvc); err != nil {
+			return utils.ErroredResponse(err)
+		}
+
+		usage := quota.PersistentVolumeClaimUsageIncrease(oldPVC, pvc)
+		if len(usage) == 0 {
+			return nil
+		}
+
+		return h.reserve(ctx, c, req, recorder, pvc, usage)
+	}
+}
+
+// reserve checks the given usage of the PersistentVolumeClaim against the Tenant-scoped ResourceQuota items it matches.
+func (h *resourceQuota) reserve(ctx context.Context, c client.Client, req admission.Request, recorder record.EventRecorder, pvc *corev1.PersistentVolumeClaim, usage corev1.ResourceList) *admission.Response {
+	tnt, err := utils.TenantByStatusNamespace(ctx, c, req.Namespace)
+	if err != nil {
+		return utils.ErroredResponse(err)
+	}
+
+	if len(tnt.GetName()) == 0 {
+		return nil
+	}
+	// The Tenant budget could be defined by the class, or carved out by the children Tenants
+	if err = capsuleutils.ResolveTenantHierarchy(ctx, c, tnt); err != nil {
+		return utils.ErroredResponse(err)
+	}
+
+	if tnt.Spec.ResourceQuota.Scope != api.ResourceQuotaScopeTenant {
+		return nil
+	}
+
+	requests := make(map[int]corev1.ResourceList)
+
+	for index, item := range tnt.Spec.ResourceQuota.Items {
+		if quota.PersistentVolumeClaimMatchesScopes(item, pvc) {
+			requests[index] = usage
+		}
+	}
+
+	if err = h.ledger.Reserve(ctx, c, tnt, req.Namespace, requests, pointer.BoolDeref(req.DryRun, false)); err != nil {
+		if !quota.IsTenantQuotaExceeded(err) {
+			return utils.ErroredResponse(err)
+		}
+
+		recorder.Eventf(tnt, corev1.EventTypeWarning, "TenantQuotaExceeded", "PersistentVolumeClaim %s/%s exceeds the Tenant quota: %s", req.Namespace, req.Name, err.Error())
+
+		response := admission.Denied(err.Error())
+
+		return &response
+	}
+
+	return nil
+}
@@ -13,6 +13,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
+	capsuleutils "github.com/projectcapsule/capsule/pkg/utils"
 	capsulewebhook "github.com/projectcapsule/capsule/pkg/webhook"
 	"github.com/projectcapsule/capsule/pkg/webhook/utils"
 )
@@ -38,6 +39,10 @@ func (h *validating) OnCreate(c client.Client, decoder *admission.Decoder, recor
 		if tnt == nil {
 			return nil
 		}
+		// StorageClasses could be inherited by the parent Tenant
+		if err = capsuleutils.ResolveTenantInheritance(ctx, c, tnt); err != nil {
+			return utils.ErroredResponse(err)
+		}
 
 		allowed := tnt.Spec.StorageClasses
 
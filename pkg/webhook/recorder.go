@@ -0,0 +1,39 @@
+// Copyright 2020-2023 Project Capsule Authors.
+// SPDX-License-Identifier: Apache-2.0
+
+package webhook
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+)
+
+// reasonRecorder keeps track of the reason of the last warning event emitted by a handler while serving a request:
+// handlers are emitting it before denying a request, thus it's used to label the denied requests metrics.
+type reasonRecorder struct {
+	record.EventRecorder
+
+	reason string
+}
+
+func (r *reasonRecorder) Event(object runtime.Object, eventtype, reason, message string) {
+	r.track(eventtype, reason)
+	r.EventRecorder.Event(object, eventtype, reason, message)
+}
+
+func (r *reasonRecorder) Eventf(object runtime.Object, eventtype, reason, messageFmt string, args ...interface{}) {
+	r.track(eventtype, reason)
+	r.EventRecorder.Eventf(object, eventtype, reason, messageFmt, args...)
+}
+
+func (r *reasonRecorder) AnnotatedEventf(object runtime.Object, annotations map[string]string, eventtype, reason, messageFmt string, args ...interface{}) {
+	r.track(eventtype, reason)
+	r.EventRecorder.AnnotatedEventf(object, annotations, eventtype, reason, messageFmt, args...)
+}
+
+func (r *reasonRecorder) track(eventtype, reason string) {
+	if eventtype == corev1.EventTypeWarning {
+		r.reason = reason
+	}
+}
@@ -0,0 +1,26 @@
+// Copyright 2020-2023 Project Capsule Authors.
+// SPDX-License-Identifier: Apache-2.0
+
+package route
+
+import (
+	capsulewebhook "github.com/projectcapsule/capsule/pkg/webhook"
+)
+
+// +kubebuilder:webhook:path=/routes,mutating=false,sideEffects=None,admissionReviewVersions=v1,failurePolicy=fail,groups=gateway.networking.k8s.io,resources=httproutes;grpcroutes;tlsroutes,verbs=create;update,versions=v1alpha2;v1beta1,name=routes.capsule.clastix.io
+
+type routes struct {
+	handlers []capsulewebhook.Handler
+}
+
+func Routes(handler ...capsulewebhook.Handler) capsulewebhook.Webhook {
+	return &routes{handlers: handler}
+}
+
+func (w *routes) GetHandlers() []capsulewebhook.Handler {
+	return w.handlers
+}
+
+func (w *routes) GetPath() string {
+	return "/routes"
+}
@@ -5,16 +5,28 @@ package webhook
 
 import (
 	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
 
 	admissionv1 "k8s.io/api/admission/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/client-go/tools/record"
 	controllerruntime "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	capsulev1beta2 "github.com/projectcapsule/capsule/api/v1beta2"
+	"github.com/projectcapsule/capsule/pkg/api"
+	"github.com/projectcapsule/capsule/pkg/metrics"
+	"github.com/projectcapsule/capsule/pkg/policyreport"
 )
 
-func Register(manager controllerruntime.Manager, webhookList ...Webhook) error {
+func Register(manager controllerruntime.Manager, reporter *policyreport.Recorder, webhookList ...Webhook) error {
 	recorder := manager.GetEventRecorderFor("tenant-webhook")
 
 	server := manager.GetWebhookServer()
@@ -22,9 +34,11 @@ func Register(manager controllerruntime.Manager, webhookList ...Webhook) error {
 	for _, wh := range webhookList {
 		server.Register(wh.GetPath(), &webhook.Admission{
 			Handler: &handlerRouter{
+				path:     wh.GetPath(),
 				client:   manager.GetClient(),
 				decoder:  admission.NewDecoder(manager.GetScheme()),
 				recorder: recorder,
+				reporter: reporter,
 				handlers: wh.GetHandlers(),
 			},
 		})
@@ -34,36 +48,136 @@ func Register(manager controllerruntime.Manager, webhookList ...Webhook) error {
 }
 
 type handlerRouter struct {
+	path     string
 	client   client.Client
 	decoder  *admission.Decoder
 	recorder record.EventRecorder
+	reporter *policyreport.Recorder
 
 	handlers []Handler
 }
 
 func (r *handlerRouter) Handle(ctx context.Context, req admission.Request) admission.Response {
-	switch req.Operation {
-	case admissionv1.Create:
-		for _, h := range r.handlers {
-			if response := h.OnCreate(r.client, r.decoder, r.recorder)(ctx, req); response != nil {
-				return *response
-			}
+	start, recorder := time.Now(), &reasonRecorder{EventRecorder: r.recorder}
+
+	response, handler := r.handle(ctx, req, recorder)
+
+	outcome, reason := metrics.OutcomeAllowed, ""
+	if !response.Allowed {
+		outcome, reason = metrics.OutcomeDenied, deniedReason(response, recorder)
+	}
+
+	metrics.ObserveWebhookRequest(r.path, handler, string(req.Operation), outcome, reason, time.Since(start))
+
+	return response
+}
+
+// handle returns the response of the first handler responding to the request, along with its name.
+// Policy violations are not denying the request if the Tenant enforcement mode is Warn or Audit, and the policy can be softened:
+// the following handlers are evaluated, and the warnings returned along with the final response.
+func (r *handlerRouter) handle(ctx context.Context, req admission.Request, recorder *reasonRecorder) (admission.Response, string) {
+	var warnings []string
+
+	for _, h := range r.handlers {
+		var fn Func
+
+		switch req.Operation {
+		case admissionv1.Create:
+			fn = h.OnCreate(r.client, r.decoder, recorder)
+		case admissionv1.Update:
+			fn = h.OnUpdate(r.client, r.decoder, recorder)
+		case admissionv1.Delete:
+			fn = h.OnDelete(r.client, r.decoder, recorder)
+		default:
+			return admission.Allowed(""), ""
 		}
-	case admissionv1.Update:
-		for _, h := range r.handlers {
-			if response := h.OnUpdate(r.client, r.decoder, r.recorder)(ctx, req); response != nil {
-				return *response
-			}
+
+		recorder.reason = ""
+
+		response := fn(ctx, req)
+		if response == nil {
+			continue
 		}
-	case admissionv1.Delete:
-		for _, h := range r.handlers {
-			if response := h.OnDelete(r.client, r.decoder, r.recorder)(ctx, req); response != nil {
-				return *response
+
+		name := handlerName(h)
+
+		if IsPolicyViolation(*response) {
+			reason := deniedReason(*response, recorder)
+
+			tnt := r.namespaceTenant(ctx, req.Namespace)
+
+			mode := EnforcementModeFor(tnt, reason)
+
+			metrics.ObservePolicyViolation(r.path, name, reason, mode.String())
+
+			if tnt != nil && r.reporter != nil && (req.DryRun == nil || !*req.DryRun) {
+				r.reporter.Record(tnt.GetName(), admissionResult(req, reason, response.Result.Message, mode, time.Now()))
+			}
+
+			switch mode {
+			case api.EnforcementModeWarn:
+				warnings = append(warnings, fmt.Sprintf("[%s] %s", reason, response.Result.Message))
+
+				continue
+			case api.EnforcementModeAudit:
+				r.recorder.Eventf(tnt, corev1.EventTypeWarning, "PolicyViolationAudited", "%s %s %s/%s violates the policy %s: %s", req.Operation, req.Kind.Kind, req.Namespace, req.Name, reason, response.Result.Message)
+
+				continue
 			}
 		}
-	case admissionv1.Connect:
-		return admission.Allowed("")
+
+		response.Warnings = append(warnings, response.Warnings...)
+
+		return *response, name
 	}
 
-	return admission.Allowed("")
+	return admission.Allowed("").WithWarnings(warnings...), ""
+}
+
+// IsPolicyViolation returns true if the request has been denied due to a policy,
+// rather than an error occurred while evaluating it: errors are never allowed, whatever the enforcement mode.
+func IsPolicyViolation(response admission.Response) bool {
+	return !response.Allowed && response.Result != nil && response.Result.Code == http.StatusForbidden
+}
+
+// namespaceTenant returns the Tenant owning the given Namespace, if any:
+// in case of errors the Tenant is not considered, thus the policies are enforced.
+func (r *handlerRouter) namespaceTenant(ctx context.Context, namespace string) *capsulev1beta2.Tenant {
+	if len(namespace) == 0 {
+		return nil
+	}
+
+	tntList := &capsulev1beta2.TenantList{}
+	if err := r.client.List(ctx, tntList, client.MatchingFieldsSelector{Selector: fields.OneTermEqualSelector(".status.namespaces", namespace)}); err != nil {
+		return nil
+	}
+
+	if len(tntList.Items) == 0 {
+		return nil
+	}
+
+	return &tntList.Items[0]
+}
+
+func handlerName(h Handler) string {
+	return strings.TrimPrefix(fmt.Sprintf("%T", h), "*")
+}
+
+// deniedReason returns the reason of the warning event emitted by the handler denying the request,
+// falling back to the reason of the response status.
+func deniedReason(response admission.Response, recorder *reasonRecorder) string {
+	if len(recorder.reason) > 0 {
+		return recorder.reason
+	}
+
+	switch {
+	case response.Result == nil:
+		return string(metav1.StatusReasonUnknown)
+	case len(response.Result.Reason) > 0:
+		return string(response.Result.Reason)
+	case response.Result.Code >= http.StatusInternalServerError:
+		return string(metav1.StatusReasonInternalError)
+	default:
+		return string(metav1.StatusReasonUnknown)
+	}
 }
@@ -0,0 +1,123 @@
+// Copyright 2020-2023 Project Capsule Authors.
+// SPDX-License-Identifier: Apache-2.0
+
+package webhook
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	admissionv1 "k8s.io/api/admission/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	capsulev1beta2 "github.com/projectcapsule/capsule/api/v1beta2"
+	"github.com/projectcapsule/capsule/pkg/api"
+	tenantindex "github.com/projectcapsule/capsule/pkg/indexer/tenant"
+)
+
+type denyingHandler struct {
+	reason string
+}
+
+func (h *denyingHandler) OnCreate(_ client.Client, _ *admission.Decoder, recorder record.EventRecorder) Func {
+	return func(ctx context.Context, req admission.Request) *admission.Response {
+		recorder.Eventf(&corev1.Pod{}, corev1.EventTypeWarning, h.reason, "Pod %s is forbidden", req.Name)
+
+		response := admission.Denied("forbidden")
+
+		return &response
+	}
+}
+
+func (h *denyingHandler) OnDelete(client.Client, *admission.Decoder, record.EventRecorder) Func {
+	return func(context.Context, admission.Request) *admission.Response {
+		return nil
+	}
+}
+
+func (h *denyingHandler) OnUpdate(client.Client, *admission.Decoder, record.EventRecorder) Func {
+	return func(context.Context, admission.Request) *admission.Response {
+		return nil
+	}
+}
+
+func TestHandlerRouter_Handle(t *testing.T) {
+	scheme := runtime.NewScheme()
+	assert.NoError(t, capsulev1beta2.AddToScheme(scheme))
+
+	tenant := func(name, namespace string, mode api.EnforcementMode) *capsulev1beta2.Tenant {
+		return &capsulev1beta2.Tenant{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec:       capsulev1beta2.TenantSpec{Enforcement: &api.EnforcementSpec{Mode: mode}},
+			Status:     capsulev1beta2.TenantStatus{Namespaces: []string{namespace}},
+		}
+	}
+
+	recorder := record.NewFakeRecorder(10)
+
+	router := &handlerRouter{
+		path: "/test",
+		client: fake.NewClientBuilder().
+			WithScheme(scheme).
+			WithObjects(tenant("warn", "warn-ns", api.EnforcementModeWarn), tenant("audit", "audit-ns", api.EnforcementModeAudit)).
+			WithIndex(&capsulev1beta2.Tenant{}, ".status.namespaces", tenantindex.NamespacesReference{}.Func()).
+			Build(),
+		recorder: recorder,
+		handlers: []Handler{&denyingHandler{reason: "ForbiddenContainerRegistry"}},
+	}
+
+	request := func(operation admissionv1.Operation, namespace string) admission.Request {
+		return admission.Request{AdmissionRequest: admissionv1.AdmissionRequest{Name: "pod", Namespace: namespace, Operation: operation}}
+	}
+
+	assert.False(t, router.Handle(context.Background(), request(admissionv1.Create, "")).Allowed)
+	assert.True(t, router.Handle(context.Background(), request(admissionv1.Delete, "")).Allowed)
+
+	warned := router.Handle(context.Background(), request(admissionv1.Create, "warn-ns"))
+	assert.True(t, warned.Allowed)
+	assert.Equal(t, []string{"[ForbiddenContainerRegistry] forbidden"}, warned.Warnings)
+
+	audited := router.Handle(context.Background(), request(admissionv1.Create, "audit-ns"))
+	assert.True(t, audited.Allowed)
+	assert.Empty(t, audited.Warnings)
+
+	router.handlers = []Handler{&denyingHandler{reason: "TenantFreezed"}}
+
+	cordoned := router.Handle(context.Background(), request(admissionv1.Create, "warn-ns"))
+	assert.False(t, cordoned.Allowed)
+	assert.Empty(t, cordoned.Warnings)
+
+	var events []string
+	for len(recorder.Events) > 0 {
+		events = append(events, <-recorder.Events)
+	}
+
+	assert.Contains(t, events, "Warning PolicyViolationAudited CREATE  audit-ns/pod violates the policy ForbiddenContainerRegistry: forbidden")
+
+	expected := `
+# HELP capsule_webhook_requests_total Admission requests handled by the Capsule webhooks, along with the handler responding and the denial reason.
+# TYPE capsule_webhook_requests_total counter
+capsule_webhook_requests_total{handler="",operation="CREATE",outcome="allowed",path="/test",reason=""} 2
+capsule_webhook_requests_total{handler="",operation="DELETE",outcome="allowed",path="/test",reason=""} 1
+capsule_webhook_requests_total{handler="webhook.denyingHandler",operation="CREATE",outcome="denied",path="/test",reason="ForbiddenContainerRegistry"} 1
+capsule_webhook_requests_total{handler="webhook.denyingHandler",operation="CREATE",outcome="denied",path="/test",reason="TenantFreezed"} 1
+# HELP capsule_webhook_policy_violations_total Policy violations detected by the Capsule webhooks, along with the Tenant enforcement mode applied.
+# TYPE capsule_webhook_policy_violations_total counter
+capsule_webhook_policy_violations_total{handler="webhook.denyingHandler",mode="Audit",path="/test",reason="ForbiddenContainerRegistry"} 1
+capsule_webhook_policy_violations_total{handler="webhook.denyingHandler",mode="Enforce",path="/test",reason="ForbiddenContainerRegistry"} 1
+capsule_webhook_policy_violations_total{handler="webhook.denyingHandler",mode="Warn",path="/test",reason="ForbiddenContainerRegistry"} 1
+capsule_webhook_policy_violations_total{handler="webhook.denyingHandler",mode="Enforce",path="/test",reason="TenantFreezed"} 1
+`
+
+	assert.NoError(t, testutil.GatherAndCompare(metrics.Registry, strings.NewReader(expected), "capsule_webhook_requests_total", "capsule_webhook_policy_violations_total"))
+}
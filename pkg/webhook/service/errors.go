@@ -7,7 +7,10 @@ import (
 	"fmt"
 	"strings"
 
+	corev1 "k8s.io/api/core/v1"
+
 	"github.com/projectcapsule/capsule/pkg/api"
+	"github.com/projectcapsule/capsule/pkg/webhook/utils"
 )
 
 type externalServiceIPForbiddenError struct {
@@ -63,3 +66,108 @@ func NewLoadBalancerDisabled() error {
 func (loadBalancerDisabledError) Error() string {
 	return "LoadBalancer service types are forbidden for the tenant: please, reach out to the system administrators"
 }
+
+type loadBalancerClassUndefinedError struct {
+	spec api.DefaultAllowedListSpec
+}
+
+func NewLoadBalancerClassUndefined(spec api.DefaultAllowedListSpec) error {
+	return &loadBalancerClassUndefinedError{spec: spec}
+}
+
+func (l loadBalancerClassUndefinedError) Error() string {
+	return utils.DefaultAllowedValuesErrorMessage(l.spec, "No LoadBalancer class is forbidden for the current Tenant. Specify a LoadBalancer class which is allowed within the Tenant: ")
+}
+
+type loadBalancerClassForbiddenError struct {
+	class string
+	spec  api.DefaultAllowedListSpec
+}
+
+func NewLoadBalancerClassForbidden(class string, spec api.DefaultAllowedListSpec) error {
+	return &loadBalancerClassForbiddenError{class: class, spec: spec}
+}
+
+func (l loadBalancerClassForbiddenError) Error() string {
+	err := fmt.Sprintf("LoadBalancer class %s is forbidden for the current Tenant: ", l.class)
+
+	return utils.DefaultAllowedValuesErrorMessage(l.spec, err)
+}
+
+type loadBalancerIPForbiddenError struct {
+	ip   string
+	spec api.AllowedIPsSpec
+}
+
+func NewLoadBalancerIPForbidden(ip string, spec api.AllowedIPsSpec) error {
+	return &loadBalancerIPForbiddenError{ip: ip, spec: spec}
+}
+
+func (l loadBalancerIPForbiddenError) Error() string {
+	if len(l.spec.Allowed) == 0 {
+		return "The current Tenant does not allow the use of Service with a LoadBalancer IP"
+	}
+
+	return fmt.Sprintf("The LoadBalancer IP %s is violating the following enforced CIDRs: %s", l.ip, l.spec.String())
+}
+
+type loadBalancerSourceRangeForbiddenError struct {
+	sourceRange string
+	spec        api.AllowedIPsSpec
+}
+
+func NewLoadBalancerSourceRangeForbidden(sourceRange string, spec api.AllowedIPsSpec) error {
+	return &loadBalancerSourceRangeForbiddenError{sourceRange: sourceRange, spec: spec}
+}
+
+func (l loadBalancerSourceRangeForbiddenError) Error() string {
+	if len(l.spec.Allowed) == 0 {
+		return "The current Tenant does not allow the use of Service with LoadBalancer source ranges"
+	}
+
+	return fmt.Sprintf("The LoadBalancer source range %s is violating the following enforced CIDRs: %s", l.sourceRange, l.spec.String())
+}
+
+type servicesExceededError struct {
+	serviceType corev1.ServiceType
+	limit       int32
+}
+
+func NewServicesExceeded(serviceType corev1.ServiceType, limit int32) error {
+	return &servicesExceededError{serviceType: serviceType, limit: limit}
+}
+
+func (s servicesExceededError) Error() string {
+	if s.serviceType == corev1.ServiceTypeNodePort {
+		return fmt.Sprintf("The current Tenant has reached the maximum number of %d Services allocating node ports, either of type NodePort or LoadBalancer: please, reach out to the system administrators", s.limit)
+	}
+
+	return fmt.Sprintf("The current Tenant has reached the maximum number of %d Services of type %s: please, reach out to the system administrators", s.limit, s.serviceType)
+}
+
+type nodePortForbiddenError struct {
+	port   int32
+	ranges []api.NodePortRange
+}
+
+func NewNodePortForbidden(port int32, ranges []api.NodePortRange) error {
+	return &nodePortForbiddenError{port: port, ranges: ranges}
+}
+
+func (n nodePortForbiddenError) Error() string {
+	if n.port == 0 {
+		return fmt.Sprintf("The node ports must be specified for the current Tenant, in the following ranges: %s", nodePortRangesString(n.ranges))
+	}
+
+	return fmt.Sprintf("The node port %d is violating the following enforced ranges: %s", n.port, nodePortRangesString(n.ranges))
+}
+
+func nodePortRangesString(ranges []api.NodePortRange) string {
+	values := make([]string, 0, len(ranges))
+
+	for _, r := range ranges {
+		values = append(values, fmt.Sprintf("%d-%d", r.From, r.To))
+	}
+
+	return strings.Join(values, ", ")
+}
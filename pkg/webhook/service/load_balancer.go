@@ -0,0 +1,139 @@
+// Copyright 2020-2023 Project Capsule Authors.
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"net"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	capsulev1beta2 "github.com/projectcapsule/capsule/api/v1beta2"
+	"github.com/projectcapsule/capsule/pkg/webhook/utils"
+)
+
+// validateLoadBalancer enforces the LoadBalancer options: the class and the maximum number of Services
+// are evaluated just when the Service becomes of type LoadBalancer, since the class cannot be changed later.
+//
+//nolint:gocognit,cyclop
+func validateLoadBalancer(ctx context.Context, clt client.Client, req admission.Request, recorder record.EventRecorder, tnt *capsulev1beta2.Tenant, svc, oldSvc *corev1.Service) *admission.Response {
+	opts := tnt.Spec.ServiceOptions.LoadBalancer
+
+	if opts == nil || svc.Spec.Type != corev1.ServiceTypeLoadBalancer {
+		return nil
+	}
+
+	becomingLoadBalancer := oldSvc == nil || oldSvc.Spec.Type != corev1.ServiceTypeLoadBalancer
+
+	if allowed := opts.AllowedClasses; allowed != nil && becomingLoadBalancer {
+		class := svc.Spec.LoadBalancerClass
+
+		switch {
+		case class == nil:
+			recorder.Eventf(tnt, corev1.EventTypeWarning, "MissingLoadBalancerClass", "Service %s/%s is missing LoadBalancer class", req.Namespace, req.Name)
+
+			response := admission.Denied(NewLoadBalancerClassUndefined(*allowed).Error())
+
+			return &response
+		case allowed.MatchDefault(*class) || allowed.Match(*class):
+			break
+		default:
+			recorder.Eventf(tnt, corev1.EventTypeWarning, "ForbiddenLoadBalancerClass", "Service %s/%s LoadBalancer class %s is forbidden for the current Tenant", req.Namespace, req.Name, *class)
+
+			response := admission.Denied(NewLoadBalancerClassForbidden(*class, *allowed).Error())
+
+			return &response
+		}
+	}
+
+	if allowed := opts.AllowedIPs; allowed != nil && len(svc.Spec.LoadBalancerIP) > 0 {
+		if !allowed.ContainsIP(net.ParseIP(svc.Spec.LoadBalancerIP)) {
+			recorder.Eventf(tnt, corev1.EventTypeWarning, "ForbiddenLoadBalancerIP", "Service %s/%s LoadBalancer IP %s is forbidden for the current Tenant", req.Namespace, req.Name, svc.Spec.LoadBalancerIP)
+
+			response := admission.Denied(NewLoadBalancerIPForbidden(svc.Spec.LoadBalancerIP, *allowed).Error())
+
+			return &response
+		}
+	}
+
+	if allowed := opts.AllowedSourceRanges; allowed != nil {
+		for _, sourceRange := range loadBalancerSourceRanges(svc) {
+			if allowed.ContainsCIDR(sourceRange) {
+				continue
+			}
+
+			recorder.Eventf(tnt, corev1.EventTypeWarning, "ForbiddenLoadBalancerSourceRange", "Service %s/%s LoadBalancer source range %s is forbidden for the current Tenant", req.Namespace, req.Name, sourceRange)
+
+			response := admission.Denied(NewLoadBalancerSourceRangeForbidden(sourceRange, *allowed).Error())
+
+			return &response
+		}
+	}
+
+	if opts.MaxServices != nil && becomingLoadBalancer {
+		count, err := countServices(ctx, clt, tnt, svc, func(item *corev1.Service) bool {
+			return item.Spec.Type == corev1.ServiceTypeLoadBalancer
+		})
+		if err != nil {
+			return utils.ErroredResponse(err)
+		}
+
+		if count >= *opts.MaxServices {
+			recorder.Eventf(tnt, corev1.EventTypeWarning, "LoadBalancerServicesExceeded", "Service %s/%s cannot be type of LoadBalancer, the Tenant has reached the maximum number of %d", req.Namespace, req.Name, *opts.MaxServices)
+
+			response := admission.Denied(NewServicesExceeded(corev1.ServiceTypeLoadBalancer, *opts.MaxServices).Error())
+
+			return &response
+		}
+	}
+
+	return nil
+}
+
+// loadBalancerSourceRanges returns the source ranges of the Service, along with the ones specified by the annotation,
+// which is taken into account by the cloud providers when the field is empty.
+func loadBalancerSourceRanges(svc *corev1.Service) (ranges []string) {
+	ranges = append(ranges, svc.Spec.LoadBalancerSourceRanges...)
+
+	if value, ok := svc.GetAnnotations()[corev1.AnnotationLoadBalancerSourceRangesKey]; ok {
+		for _, sourceRange := range strings.Split(value, ",") {
+			if sourceRange = strings.TrimSpace(sourceRange); len(sourceRange) > 0 {
+				ranges = append(ranges, sourceRange)
+			}
+		}
+	}
+
+	return ranges
+}
+
+// countServices returns the number of Services matching the given function in the Namespaces of the Tenant,
+// the given one excluded: the Services are listed from the cache, thus the ones created concurrently could be missed.
+func countServices(ctx context.Context, clt client.Client, tnt *capsulev1beta2.Tenant, svc *corev1.Service, match func(*corev1.Service) bool) (count int32, err error) {
+	for _, ns := range tnt.Status.Namespaces {
+		svcList := &corev1.ServiceList{}
+		if err = clt.List(ctx, svcList, client.InNamespace(ns)); err != nil {
+			return 0, err
+		}
+
+		for i := range svcList.Items {
+			item := &svcList.Items[i]
+
+			if !match(item) {
+				continue
+			}
+
+			if item.GetName() == svc.GetName() && item.GetNamespace() == svc.GetNamespace() {
+				continue
+			}
+
+			count++
+		}
+	}
+
+	return count, nil
+}
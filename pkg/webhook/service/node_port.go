@@ -0,0 +1,95 @@
+// Copyright 2020-2023 Project Capsule Authors.
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	capsulev1beta2 "github.com/projectcapsule/capsule/api/v1beta2"
+	"github.com/projectcapsule/capsule/pkg/api"
+	"github.com/projectcapsule/capsule/pkg/webhook/utils"
+)
+
+// validateNodePorts enforces the NodePort options: the node ports already assigned to the Service are not evaluated,
+// allowing the updates of the Services created before the options were put in place.
+//
+//nolint:cyclop
+func validateNodePorts(ctx context.Context, clt client.Client, req admission.Request, recorder record.EventRecorder, tnt *capsulev1beta2.Tenant, svc, oldSvc *corev1.Service) *admission.Response {
+	opts := tnt.Spec.ServiceOptions.NodePort
+
+	if opts == nil {
+		return nil
+	}
+
+	// The LoadBalancer Services are allocating node ports as well, thus these are counted too
+	if opts.MaxServices != nil && allocatesNodePorts(svc) && (oldSvc == nil || !allocatesNodePorts(oldSvc)) {
+		count, err := countServices(ctx, clt, tnt, svc, allocatesNodePorts)
+		if err != nil {
+			return utils.ErroredResponse(err)
+		}
+
+		if count >= *opts.MaxServices {
+			recorder.Eventf(tnt, corev1.EventTypeWarning, "NodePortServicesExceeded", "Service %s/%s cannot allocate node ports, the Tenant has reached the maximum number of %d", req.Namespace, req.Name, *opts.MaxServices)
+
+			response := admission.Denied(NewServicesExceeded(corev1.ServiceTypeNodePort, *opts.MaxServices).Error())
+
+			return &response
+		}
+	}
+
+	if len(opts.AllowedRanges) == 0 || !allocatesNodePorts(svc) {
+		return nil
+	}
+
+	assigned := sets.New[int32]()
+
+	if oldSvc != nil {
+		for _, port := range oldSvc.Spec.Ports {
+			assigned.Insert(port.NodePort)
+		}
+	}
+
+	for _, port := range svc.Spec.Ports {
+		if port.NodePort != 0 && (assigned.Has(port.NodePort) || isNodePortAllowed(port.NodePort, opts.AllowedRanges)) {
+			continue
+		}
+
+		recorder.Eventf(tnt, corev1.EventTypeWarning, "ForbiddenNodePortRange", "Service %s/%s node port %d is forbidden for the current Tenant", req.Namespace, req.Name, port.NodePort)
+
+		response := admission.Denied(NewNodePortForbidden(port.NodePort, opts.AllowedRanges).Error())
+
+		return &response
+	}
+
+	return nil
+}
+
+// allocatesNodePorts returns true for the Services of type NodePort, as well as for the LoadBalancer ones,
+// unless the allocation of the node ports is disabled.
+func allocatesNodePorts(svc *corev1.Service) bool {
+	switch svc.Spec.Type {
+	case corev1.ServiceTypeNodePort:
+		return true
+	case corev1.ServiceTypeLoadBalancer:
+		return svc.Spec.AllocateLoadBalancerNodePorts == nil || *svc.Spec.AllocateLoadBalancerNodePorts
+	default:
+		return false
+	}
+}
+
+func isNodePortAllowed(port int32, ranges []api.NodePortRange) bool {
+	for _, r := range ranges {
+		if r.Contains(port) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -9,6 +9,7 @@ import (
 	"strings"
 
 	"github.com/pkg/errors"
+	admissionv1 "k8s.io/api/admission/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/client-go/tools/record"
@@ -90,6 +91,25 @@ func (r *handler) handleService(ctx context.Context, clt client.Client, decoder
 		}
 	}
 
+	if tnt.Spec.ServiceOptions != nil {
+		var oldSvc *corev1.Service
+
+		if req.Operation == admissionv1.Update {
+			oldSvc = &corev1.Service{}
+			if err := decoder.DecodeRaw(req.OldObject, oldSvc); err != nil {
+				return utils.ErroredResponse(err)
+			}
+		}
+
+		if response := validateLoadBalancer(ctx, clt, req, recorder, &tnt, svc, oldSvc); response != nil {
+			return response
+		}
+
+		if response := validateNodePorts(ctx, clt, req, recorder, &tnt, svc, oldSvc); response != nil {
+			return response
+		}
+	}
+
 	if svc.Spec.ExternalIPs == nil || (tnt.Spec.ServiceOptions == nil || tnt.Spec.ServiceOptions.ExternalServiceIPs == nil) {
 		return nil
 	}
@@ -0,0 +1,63 @@
+// Copyright 2020-2023 Project Capsule Authors.
+// SPDX-License-Identifier: Apache-2.0
+
+package tenant
+
+import (
+	"context"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	capsulev1beta2 "github.com/projectcapsule/capsule/api/v1beta2"
+	capsuleutils "github.com/projectcapsule/capsule/pkg/utils"
+	capsulewebhook "github.com/projectcapsule/capsule/pkg/webhook"
+	"github.com/projectcapsule/capsule/pkg/webhook/utils"
+)
+
+type classHandler struct{}
+
+// ClassHandler ensures the TenantClass referenced by the Tenant exists.
+func ClassHandler() capsulewebhook.Handler {
+	return &classHandler{}
+}
+
+func (h *classHandler) validate(ctx context.Context, c client.Client, decoder *admission.Decoder, req admission.Request) *admission.Response {
+	tnt := &capsulev1beta2.Tenant{}
+	if err := decoder.Decode(req, tnt); err != nil {
+		return utils.ErroredResponse(err)
+	}
+
+	if _, err := capsuleutils.GetTenantClass(ctx, c, tnt); err != nil {
+		if apierrors.IsNotFound(err) {
+			response := admission.Denied(fmt.Sprintf("the TenantClass %s does not exist", tnt.Spec.TenantClassName))
+
+			return &response
+		}
+
+		return utils.ErroredResponse(err)
+	}
+
+	return nil
+}
+
+func (h *classHandler) OnCreate(c client.Client, decoder *admission.Decoder, _ record.EventRecorder) capsulewebhook.Func {
+	return func(ctx context.Context, req admission.Request) *admission.Response {
+		return h.validate(ctx, c, decoder, req)
+	}
+}
+
+func (h *classHandler) OnDelete(client.Client, *admission.Decoder, record.EventRecorder) capsulewebhook.Func {
+	return func(context.Context, admission.Request) *admission.Response {
+		return nil
+	}
+}
+
+func (h *classHandler) OnUpdate(c client.Client, decoder *admission.Decoder, _ record.EventRecorder) capsulewebhook.Func {
+	return func(ctx context.Context, req admission.Request) *admission.Response {
+		return h.validate(ctx, c, decoder, req)
+	}
+}
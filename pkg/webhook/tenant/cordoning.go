@@ -10,11 +10,13 @@ import (
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	capsulev1beta2 "github.com/projectcapsule/capsule/api/v1beta2"
+	"github.com/projectcapsule/capsule/pkg/api"
 	"github.com/projectcapsule/capsule/pkg/configuration"
 	capsulewebhook "github.com/projectcapsule/capsule/pkg/webhook"
 	"github.com/projectcapsule/capsule/pkg/webhook/utils"
@@ -51,6 +53,24 @@ func (h *cordoningHandler) cordonHandler(ctx context.Context, clt client.Client,
 
 		return &response
 	}
+	// the Namespaces pending deletion are cordoned as well, besides the Namespace itself,
+	// which must be updated to restore it
+	if req.Kind.Group == "" && req.Kind.Kind == "Namespace" {
+		return nil
+	}
+
+	ns := &corev1.Namespace{}
+	if err := clt.Get(ctx, types.NamespacedName{Name: req.Namespace}, ns); err != nil {
+		return utils.ErroredResponse(err)
+	}
+
+	if _, ok := ns.GetLabels()[api.NamespacePendingDeletionLabel]; ok && utils.IsCapsuleUser(ctx, req, clt, h.configuration.UserGroups()) {
+		recorder.Eventf(&tnt, corev1.EventTypeWarning, "NamespacePendingDeletion", "%s %s/%s cannot be %sd, the Namespace is pending deletion", req.Kind.String(), req.Namespace, req.Name, strings.ToLower(string(req.Operation)))
+
+		response := admission.Denied(fmt.Sprintf("namespace %s is pending deletion: please, restore it before changing its resources", req.Namespace))
+
+		return &response
+	}
 
 	return nil
 }
@@ -0,0 +1,436 @@
+// Copyright 2020-2023 Project Capsule Authors.
+// SPDX-License-Identifier: Apache-2.0
+
+package tenant
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strconv"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	capsulev1beta2 "github.com/projectcapsule/capsule/api/v1beta2"
+	"github.com/projectcapsule/capsule/pkg/api"
+	"github.com/projectcapsule/capsule/pkg/configuration"
+	capsuleutils "github.com/projectcapsule/capsule/pkg/utils"
+	capsulewebhook "github.com/projectcapsule/capsule/pkg/webhook"
+	"github.com/projectcapsule/capsule/pkg/webhook/utils"
+)
+
+type hierarchyHandler struct {
+	configuration configuration.Configuration
+}
+
+// HierarchyHandler validates the Tenant hierarchy: children can only narrow the policies of their parent,
+// and their budget is carved out of the parent one.
+// Capsule users are allowed to manage only the Tenants having a parent, or an ancestor, they own.
+func HierarchyHandler(configuration configuration.Configuration) capsulewebhook.Handler {
+	return &hierarchyHandler{
+		configuration: configuration,
+	}
+}
+
+func (h *hierarchyHandler) OnCreate(c client.Client, decoder *admission.Decoder, recorder record.EventRecorder) capsulewebhook.Func {
+	return func(ctx context.Context, req admission.Request) *admission.Response {
+		tnt := &capsulev1beta2.Tenant{}
+		if err := decoder.Decode(req, tnt); err != nil {
+			return utils.ErroredResponse(err)
+		}
+
+		if response := h.authorize(ctx, c, req, tnt); response != nil {
+			return response
+		}
+
+		return h.validateParent(ctx, c, recorder, tnt, utils.IsCapsuleUser(ctx, req, c, h.configuration.UserGroups()))
+	}
+}
+
+func (h *hierarchyHandler) OnDelete(c client.Client, decoder *admission.Decoder, _ record.EventRecorder) capsulewebhook.Func {
+	return func(ctx context.Context, req admission.Request) *admission.Response {
+		tnt := &capsulev1beta2.Tenant{}
+		if err := decoder.DecodeRaw(req.OldObject, tnt); err != nil {
+			return utils.ErroredResponse(err)
+		}
+
+		if response := h.authorize(ctx, c, req, tnt); response != nil {
+			return response
+		}
+
+		children, err := capsuleutils.GetTenantChildren(ctx, c, tnt)
+		if err != nil {
+			return utils.ErroredResponse(err)
+		}
+
+		if len(children) > 0 {
+			names := make([]string, 0, len(children))
+
+			for _, child := range children {
+				names = append(names, child.GetName())
+			}
+
+			response := admission.Denied(NewTenantHasChildrenError(tnt.GetName(), names).Error())
+
+			return &response
+		}
+
+		return nil
+	}
+}
+
+func (h *hierarchyHandler) OnUpdate(c client.Client, decoder *admission.Decoder, recorder record.EventRecorder) capsulewebhook.Func {
+	return func(ctx context.Context, req admission.Request) *admission.Response {
+		oldTnt := &capsulev1beta2.Tenant{}
+		if err := decoder.DecodeRaw(req.OldObject, oldTnt); err != nil {
+			return utils.ErroredResponse(err)
+		}
+
+		tnt := &capsulev1beta2.Tenant{}
+		if err := decoder.Decode(req, tnt); err != nil {
+			return utils.ErroredResponse(err)
+		}
+
+		if response := h.authorize(ctx, c, req, oldTnt); response != nil {
+			return response
+		}
+
+		if response := h.authorize(ctx, c, req, tnt); response != nil {
+			return response
+		}
+
+		// Skipping the validation in case of metadata changes only
+		if equality.Semantic.DeepEqual(oldTnt.Spec, tnt.Spec) {
+			return nil
+		}
+
+		if response := h.validateParent(ctx, c, recorder, tnt, utils.IsCapsuleUser(ctx, req, c, h.configuration.UserGroups())); response != nil {
+			return response
+		}
+
+		return h.validateChildren(ctx, c, recorder, tnt)
+	}
+}
+
+// authorize ensures Capsule users are managing a Tenant having a parent, or an ancestor, they own.
+func (h *hierarchyHandler) authorize(ctx context.Context, c client.Client, req admission.Request, tnt *capsulev1beta2.Tenant) *admission.Response {
+	if !utils.IsCapsuleUser(ctx, req, c, h.configuration.UserGroups()) {
+		return nil
+	}
+
+	if len(tnt.Spec.Parent) == 0 {
+		response := admission.Denied(NewTenantParentRequiredError().Error())
+
+		return &response
+	}
+
+	ancestors, err := capsuleutils.GetTenantAncestors(ctx, c, tnt)
+	if err != nil {
+		response := admission.Denied(err.Error())
+
+		return &response
+	}
+
+	for _, ancestor := range ancestors {
+		if utils.IsTenantOwner(ancestor.Spec.Owners, req.UserInfo) {
+			return nil
+		}
+	}
+
+	response := admission.Denied(NewTenantParentNotOwnedError(req.UserInfo.Username, tnt.Spec.Parent).Error())
+
+	return &response
+}
+
+// validateParent ensures the Tenant is compatible with its parent: when managed by a Capsule user,
+// the Tenant cannot grant anything the parent doesn't, besides narrowing it.
+func (h *hierarchyHandler) validateParent(ctx context.Context, c client.Client, recorder record.EventRecorder, tnt *capsulev1beta2.Tenant, delegated bool) *admission.Response {
+	if len(tnt.Spec.Parent) == 0 {
+		return nil
+	}
+	// Walking the ancestors also ensures the parent exists and there are no cycles
+	if _, err := capsuleutils.GetTenantAncestors(ctx, c, tnt); err != nil {
+		response := admission.Denied(err.Error())
+
+		return &response
+	}
+
+	parent := &capsulev1beta2.Tenant{}
+	if err := c.Get(ctx, types.NamespacedName{Name: tnt.Spec.Parent}, parent); err != nil {
+		return utils.ErroredResponse(err)
+	}
+
+	raw := parent.DeepCopy()
+
+	if err := capsuleutils.ResolveTenantInheritance(ctx, c, parent); err != nil {
+		return utils.ErroredResponse(err)
+	}
+
+	siblings, err := capsuleutils.GetTenantChildren(ctx, c, parent)
+	if err != nil {
+		return utils.ErroredResponse(err)
+	}
+
+	children := []capsulev1beta2.Tenant{*tnt}
+
+	for _, sibling := range siblings {
+		if sibling.GetName() != tnt.GetName() {
+			children = append(children, sibling)
+		}
+	}
+
+	if err = validateNarrowing(parent, tnt); err == nil && delegated {
+		err = validateDelegation(raw, parent, tnt)
+	}
+
+	if err == nil {
+		err = validateBudget(parent, children)
+	}
+
+	if err != nil {
+		recorder.Eventf(parent, corev1.EventTypeWarning, "ForbiddenTenantHierarchy", "Tenant %s is violating the hierarchy: %s", tnt.GetName(), err.Error())
+
+		response := admission.Denied(err.Error())
+
+		return &response
+	}
+
+	return nil
+}
+
+// validateChildren ensures the updated Tenant is still compatible with the children ones.
+func (h *hierarchyHandler) validateChildren(ctx context.Context, c client.Client, recorder record.EventRecorder, tnt *capsulev1beta2.Tenant) *admission.Response {
+	children, err := capsuleutils.GetTenantChildren(ctx, c, tnt)
+	if err != nil {
+		return utils.ErroredResponse(err)
+	}
+
+	if len(children) == 0 {
+		return nil
+	}
+
+	parent := tnt.DeepCopy()
+	if err = capsuleutils.ResolveTenantInheritance(ctx, c, parent); err != nil {
+		return utils.ErroredResponse(err)
+	}
+
+	for i := range children {
+		if err = validateNarrowing(parent, &children[i]); err != nil {
+			break
+		}
+	}
+
+	if err == nil {
+		err = validateBudget(parent, children)
+	}
+
+	if err != nil {
+		recorder.Eventf(tnt, corev1.EventTypeWarning, "ForbiddenTenantHierarchy", "Tenant %s is not compatible with its children: %s", tnt.GetName(), err.Error())
+
+		response := admission.Denied(err.Error())
+
+		return &response
+	}
+
+	return nil
+}
+
+// validateNarrowing ensures the child Tenant doesn't allow more than its parent, which must be already resolved.
+func validateNarrowing(parent, child *capsulev1beta2.Tenant) error {
+	if child.Spec.ContainerRegistries != nil && !child.Spec.ContainerRegistries.IsSubsetOf(parent.Spec.ContainerRegistries) {
+		return NewTenantHierarchyViolationError("containerRegistries", parent.GetName())
+	}
+
+	if child.Spec.StorageClasses != nil && !child.Spec.StorageClasses.IsSubsetOf(parent.Spec.StorageClasses) {
+		return NewTenantHierarchyViolationError("storageClasses", parent.GetName())
+	}
+
+	if child.Spec.IngressOptions.AllowedClasses != nil && !child.Spec.IngressOptions.AllowedClasses.IsSubsetOf(parent.Spec.IngressOptions.AllowedClasses) {
+		return NewTenantHierarchyViolationError("ingressOptions.allowedClasses", parent.GetName())
+	}
+
+	if child.Spec.IngressOptions.AllowedHostnames != nil && !child.Spec.IngressOptions.AllowedHostnames.IsSubsetOf(parent.Spec.IngressOptions.AllowedHostnames) {
+		return NewTenantHierarchyViolationError("ingressOptions.allowedHostnames", parent.GetName())
+	}
+
+	if child.Spec.IngressOptions.AllowWildcardHostnames && !parent.Spec.IngressOptions.AllowWildcardHostnames {
+		return NewTenantHierarchyViolationError("ingressOptions.allowWildcardHostnames", parent.GetName())
+	}
+
+	if child.Spec.IngressOptions.AllowedGateways != nil && !child.Spec.IngressOptions.AllowedGateways.IsSubsetOf(parent.Spec.IngressOptions.AllowedGateways) {
+		return NewTenantHierarchyViolationError("ingressOptions.allowedGateways", parent.GetName())
+	}
+
+	for k, v := range parent.Spec.NodeSelector {
+		if value, ok := child.Spec.NodeSelector[k]; ok && value != v {
+			return NewTenantHierarchyViolationError("nodeSelector", parent.GetName())
+		}
+	}
+
+	return nil
+}
+
+// validateDelegation ensures the child Tenant managed by a Capsule user doesn't grant more than its parent,
+// through the policies which are not narrowed: these must match the parent ones, as they are specified by the parent,
+// or be inherited from it when resolved, given the raw and the resolved specifications of the parent.
+func validateDelegation(parent, resolved, child *capsulev1beta2.Tenant) error {
+	if child.Spec.Adoption != nil {
+		return NewTenantDelegationViolationError("adoption", parent.GetName())
+	}
+
+	if child.Spec.TenantClassName != parent.Spec.TenantClassName {
+		return NewTenantDelegationViolationError("tenantClassName", parent.GetName())
+	}
+
+	if err := validateOwnersDelegation(parent, child); err != nil {
+		return err
+	}
+	// The Namespace quota is carved out of the parent budget, the other Namespace options must match
+	childNamespaceOptions, parentNamespaceOptions := &capsulev1beta2.NamespaceOptions{}, &capsulev1beta2.NamespaceOptions{}
+
+	if child.Spec.NamespaceOptions != nil {
+		childNamespaceOptions = child.Spec.NamespaceOptions.DeepCopy()
+	}
+
+	if parent.Spec.NamespaceOptions != nil {
+		parentNamespaceOptions = parent.Spec.NamespaceOptions.DeepCopy()
+	}
+
+	childNamespaceOptions.Quota, parentNamespaceOptions.Quota = nil, nil
+
+	if !equality.Semantic.DeepEqual(childNamespaceOptions, parentNamespaceOptions) {
+		return NewTenantDelegationViolationError("namespaceOptions", parent.GetName())
+	}
+	// The narrowed Ingress options have been already validated, the other ones must match
+	ingressOptions := parent.Spec.IngressOptions.DeepCopy()
+	ingressOptions.AllowedClasses = child.Spec.IngressOptions.AllowedClasses
+	ingressOptions.AllowedHostnames = child.Spec.IngressOptions.AllowedHostnames
+	ingressOptions.AllowWildcardHostnames = child.Spec.IngressOptions.AllowWildcardHostnames
+	ingressOptions.AllowedGateways = child.Spec.IngressOptions.AllowedGateways
+
+	if !equality.Semantic.DeepEqual(*ingressOptions, child.Spec.IngressOptions) {
+		return NewTenantDelegationViolationError("ingressOptions", parent.GetName())
+	}
+	// The Tenant-scoped ResourceQuota items are carved out of the parent budget
+	if resolved.Spec.ResourceQuota.Scope != api.ResourceQuotaScopeTenant && !equality.Semantic.DeepEqual(child.Spec.ResourceQuota, parent.Spec.ResourceQuota) {
+		return NewTenantDelegationViolationError("resourceQuotas", parent.GetName())
+	}
+
+	for _, policy := range []struct {
+		field         string
+		child, parent interface{}
+	}{
+		{"serviceOptions", child.Spec.ServiceOptions, parent.Spec.ServiceOptions},
+		{"podOptions", child.Spec.PodOptions, parent.Spec.PodOptions},
+		{"networkPolicies", child.Spec.NetworkPolicies, parent.Spec.NetworkPolicies},
+		{"additionalRoleBindings", child.Spec.AdditionalRoleBindings, parent.Spec.AdditionalRoleBindings},
+		{"imagePullPolicies", child.Spec.ImagePullPolicies, parent.Spec.ImagePullPolicies},
+		{"runtimeClasses", child.Spec.RuntimeClasses, parent.Spec.RuntimeClasses},
+		{"priorityClasses", child.Spec.PriorityClasses, parent.Spec.PriorityClasses},
+		{"enforcement", child.Spec.Enforcement, parent.Spec.Enforcement},
+	} {
+		if !equality.Semantic.DeepEqual(policy.child, policy.parent) {
+			return NewTenantDelegationViolationError(policy.field, parent.GetName())
+		}
+	}
+	// The policies inherited when missing must be left to the parent, or match the resolved ones
+	for _, policy := range []struct {
+		field         string
+		child, parent interface{}
+	}{
+		{"imagePolicy", child.Spec.ImagePolicy, resolved.Spec.ImagePolicy},
+		{"registryMirrors", child.Spec.RegistryMirrors, resolved.Spec.RegistryMirrors},
+		{"podSecurity", child.Spec.PodSecurity, resolved.Spec.PodSecurity},
+		{"volumeOptions", child.Spec.VolumeOptions, resolved.Spec.VolumeOptions},
+		{"nodePool", child.Spec.NodePool, resolved.Spec.NodePool},
+	} {
+		if !reflect.ValueOf(policy.child).IsNil() && !equality.Semantic.DeepEqual(policy.child, policy.parent) {
+			return NewTenantDelegationViolationError(policy.field, parent.GetName())
+		}
+	}
+
+	return nil
+}
+
+// validateOwnersDelegation ensures the owners of the child Tenant are not granted more Cluster Roles,
+// or capsule-proxy operations, than the ones granted to the owners of the parent Tenant.
+func validateOwnersDelegation(parent, child *capsulev1beta2.Tenant) error {
+	clusterRoles := sets.New[string]()
+	operations := map[capsulev1beta2.ProxyServiceKind]sets.Set[capsulev1beta2.ProxyOperation]{}
+
+	for _, owner := range parent.Spec.Owners {
+		clusterRoles.Insert(owner.ClusterRoles...)
+
+		for _, settings := range owner.ProxyOperations {
+			if _, ok := operations[settings.Kind]; !ok {
+				operations[settings.Kind] = sets.New[capsulev1beta2.ProxyOperation]()
+			}
+
+			operations[settings.Kind].Insert(settings.Operations...)
+		}
+	}
+
+	for _, owner := range child.Spec.Owners {
+		if !clusterRoles.HasAll(owner.ClusterRoles...) {
+			return NewTenantHierarchyViolationError("owners.clusterRoles", parent.GetName())
+		}
+
+		for _, settings := range owner.ProxyOperations {
+			if allowed, ok := operations[settings.Kind]; !ok || !allowed.HasAll(settings.Operations...) {
+				return NewTenantHierarchyViolationError("owners.proxySettings", parent.GetName())
+			}
+		}
+	}
+
+	return nil
+}
+
+// validateBudget ensures the Namespace quota and the Tenant-scoped ResourceQuota items
+// allocated to the children don't exceed the parent ones.
+func validateBudget(parent *capsulev1beta2.Tenant, children []capsulev1beta2.Tenant) error {
+	if parent.Spec.NamespaceOptions != nil && parent.Spec.NamespaceOptions.Quota != nil {
+		var requested int32
+
+		for _, child := range children {
+			if child.Spec.NamespaceOptions == nil || child.Spec.NamespaceOptions.Quota == nil {
+				return NewTenantBudgetMissingError(fmt.Sprintf("the Namespace quota of the Tenant %s", child.GetName()), parent.GetName())
+			}
+
+			requested += *child.Spec.NamespaceOptions.Quota
+		}
+
+		if limit := *parent.Spec.NamespaceOptions.Quota; requested > limit {
+			return NewTenantBudgetExceededError("Namespace quota", parent.GetName(), strconv.Itoa(int(requested)), strconv.Itoa(int(limit)))
+		}
+	}
+
+	if parent.Spec.ResourceQuota.Scope != api.ResourceQuotaScopeTenant {
+		return nil
+	}
+
+	for index, item := range parent.Spec.ResourceQuota.Items {
+		for name, limit := range item.Hard {
+			for _, child := range children {
+				if child.Spec.ResourceQuota.Scope == api.ResourceQuotaScopeTenant && len(child.Spec.ResourceQuota.Items) > index {
+					if _, ok := child.Spec.ResourceQuota.Items[index].Hard[name]; ok {
+						continue
+					}
+				}
+
+				return NewTenantBudgetMissingError(fmt.Sprintf("the Tenant-scoped ResourceQuota item %d for %s of the Tenant %s", index, name, child.GetName()), parent.GetName())
+			}
+
+			if requested := capsulev1beta2.ChildrenResourceQuota(index, name, children...); requested.Cmp(limit) > 0 {
+				return NewTenantBudgetExceededError(fmt.Sprintf("ResourceQuota item %d %s", index, name), parent.GetName(), requested.String(), limit.String())
+			}
+		}
+	}
+
+	return nil
+}
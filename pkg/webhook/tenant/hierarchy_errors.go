@@ -0,0 +1,119 @@
+// Copyright 2020-2023 Project Capsule Authors.
+// SPDX-License-Identifier: Apache-2.0
+
+package tenant
+
+import (
+	"fmt"
+	"strings"
+)
+
+type tenantParentRequiredError struct{}
+
+func NewTenantParentRequiredError() error {
+	return &tenantParentRequiredError{}
+}
+
+func (tenantParentRequiredError) Error() string {
+	return "Tenant owners can only manage Tenants having a parent they own: please, reach out to the system administrators"
+}
+
+type tenantParentNotOwnedError struct {
+	user   string
+	parent string
+}
+
+func NewTenantParentNotOwnedError(user, parent string) error {
+	return &tenantParentNotOwnedError{
+		user:   user,
+		parent: parent,
+	}
+}
+
+func (e tenantParentNotOwnedError) Error() string {
+	return fmt.Sprintf("%s is not an owner of the parent Tenant %s, or of any of its ancestors", e.user, e.parent)
+}
+
+type tenantHierarchyViolationError struct {
+	field  string
+	parent string
+}
+
+func NewTenantHierarchyViolationError(field, parent string) error {
+	return &tenantHierarchyViolationError{
+		field:  field,
+		parent: parent,
+	}
+}
+
+func (e tenantHierarchyViolationError) Error() string {
+	return fmt.Sprintf("%s cannot allow more than the ones of the parent Tenant %s, they can be only narrowed", e.field, e.parent)
+}
+
+type tenantDelegationViolationError struct {
+	field  string
+	parent string
+}
+
+func NewTenantDelegationViolationError(field, parent string) error {
+	return &tenantDelegationViolationError{
+		field:  field,
+		parent: parent,
+	}
+}
+
+func (e tenantDelegationViolationError) Error() string {
+	return fmt.Sprintf("%s must match the one of the parent Tenant %s, since it cannot be changed by Tenant owners: please, reach out to the system administrators", e.field, e.parent)
+}
+
+type tenantBudgetMissingError struct {
+	budget string
+	parent string
+}
+
+func NewTenantBudgetMissingError(budget, parent string) error {
+	return &tenantBudgetMissingError{
+		budget: budget,
+		parent: parent,
+	}
+}
+
+func (e tenantBudgetMissingError) Error() string {
+	return fmt.Sprintf("%s must be specified since it is limited by the parent Tenant %s", e.budget, e.parent)
+}
+
+type tenantBudgetExceededError struct {
+	budget    string
+	parent    string
+	requested string
+	limit     string
+}
+
+func NewTenantBudgetExceededError(budget, parent, requested, limit string) error {
+	return &tenantBudgetExceededError{
+		budget:    budget,
+		parent:    parent,
+		requested: requested,
+		limit:     limit,
+	}
+}
+
+func (e tenantBudgetExceededError) Error() string {
+	return fmt.Sprintf("the children of the Tenant %s are requesting %s for %s, exceeding the parent budget of %s", e.parent, e.requested, e.budget, e.limit)
+}
+
+type tenantHasChildrenError struct {
+	tenant   string
+	children []string
+}
+
+func NewTenantHasChildrenError(tenant string, children []string) error {
+	return &tenantHasChildrenError{
+		tenant:   tenant,
+		children: children,
+	}
+}
+
+func (e tenantHasChildrenError) Error() string {
+	return fmt.Sprintf("the Tenant %s cannot be deleted since it is the parent of the Tenants %s", e.tenant, strings.Join(e.children, ", "))
+}
@@ -0,0 +1,164 @@
+// Copyright 2020-2023 Project Capsule Authors.
+// SPDX-License-Identifier: Apache-2.0
+
+package tenant
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+
+	capsulev1beta2 "github.com/projectcapsule/capsule/api/v1beta2"
+	"github.com/projectcapsule/capsule/pkg/api"
+)
+
+func TestValidateDelegation(t *testing.T) {
+	parent := &capsulev1beta2.Tenant{
+		ObjectMeta: metav1.ObjectMeta{Name: "parent"},
+		Spec: capsulev1beta2.TenantSpec{
+			TenantClassName: "small",
+			Owners: capsulev1beta2.OwnerListSpec{
+				{
+					Kind:         capsulev1beta2.UserOwner,
+					Name:         "alice",
+					ClusterRoles: []string{"admin", "capsule-namespace-deleter"},
+					ProxyOperations: []capsulev1beta2.ProxySettings{
+						{Kind: capsulev1beta2.NodesProxy, Operations: []capsulev1beta2.ProxyOperation{capsulev1beta2.ListOperation}},
+					},
+				},
+			},
+			NamespaceOptions: &capsulev1beta2.NamespaceOptions{
+				Quota:           pointer.Int32(5),
+				NamingPolicy:    &api.NamespaceNamingPolicy{Template: "{{ tenant }}-{{ name }}"},
+				ForbiddenLabels: api.ForbiddenListSpec{Exact: []string{"team"}},
+			},
+			PodSecurity: &api.PodSecuritySpec{
+				Enforce: &api.PodSecurityStandardSpec{Level: "restricted"},
+			},
+		},
+	}
+
+	child := func(fn func(spec *capsulev1beta2.TenantSpec)) *capsulev1beta2.Tenant {
+		tnt := &capsulev1beta2.Tenant{
+			ObjectMeta: metav1.ObjectMeta{Name: "child"},
+			Spec: capsulev1beta2.TenantSpec{
+				Parent:          "parent",
+				TenantClassName: "small",
+				Owners: capsulev1beta2.OwnerListSpec{
+					{Kind: capsulev1beta2.UserOwner, Name: "bob", ClusterRoles: []string{"admin"}},
+				},
+				NamespaceOptions: &capsulev1beta2.NamespaceOptions{
+					Quota:           pointer.Int32(2),
+					NamingPolicy:    &api.NamespaceNamingPolicy{Template: "{{ tenant }}-{{ name }}"},
+					ForbiddenLabels: api.ForbiddenListSpec{Exact: []string{"team"}},
+				},
+				ContainerRegistries: &api.AllowedListSpec{Exact: []string{"quay.io"}},
+			},
+		}
+
+		if fn != nil {
+			fn(&tnt.Spec)
+		}
+
+		return tnt
+	}
+
+	for name, tc := range map[string]struct {
+		child *capsulev1beta2.Tenant
+		field string
+	}{
+		"matching": {
+			child: child(nil),
+		},
+		"inherited policy matching the parent": {
+			child: child(func(spec *capsulev1beta2.TenantSpec) {
+				spec.PodSecurity = parent.Spec.PodSecurity.DeepCopy()
+			}),
+		},
+		"adoption": {
+			child: child(func(spec *capsulev1beta2.TenantSpec) {
+				spec.Adoption = &api.NamespaceAdoptionSpec{Namespaces: []string{"kube-system"}}
+			}),
+			field: "adoption",
+		},
+		"class": {
+			child: child(func(spec *capsulev1beta2.TenantSpec) {
+				spec.TenantClassName = "large"
+			}),
+			field: "tenantClassName",
+		},
+		"owner proxy settings granted by the parent": {
+			child: child(func(spec *capsulev1beta2.TenantSpec) {
+				spec.Owners[0].ProxyOperations = []capsulev1beta2.ProxySettings{
+					{Kind: capsulev1beta2.NodesProxy, Operations: []capsulev1beta2.ProxyOperation{capsulev1beta2.ListOperation}},
+				}
+			}),
+		},
+		"owner cluster roles": {
+			child: child(func(spec *capsulev1beta2.TenantSpec) {
+				spec.Owners[0].ClusterRoles = []string{"admin", "cluster-admin"}
+			}),
+			field: "owners.clusterRoles",
+		},
+		"owner proxy settings": {
+			child: child(func(spec *capsulev1beta2.TenantSpec) {
+				spec.Owners[0].ProxyOperations = []capsulev1beta2.ProxySettings{
+					{Kind: capsulev1beta2.NodesProxy, Operations: []capsulev1beta2.ProxyOperation{capsulev1beta2.ListOperation, capsulev1beta2.DeleteOperation}},
+				}
+			}),
+			field: "owners.proxySettings",
+		},
+		"namespace options": {
+			child: child(func(spec *capsulev1beta2.TenantSpec) {
+				spec.NamespaceOptions.NamingPolicy = nil
+			}),
+			field: "namespaceOptions",
+		},
+		"additional role bindings": {
+			child: child(func(spec *capsulev1beta2.TenantSpec) {
+				spec.AdditionalRoleBindings = []api.AdditionalRoleBindingsSpec{
+					{ClusterRoleName: "cluster-admin", Subjects: []rbacv1.Subject{{Kind: "User", Name: "alice"}}},
+				}
+			}),
+			field: "additionalRoleBindings",
+		},
+		"resource quotas": {
+			child: child(func(spec *capsulev1beta2.TenantSpec) {
+				spec.ResourceQuota.Items = []corev1.ResourceQuotaSpec{{}}
+			}),
+			field: "resourceQuotas",
+		},
+		"inherited policy overridden": {
+			child: child(func(spec *capsulev1beta2.TenantSpec) {
+				spec.PodSecurity = &api.PodSecuritySpec{
+					Enforce: &api.PodSecurityStandardSpec{Level: "privileged"},
+				}
+			}),
+			field: "podSecurity",
+		},
+		"reserved taints": {
+			child: child(func(spec *capsulev1beta2.TenantSpec) {
+				spec.NodePool = &api.NodePoolSpec{
+					ReservedTaints: []api.ReservedTaintSpec{{Key: "dedicated"}},
+				}
+			}),
+			field: "nodePool",
+		},
+	} {
+		err := validateDelegation(parent, parent, tc.child)
+
+		switch {
+		case len(tc.field) == 0:
+			assert.NoError(t, err, name)
+		case strings.HasPrefix(tc.field, "owners."):
+			assert.Equal(t, NewTenantHierarchyViolationError(tc.field, parent.GetName()), err, name)
+		default:
+			assert.Equal(t, NewTenantDelegationViolationError(tc.field, parent.GetName()), err, name)
+		}
+	}
+}
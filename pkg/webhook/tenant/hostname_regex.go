@@ -37,6 +37,14 @@ func (h *hostnameRegexHandler) validate(decoder *admission.Decoder, req admissio
 		}
 	}
 
+	if tenant.Spec.IngressOptions.AllowedGateways != nil && len(tenant.Spec.IngressOptions.AllowedGateways.Regex) > 0 {
+		if _, err := regexp.Compile(tenant.Spec.IngressOptions.AllowedGateways.Regex); err != nil {
+			response := admission.Denied("unable to compile allowedGateways allowedRegex")
+
+			return &response
+		}
+	}
+
 	return nil
 }
 
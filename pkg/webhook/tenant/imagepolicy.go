@@ -0,0 +1,57 @@
+// Copyright 2020-2023 Project Capsule Authors.
+// SPDX-License-Identifier: Apache-2.0
+
+package tenant
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	capsulev1beta2 "github.com/projectcapsule/capsule/api/v1beta2"
+	capsulewebhook "github.com/projectcapsule/capsule/pkg/webhook"
+	"github.com/projectcapsule/capsule/pkg/webhook/pod"
+	"github.com/projectcapsule/capsule/pkg/webhook/utils"
+)
+
+type imagePolicyHandler struct{}
+
+func ImagePolicyHandler() capsulewebhook.Handler {
+	return &imagePolicyHandler{}
+}
+
+func (h *imagePolicyHandler) validate(decoder *admission.Decoder, req admission.Request) *admission.Response {
+	tenant := &capsulev1beta2.Tenant{}
+	if err := decoder.Decode(req, tenant); err != nil {
+		return utils.ErroredResponse(err)
+	}
+
+	if err := pod.ValidateImagePolicy(tenant.Spec.ImagePolicy); err != nil {
+		response := admission.Denied(fmt.Sprintf("invalid imagePolicy deniedImages: %s", err.Error()))
+
+		return &response
+	}
+
+	return nil
+}
+
+func (h *imagePolicyHandler) OnCreate(_ client.Client, decoder *admission.Decoder, _ record.EventRecorder) capsulewebhook.Func {
+	return func(ctx context.Context, req admission.Request) *admission.Response {
+		return h.validate(decoder, req)
+	}
+}
+
+func (h *imagePolicyHandler) OnDelete(client.Client, *admission.Decoder, record.EventRecorder) capsulewebhook.Func {
+	return func(context.Context, admission.Request) *admission.Response {
+		return nil
+	}
+}
+
+func (h *imagePolicyHandler) OnUpdate(_ client.Client, decoder *admission.Decoder, _ record.EventRecorder) capsulewebhook.Func {
+	return func(ctx context.Context, req admission.Request) *admission.Response {
+		return h.validate(decoder, req)
+	}
+}
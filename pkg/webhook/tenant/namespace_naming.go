@@ -0,0 +1,60 @@
+// Copyright 2020-2023 Project Capsule Authors.
+// SPDX-License-Identifier: Apache-2.0
+
+//nolint:dupl
+package tenant
+
+import (
+	"context"
+
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	capsulev1beta2 "github.com/projectcapsule/capsule/api/v1beta2"
+	capsulewebhook "github.com/projectcapsule/capsule/pkg/webhook"
+	"github.com/projectcapsule/capsule/pkg/webhook/utils"
+)
+
+type namespaceNamingPolicyHandler struct{}
+
+func NamespaceNamingPolicyHandler() capsulewebhook.Handler {
+	return &namespaceNamingPolicyHandler{}
+}
+
+func (h *namespaceNamingPolicyHandler) validate(decoder *admission.Decoder, req admission.Request) *admission.Response {
+	tenant := &capsulev1beta2.Tenant{}
+	if err := decoder.Decode(req, tenant); err != nil {
+		return utils.ErroredResponse(err)
+	}
+
+	if tenant.Spec.NamespaceOptions == nil || tenant.Spec.NamespaceOptions.NamingPolicy == nil {
+		return nil
+	}
+
+	if err := tenant.Spec.NamespaceOptions.NamingPolicy.Validate(); err != nil {
+		response := admission.Denied(err.Error())
+
+		return &response
+	}
+
+	return nil
+}
+
+func (h *namespaceNamingPolicyHandler) OnCreate(_ client.Client, decoder *admission.Decoder, _ record.EventRecorder) capsulewebhook.Func {
+	return func(ctx context.Context, req admission.Request) *admission.Response {
+		return h.validate(decoder, req)
+	}
+}
+
+func (h *namespaceNamingPolicyHandler) OnDelete(client.Client, *admission.Decoder, record.EventRecorder) capsulewebhook.Func {
+	return func(context.Context, admission.Request) *admission.Response {
+		return nil
+	}
+}
+
+func (h *namespaceNamingPolicyHandler) OnUpdate(_ client.Client, decoder *admission.Decoder, _ record.EventRecorder) capsulewebhook.Func {
+	return func(ctx context.Context, req admission.Request) *admission.Response {
+		return h.validate(decoder, req)
+	}
+}
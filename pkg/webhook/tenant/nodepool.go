@@ -0,0 +1,117 @@
+// Copyright 2020-2023 Project Capsule Authors.
+// SPDX-License-Identifier: Apache-2.0
+
+package tenant
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	capsulev1beta2 "github.com/projectcapsule/capsule/api/v1beta2"
+	"github.com/projectcapsule/capsule/pkg/api"
+	capsulewebhook "github.com/projectcapsule/capsule/pkg/webhook"
+	"github.com/projectcapsule/capsule/pkg/webhook/utils"
+)
+
+type nodePoolHandler struct{}
+
+func NodePoolHandler() capsulewebhook.Handler {
+	return &nodePoolHandler{}
+}
+
+func (h *nodePoolHandler) OnCreate(c client.Client, decoder *admission.Decoder, _ record.EventRecorder) capsulewebhook.Func {
+	return func(ctx context.Context, req admission.Request) *admission.Response {
+		return h.validate(ctx, c, decoder, req)
+	}
+}
+
+func (h *nodePoolHandler) OnDelete(client.Client, *admission.Decoder, record.EventRecorder) capsulewebhook.Func {
+	return func(context.Context, admission.Request) *admission.Response {
+		return nil
+	}
+}
+
+func (h *nodePoolHandler) OnUpdate(c client.Client, decoder *admission.Decoder, _ record.EventRecorder) capsulewebhook.Func {
+	return func(ctx context.Context, req admission.Request) *admission.Response {
+		return h.validate(ctx, c, decoder, req)
+	}
+}
+
+// validate denies the reserved taints already reserved by another Tenant, unless belonging to the same hierarchy,
+// or inherited by an ancestor:
+// the Pods tolerating the taints reserved by their own Tenant are allowed, thus these cannot be shared among unrelated ones.
+func (h *nodePoolHandler) validate(ctx context.Context, c client.Client, decoder *admission.Decoder, req admission.Request) *admission.Response {
+	tnt := &capsulev1beta2.Tenant{}
+	if err := decoder.Decode(req, tnt); err != nil {
+		return utils.ErroredResponse(err)
+	}
+
+	if tnt.Spec.NodePool == nil || len(tnt.Spec.NodePool.ReservedTaints) == 0 {
+		return nil
+	}
+
+	tntList := &capsulev1beta2.TenantList{}
+	if err := c.List(ctx, tntList); err != nil {
+		return utils.ErroredResponse(err)
+	}
+
+	parents := map[string]string{tnt.GetName(): tnt.Spec.Parent}
+
+	for _, other := range tntList.Items {
+		if other.GetName() != tnt.GetName() {
+			parents[other.GetName()] = other.Spec.Parent
+		}
+	}
+
+	// The taints reserved by the ancestors are inherited, thus shared with the other descendants
+	inherited := sets.New[api.ReservedTaintSpec]()
+
+	for _, other := range tntList.Items {
+		if other.Spec.NodePool != nil && isAncestor(parents, other.GetName(), tnt.GetName()) {
+			inherited.Insert(other.Spec.NodePool.ReservedTaints...)
+		}
+	}
+
+	for _, other := range tntList.Items {
+		if other.GetName() == tnt.GetName() || other.Spec.NodePool == nil {
+			continue
+		}
+
+		if isAncestor(parents, other.GetName(), tnt.GetName()) || isAncestor(parents, tnt.GetName(), other.GetName()) {
+			continue
+		}
+
+		for _, taint := range tnt.Spec.NodePool.ReservedTaints {
+			for _, reserved := range other.Spec.NodePool.ReservedTaints {
+				if taint != reserved || inherited.Has(taint) {
+					continue
+				}
+
+				response := admission.Denied(NewReservedTaintCollisionError(taint.Key, other.GetName()).Error())
+
+				return &response
+			}
+		}
+	}
+
+	return nil
+}
+
+// isAncestor returns true if the ancestor Tenant is in the chain of parents of the given one.
+func isAncestor(parents map[string]string, ancestor, tenant string) bool {
+	visited := sets.New[string](tenant)
+
+	for parent := parents[tenant]; len(parent) > 0 && !visited.Has(parent); parent = parents[parent] {
+		if parent == ancestor {
+			return true
+		}
+
+		visited.Insert(parent)
+	}
+
+	return false
+}
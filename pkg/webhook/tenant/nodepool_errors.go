@@ -0,0 +1,22 @@
+// Copyright 2020-2023 Project Capsule Authors.
+// SPDX-License-Identifier: Apache-2.0
+
+package tenant
+
+import "fmt"
+
+type reservedTaintCollisionError struct {
+	key    string
+	tenant string
+}
+
+func NewReservedTaintCollisionError(key, tenant string) error {
+	return &reservedTaintCollisionError{
+		key:    key,
+		tenant: tenant,
+	}
+}
+
+func (e reservedTaintCollisionError) Error() string {
+	return fmt.Sprintf("the taint %s is already reserved by the Tenant %s, which is not part of the same hierarchy", e.key, e.tenant)
+}
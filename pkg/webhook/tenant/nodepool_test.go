@@ -0,0 +1,65 @@
+// Copyright 2020-2023 Project Capsule Authors.
+// SPDX-License-Identifier: Apache-2.0
+
+package tenant
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	admissionv1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	capsulev1beta2 "github.com/projectcapsule/capsule/api/v1beta2"
+	"github.com/projectcapsule/capsule/pkg/api"
+)
+
+func TestNodePoolHandler(t *testing.T) {
+	scheme := runtime.NewScheme()
+	assert.NoError(t, capsulev1beta2.AddToScheme(scheme))
+
+	tenant := func(name, parent string, taints ...api.ReservedTaintSpec) *capsulev1beta2.Tenant {
+		return &capsulev1beta2.Tenant{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec: capsulev1beta2.TenantSpec{
+				Parent:   parent,
+				NodePool: &api.NodePoolSpec{ReservedTaints: taints},
+			},
+		}
+	}
+
+	oil := api.ReservedTaintSpec{Key: "pool", Value: "oil"}
+
+	c := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(tenant("oil", "", oil), tenant("oil-dev", "oil", oil), tenant("gas", "")).
+		Build()
+
+	handler := &nodePoolHandler{}
+
+	for name, tc := range map[string]struct {
+		tenant  *capsulev1beta2.Tenant
+		allowed bool
+	}{
+		"unrelated":  {tenant: tenant("gas", "", oil)},
+		"other key":  {tenant: tenant("gas", "", api.ReservedTaintSpec{Key: "pool", Value: "gas"}), allowed: true},
+		"child":      {tenant: tenant("oil-prod", "oil", oil), allowed: true},
+		"gas child":  {tenant: tenant("gas-dev", "gas", oil)},
+		"grandchild": {tenant: tenant("oil-dev-test", "oil-dev", oil), allowed: true},
+		"parent":     {tenant: tenant("oil", "", oil), allowed: true},
+	} {
+		raw, err := json.Marshal(tc.tenant)
+		assert.NoError(t, err, name)
+
+		req := admission.Request{AdmissionRequest: admissionv1.AdmissionRequest{Object: runtime.RawExtension{Raw: raw}}}
+
+		response := handler.OnCreate(c, admission.NewDecoder(scheme), nil)(context.Background(), req)
+
+		assert.Equal(t, tc.allowed, response == nil, name)
+	}
+}
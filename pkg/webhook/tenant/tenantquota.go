@@ -0,0 +1,96 @@
+// Copyright 2020-2023 Project Capsule Authors.
+// SPDX-License-Identifier: Apache-2.0
+
+package tenant
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	capsulev1beta2 "github.com/projectcapsule/capsule/api/v1beta2"
+	"github.com/projectcapsule/capsule/pkg/quota"
+	capsuleutils "github.com/projectcapsule/capsule/pkg/utils"
+	capsulewebhook "github.com/projectcapsule/capsule/pkg/webhook"
+	"github.com/projectcapsule/capsule/pkg/webhook/utils"
+)
+
+type tenantQuotaHandler struct {
+	counter *quota.Counter
+}
+
+// TenantQuotaHandler enforces the TenantQuota resources, limiting the amount of objects of any kind across the Tenant Namespaces.
+// The used amount is counted by the TenantQuota controller, thus no object is updated upon admission.
+func TenantQuotaHandler(counter *quota.Counter) capsulewebhook.Handler {
+	return &tenantQuotaHandler{
+		counter: counter,
+	}
+}
+
+// tenantQuotas returns the TenantQuota resources applied to the Tenant owning the Namespace of the request.
+func (h *tenantQuotaHandler) tenantQuotas(ctx context.Context, c client.Client, req admission.Request) ([]capsulev1beta2.TenantQuota, error) {
+	if len(req.Namespace) == 0 || len(req.SubResource) > 0 {
+		return nil, nil
+	}
+
+	tnt, err := utils.TenantByStatusNamespace(ctx, c, req.Namespace)
+	if err != nil || len(tnt.GetName()) == 0 {
+		return nil, err
+	}
+
+	return capsuleutils.GetTenantQuotas(ctx, c, tnt.GetName())
+}
+
+func (h *tenantQuotaHandler) OnCreate(c client.Client, _ *admission.Decoder, recorder record.EventRecorder) capsulewebhook.Func {
+	return func(ctx context.Context, req admission.Request) *admission.Response {
+		tqs, err := h.tenantQuotas(ctx, c, req)
+		if err != nil {
+			return utils.ErroredResponse(err)
+		}
+
+		gk := schema.GroupKind{Group: req.Kind.Group, Kind: req.Kind.Kind}
+
+		// All the TenantQuota resources are checked before tracking the new object in any of them
+		if tq, reserveErr := h.counter.ReserveAll(tqs, gk, pointer.BoolDeref(req.DryRun, false)); reserveErr != nil {
+			recorder.Eventf(tq, corev1.EventTypeWarning, "TenantQuotaLimitReached", "Resource %s/%s of kind %s cannot be created: %s", req.Namespace, req.Name, gk.String(), reserveErr.Error())
+
+			response := admission.Denied(reserveErr.Error())
+
+			return &response
+		}
+
+		return nil
+	}
+}
+
+func (h *tenantQuotaHandler) OnDelete(c client.Client, _ *admission.Decoder, _ record.EventRecorder) capsulewebhook.Func {
+	return func(ctx context.Context, req admission.Request) *admission.Response {
+		if pointer.BoolDeref(req.DryRun, false) {
+			return nil
+		}
+
+		tqs, err := h.tenantQuotas(ctx, c, req)
+		if err != nil {
+			return utils.ErroredResponse(err)
+		}
+
+		gk := schema.GroupKind{Group: req.Kind.Group, Kind: req.Kind.Kind}
+
+		for i := range tqs {
+			h.counter.Release(&tqs[i], gk)
+		}
+
+		return nil
+	}
+}
+
+func (h *tenantQuotaHandler) OnUpdate(client.Client, *admission.Decoder, record.EventRecorder) capsulewebhook.Func {
+	return func(context.Context, admission.Request) *admission.Response {
+		return nil
+	}
+}